@@ -12,6 +12,7 @@ func main() {
 	l := flag.Bool("l", false, "load previous analyze data")
 	g := flag.Bool("g", false, "generate model")
 	e := flag.Bool("e", false, "regenerate events")
+	fx := flag.Bool("f", false, "generate test fixtures")
 	flag.Parse()
 
 	if len(*f) > 0 {
@@ -43,6 +44,9 @@ func main() {
 		if err := df.GenerateBackendCode(m); err != nil {
 			log.Fatal(err)
 		}
+		if err := df.GenerateExportCode(m); err != nil {
+			log.Fatal(err)
+		}
 
 		if *e {
 			if err := df.GenerateEventsCode(m); err != nil {
@@ -50,5 +54,10 @@ func main() {
 			}
 		}
 
+		if *fx {
+			if err := df.GenerateFixtures(m); err != nil {
+				log.Fatal(err)
+			}
+		}
 	}
 }
@@ -34,6 +34,7 @@ type Field struct {
 	Plus        bool
 	EnumValues  *[]string
 	Related     string
+	NoExport    bool
 }
 
 func (f Field) Active(plus bool) bool {
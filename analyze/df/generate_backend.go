@@ -29,6 +29,7 @@ var backendTemplate = template.Must(template.New("").Funcs(template.FuncMap{
 	"enum":       enumValue,
 	"enumString": enumString,
 	"startswith": startswith,
+	"lowerCamel": strcase.ToLowerCamel,
 }).Parse(`// Code generated by legendsbrowser; DO NOT EDIT.
 package model
 
@@ -103,6 +104,9 @@ type {{ $obj.Name }} struct {
 	{{- if not (and (eq $fname "type") (not (not $obj.SubTypes))) }}
 	{{- if not ($field.SameField $obj) }}
 	{{ $field.TypeLine }} // {{ $fname }}
+	{{- if $field.IsCoords }}
+	coords []Coord
+	{{- end }}
 	{{- end }}
 	{{- end }}
 	{{- end }}
@@ -116,14 +120,20 @@ type {{ $obj.Name }} struct {
 
 func New{{ $obj.Name }}() *{{ $obj.Name }} {
 	return &{{ $obj.Name }}{
-		{{- range $fname, $field := $obj.Fields }}{{- if $field.MustInit }}{{- if not ($field.SameField $obj) }}
-		{{ $field.Init }}
-		{{- end }}{{- end }}{{- end }}
-		{{- range $fname, $field := $obj.Additional }}{{- if $field.MustInit }}
-		{{ $field.Init }}
-		{{- end }}{{- end }}
+		{{- template "init" $obj }}
+	}
+}
+
+{{- if $obj.Arena }}
+
+func (a *arenas) new{{ $obj.Name }}() *{{ $obj.Name }} {
+	x := a.{{ $obj.Name | lowerCamel }}.new()
+	*x = {{ $obj.Name }}{
+		{{- template "init" $obj }}
 	}
+	return x
 }
+{{- end }}
 
 {{- if $obj.Id }}
 func (x *{{ $obj.Name }}) Id() int { return x.Id_ }
@@ -132,6 +142,9 @@ func (x *{{ $obj.Name }}) setId(id int) { x.Id_ = id }
 {{- if $obj.Named }}
 func (x *{{ $obj.Name }}) Name() string { return x.Name_ }
 {{- end }}
+{{- if $obj.HasCoords }}
+func (x *{{ $obj.Name }}) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
+{{- end }}
 {{- if $obj.SubType }}
 func (x *{{ $obj.Name }}) Type() string { return "{{ $obj.SubType }}" }
 {{- end }}
@@ -158,12 +171,12 @@ func (x *{{ $obj.Name }}) CheckFields() {
 		{{- if eq $field.Type $field2.Type }}
 		{{- if eq $field.Type "int" }}
 			if x.{{ $field.Name}} != x.{{ $field2.Name}} {
-				sameFields["{{$obj.Name}}"]["{{ $field.Name}}"]["{{ $field2.Name}}"] = false
+				fieldsDiffer("{{$obj.Name}}", "{{ $field.Name}}", "{{ $field2.Name}}")
 			}
 		{{- end }}
 		{{- if eq $field.Type "string" }}
 			if x.{{ $field.Name}} != x.{{ $field2.Name}} && x.{{ $field.Name}} != "" && x.{{ $field2.Name}} != "" {
-				sameFields["{{$obj.Name}}"]["{{ $field.Name}}"]["{{ $field2.Name}}"] = false
+				fieldsDiffer("{{$obj.Name}}", "{{ $field.Name}}", "{{ $field2.Name}}")
 			}
 		{{- end }}		{{- end }}
 		{{- end }}
@@ -179,25 +192,48 @@ func (x *{{ $obj.Name }}) MarshalJSON() ([]byte, error) {
 	{{- if not (not $obj.SubTypes) }}
 	d["details"] = x.Details
 	{{- end }}
-	{{- range $fname, $field := $obj.Additional }}
+	{{- range $fname, $field := $obj.Additional }}{{- if not $field.NoExport }}
 	{{ $field.JsonMarshal }}
-	{{- end }}
+	{{- end }}{{- end }}
 	return json.Marshal(d)
 }
 
 {{- end }}
 
+{{- range $name, $obj := $.Objects }}
+{{- if not (not $obj.SubTypes) }}
+
+// {{ $obj.Name | lowerCamel }}Types creates empty details by type
+var {{ $obj.Name | lowerCamel }}Types = map[string]func() {{ $obj.Name }}Details{
+	{{- range $subName, $sub := $.Objects }}
+	{{- if $sub.IsSubTypeOf $obj.Name }}
+	"{{ $sub.SubType }}": func() {{ $obj.Name }}Details { return New{{ $sub.Name }}() },
+	{{- end }}
+	{{- end }}
+}
+{{- end }}
+{{- end }}
+
+// arenas allocate the events and their details of one world
+type arenas struct {
+	{{- range $name, $obj := $.Objects }}
+	{{- if $obj.Arena }}
+	{{ $obj.Name | lowerCamel }} arena[{{ $obj.Name }}]
+	{{- end }}
+	{{- end }}
+}
+
 // Parser
 
 {{- range $name, $obj := $.Objects }}
 {{- range $plus := $.Modes }}
-func parse{{ $obj.Name }}{{ if $plus }}Plus{{ end }}(p *util.XMLParser{{ if $plus }}, obj *{{ $obj.Name }}{{ end }}) (*{{ $obj.Name }}, error) {
+func parse{{ $obj.Name }}{{ if $plus }}Plus{{ end }}(p *parser{{ if $plus }}, obj *{{ $obj.Name }}{{ end }}) (*{{ $obj.Name }}, error) {
 	{{- if not $plus }}
-	var obj = New{{ $obj.Name }}()
+	var obj = {{ $obj.New }}
 	{{- end }}
 	{{- if $plus }}
 	if obj == nil {
-		obj = New{{ $obj.Name }}()
+		obj = {{ $obj.New }}
 	}
 	{{- end }}
 
@@ -267,6 +303,15 @@ func parse{{ $obj.Name }}{{ if $plus }}Plus{{ end }}(p *util.XMLParser{{ if $plu
 }
 {{- end }}
 {{- end }}
+
+{{- define "init" }}
+		{{- range $fname, $field := .Fields }}{{- if $field.MustInit }}{{- if not ($field.SameField $) }}
+		{{ $field.Init }}
+		{{- end }}{{- end }}{{- end }}
+		{{- range $fname, $field := .Additional }}{{- if $field.MustInit }}
+		{{ $field.Init }}
+		{{- end }}{{- end }}
+{{- end }}
 `))
 
 var sameFields map[string]map[string]string
@@ -363,6 +408,58 @@ func (f Field) Init() string {
 	return ""
 }
 
+// free text that is not worth interning
+var uniqueTexts = map[string]bool{
+	"Name":            true,
+	"Altname":         true,
+	"IdentityName":    true,
+	"Title":           true,
+	"Description":     true,
+	"ItemDescription": true,
+	"Claims":          true,
+	"Rectangle":       true,
+}
+
+// Text returns the conversion of a string value. Repeated values are
+// interned.
+func (f Field) Text() string {
+	switch {
+	case uniqueTexts[f.Name] || strings.HasPrefix(f.Name, "Name"):
+		return "txt(data)"
+	}
+	return "p.itxt(data)"
+}
+
+// IsCoords reports whether the field holds coordinates like "1,2|3,4",
+// which are parsed while loading.
+func (f Field) IsCoords() bool {
+	return f.Name == "Coords" && f.Type == "string" && !f.Multiple
+}
+
+// HasCoords reports whether the object has parsed coordinates.
+func (obj Object) HasCoords() bool {
+	for _, f := range obj.Fields {
+		if f.IsCoords() && !f.SameField(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// Arena reports whether objects are allocated in blocks, which is done for
+// the numerous events and their details.
+func (obj Object) Arena() bool {
+	return strings.HasPrefix(obj.Name, "HistoricalEvent")
+}
+
+// New is the allocation of an object by its parser.
+func (obj Object) New() string {
+	if obj.Arena() {
+		return fmt.Sprintf("p.new%s()", obj.Name)
+	}
+	return fmt.Sprintf("New%s()", obj.Name)
+}
+
 func (f Field) StartAction(obj Object, plus bool) string {
 	n := f.FixedName()
 
@@ -419,8 +516,10 @@ func (f Field) StartAction(obj Object, plus bool) string {
 		if !f.Multiple {
 			if f.Type == "int" {
 				return fmt.Sprintf("%sobj.%s = num(data)", s, n)
+			} else if f.Type == "string" && f.IsCoords() {
+				return fmt.Sprintf("%sobj.%s = txt(data)\nobj.coords = parseCoords(obj.%s)", s, n, n)
 			} else if f.Type == "string" {
-				return fmt.Sprintf("%sobj.%s = txt(data)", s, n)
+				return fmt.Sprintf("%sobj.%s = %s", s, n, f.Text())
 			} else if f.Type == "bool" {
 				s := "_, err := p.Value()\nif err != nil { return nil, err }\n"
 				return fmt.Sprintf("%sobj.%s = true", s, n)
@@ -431,7 +530,7 @@ func (f Field) StartAction(obj Object, plus bool) string {
 			if f.Type == "int" {
 				return fmt.Sprintf("%sobj.%s = append(obj.%s, num(data))", s, n, n)
 			} else if f.Type == "string" {
-				return fmt.Sprintf("%sobj.%s = append(obj.%s, txt(data))", s, n, n)
+				return fmt.Sprintf("%sobj.%s = append(obj.%s, %s)", s, n, n, f.Text())
 			} else if f.Type == "bool" {
 				s := "_, err := p.Value()\nif err != nil { return nil, err }\n"
 				return fmt.Sprintf("%sobj.%s = append(obj.%s, true)", s, n, n)
@@ -0,0 +1,224 @@
+package df
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+var exportTemplate = template.Must(template.New("").Parse(`// Code generated by legendsbrowser; DO NOT EDIT.
+package export
+
+var tables = []*Table{
+	{{- range $table := $.Tables }}
+	{
+		Name: "{{ $table.Name }}",
+		Type: "{{ $table.Type }}",
+		{{- if $table.Field }}
+		Field: "{{ $table.Field }}",
+		{{- end }}
+		{{- if $table.Parent }}
+		Parent: "{{ $table.Parent }}",
+		{{- end }}
+		{{- if $table.SubType }}
+		SubType: true,
+		{{- end }}
+		{{- if $table.Id }}
+		Id: true,
+		{{- end }}
+		Columns: []*Column{
+			{{- range $c := $table.Columns }}
+			{Name: "{{ $c.Name }}", Field: "{{ $c.Field }}", Kind: {{ $c.Kind }}
+				{{- if $c.Multiple }}, Multiple: true{{ end }}
+				{{- if $c.Enum }}, Enum: "{{ $c.Enum }}"{{ end }}
+				{{- if $c.References }}, References: "{{ $c.References }}"{{ end }}},
+			{{- end }}
+		},
+	},
+	{{- end }}
+}
+
+var enums = map[string][]string{
+	{{- range $name, $values := $.Enums }}
+	"{{ $name }}": { {{- range $i, $v := $values }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end -}} },
+	{{- end }}
+}
+`))
+
+type exportTable struct {
+	Name    string
+	Type    string
+	Field   string
+	Parent  string
+	SubType bool
+	Id      bool
+	Columns []*exportColumn
+}
+
+type exportColumn struct {
+	Name       string
+	Field      string
+	Kind       string
+	Multiple   bool
+	Enum       string
+	References string
+}
+
+// tables of the objects referenced by the relations of RelatedTo
+var relationTables = []struct {
+	relation string
+	regex    *regexp.Regexp
+	table    string
+}{
+	{"entity", entityRegex, "entity"},
+	{"hf", hfRegex, "historical_figure"},
+	{"artifact", artifactRegex, "artifact"},
+	{"site", siteRegex, "site"},
+	{"region", regionRegex, "region"},
+	{"worldConstruction", worldConstructionRegex, "world_construction"},
+	{"writtenContent", writtenContentRegex, "written_content"},
+	{"danceForm", noRegex, "dance_form"},
+	{"musicalForm", noRegex, "musical_form"},
+	{"poeticForm", noRegex, "poetic_form"},
+	{"mountain", noRegex, "mountain_peak"},
+	{"identity", identityRegex, "identity"},
+}
+
+func relationTable(name string, f Field) string {
+	for _, r := range relationTables {
+		if f.Related == r.relation || (f.Related == "" && r.regex.MatchString(name)) {
+			return r.table
+		}
+	}
+	return ""
+}
+
+// GenerateExportCode writes the tables the exporters turn the model into.
+// Every object type becomes a table, nested objects reference the object
+// holding them and the details of events and collections reference their
+// base object.
+func GenerateExportCode(objects *Metadata) error {
+	var tables []*exportTable
+	enums := make(map[string][]string)
+
+	var add func(obj Object, field, parent string, subType bool)
+	add = func(obj Object, field, parent string, subType bool) {
+		t := &exportTable{
+			Name:    strcase.ToSnake(obj.Name),
+			Type:    obj.Name,
+			Field:   field,
+			Parent:  parent,
+			SubType: subType,
+			Id:      obj.Id,
+		}
+		tables = append(tables, t)
+
+		var children []Field
+		for _, n := range sortedKeys(obj.Fields) {
+			f := obj.Fields[n]
+			if f.SameField(obj) || (n == "type" && obj.SubTypes != nil) {
+				continue
+			}
+			c := &exportColumn{Name: strcase.ToSnake(f.Name), Field: f.FixedName(), Multiple: f.Multiple}
+			switch f.Type {
+			case "int":
+				c.Kind = "Int"
+				c.References = relationTable(n, f)
+			case "string":
+				c.Kind = "String"
+			case "bool":
+				c.Kind = "Bool"
+			case "enum":
+				c.Kind = "Enum"
+				c.Enum = *f.ElementType
+				var values []string
+				for _, v := range f.UniqueEnumValues() {
+					values = append(values, enumString(v))
+				}
+				enums[obj.Name+f.Name] = append([]string{"unknown"}, values...)
+			case "object", "array", "map":
+				children = append(children, f)
+				continue
+			default:
+				continue
+			}
+			t.Columns = append(t.Columns, c)
+		}
+		if obj.SubTypes != nil {
+			t.Columns = append(t.Columns, &exportColumn{Name: "type", Field: "Details", Kind: "Type"})
+		}
+		for _, n := range sortedKeys(obj.Additional) {
+			f := obj.Additional[n]
+			if f.NoExport {
+				continue
+			}
+			c := &exportColumn{Name: strcase.ToSnake(f.Name), Field: f.FixedName(), Multiple: strings.HasPrefix(f.Type, "[]")}
+			switch strings.TrimPrefix(f.Type, "[]") {
+			case "int":
+				c.Kind = "Int"
+				c.References = relationTable("", f)
+			case "string":
+				c.Kind = "String"
+			case "bool":
+				c.Kind = "Bool"
+			default:
+				continue
+			}
+			if c.Multiple && c.Kind == "Bool" {
+				continue
+			}
+			t.Columns = append(t.Columns, c)
+		}
+
+		for _, f := range children {
+			add((*objects)[*f.ElementType], f.FixedName(), t.Name, false)
+		}
+		if obj.SubTypes != nil {
+			for _, n := range sortedKeys(*objects) {
+				if o := (*objects)[n]; o.IsSubTypeOf(obj.Name) {
+					add(o, "Details", t.Name, true)
+				}
+			}
+		}
+	}
+	add((*objects)["DfWorld"], "", "", false)
+
+	f, err := os.Create("../backend/export/schema.go")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	err = exportTemplate.Execute(&buf, struct {
+		Tables []*exportTable
+		Enums  map[string][]string
+	}{
+		Tables: tables,
+		Enums:  enums,
+	})
+	if err != nil {
+		return err
+	}
+	p, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Println("WARN: could not format source", err)
+		p = buf.Bytes()
+	}
+	_, err = f.Write(p)
+	return err
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := util.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,404 @@
+package df
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+const fixturePath = "../backend/model/testdata/fixture-"
+
+type fixtureEvent struct {
+	Id, Year, Seconds, Variant int
+	Type                       Subtype
+}
+
+type fixtureCollection struct {
+	Id, Variant int
+	Type        Subtype
+	Events      []int
+	Refs        map[string]int
+	Children    []int
+}
+
+type fixtureWriter struct {
+	objects     Metadata
+	events      []*fixtureEvent
+	collections []*fixtureCollection
+	plus        bool
+	buf         bytes.Buffer
+	depth       int
+}
+
+// GenerateFixtures writes a small synthetic world to the testdata of the
+// model. It has two of every object, and of every event and collection type
+// as many as the most values of one of its enums, so that every value is used
+// once.
+func GenerateFixtures(objects *Metadata) error {
+	w := &fixtureWriter{objects: *objects}
+	w.planEvents()
+	w.planCollections()
+
+	for _, plus := range []bool{false, true} {
+		w.plus = plus
+		w.buf.Reset()
+		w.buf.WriteString("<?xml version=\"1.0\" encoding='UTF-8'?>\n")
+		w.open("df_world")
+		w.fields(w.objects["DfWorld"], 0)
+		w.close("df_world")
+		file := "legends.xml"
+		if plus {
+			file = "legends_plus.xml"
+		}
+		if err := ioutil.WriteFile(fixturePath+file, w.buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	if err := ioutil.WriteFile(fixturePath+"world_history.txt", []byte(w.history()), 0644); err != nil {
+		return err
+	}
+	params := "[WORLD_GEN]\n\t[TITLE:FIXTURE]\n\t[DIM:17:17]\n"
+	if err := ioutil.WriteFile(fixturePath+"world_gen_param.txt", []byte(params), 0644); err != nil {
+		return err
+	}
+	fmt.Println("generated fixtures in", filepath.Dir(fixturePath))
+	return nil
+}
+
+func (w *fixtureWriter) subtypes(name string) []Subtype {
+	list := append([]Subtype{}, *w.objects[name].SubTypes...)
+	sort.Slice(list, func(i, j int) bool { return list[i].BaseType < list[j].BaseType })
+	return list
+}
+
+// variants is the number of objects needed to use every value of the enums
+// of obj.
+func (w *fixtureWriter) variants(obj Object) int {
+	n := 2
+	for _, f := range obj.Fields {
+		if f.Type == "enum" && len(*f.EnumValues) > n {
+			n = len(*f.EnumValues)
+		}
+	}
+	return n
+}
+
+func (w *fixtureWriter) planEvents() {
+	for _, s := range w.subtypes("HistoricalEvent") {
+		for v := 0; v < w.variants(w.objects["HistoricalEvent"+s.Name]); v++ {
+			id := len(w.events)
+			w.events = append(w.events, &fixtureEvent{Id: id, Year: 10 + id/10, Seconds: id % 10 * 40320, Variant: v, Type: s})
+		}
+	}
+}
+
+func (w *fixtureWriter) planCollections() {
+	for _, s := range w.subtypes("HistoricalEventCollection") {
+		var matching []int
+		for _, e := range w.events {
+			if e.Type.BaseType == s.BaseType {
+				matching = append(matching, e.Id)
+			}
+		}
+		for v := 0; v < w.variants(w.objects["HistoricalEventCollection"+s.Name]); v++ {
+			id := len(w.collections)
+			c := &fixtureCollection{Id: id, Variant: v, Type: s, Refs: make(map[string]int)}
+			if len(matching) > 0 {
+				c.Events = []int{matching[v%len(matching)], matching[(v+1)%len(matching)]}
+			} else {
+				c.Events = []int{2 * id % len(w.events), (2*id + 1) % len(w.events)}
+			}
+			w.collections = append(w.collections, c)
+		}
+	}
+
+	// collections are children of the first collection of the type named by
+	// their eventcol fields, or of the first war
+	parent := func(name string) *fixtureCollection {
+		var war *fixtureCollection
+		for _, c := range w.collections {
+			if c.Type.BaseType == name {
+				return c
+			}
+			if war == nil && c.Type.BaseType == "war" {
+				war = c
+			}
+		}
+		return war
+	}
+	for _, c := range w.collections {
+		obj := w.objects["HistoricalEventCollection"+c.Type.Name]
+		for k, f := range obj.Fields {
+			if strings.HasSuffix(k, "_eventcol") && !f.Multiple {
+				p := parent(strings.TrimSuffix(k, "_eventcol"))
+				c.Refs[k] = p.Id
+				p.Children = append(p.Children, c.Id)
+			}
+		}
+		for k := range obj.Additional {
+			if strings.HasSuffix(k, "Eventcol") {
+				p := parent(strcase.ToDelimited(strings.TrimSuffix(k, "Eventcol"), ' '))
+				p.Children = append(p.Children, c.Id)
+			}
+		}
+	}
+}
+
+func (w *fixtureWriter) line(format string, a ...interface{}) {
+	w.buf.WriteString(strings.Repeat("\t", w.depth))
+	fmt.Fprintf(&w.buf, format, a...)
+	w.buf.WriteByte('\n')
+}
+
+func (w *fixtureWriter) open(name string) {
+	w.line("<%s>", name)
+	w.depth++
+}
+
+func (w *fixtureWriter) close(name string) {
+	w.depth--
+	w.line("</%s>", name)
+}
+
+func (w *fixtureWriter) element(name string, value interface{}) {
+	var s bytes.Buffer
+	xml.EscapeText(&s, []byte(fmt.Sprint(value)))
+	w.line("<%s>%s</%s>", name, s.String(), name)
+}
+
+// active reports whether f is written in the current file. Fields of both
+// files are repeated in the plus file, unless that would add to lists.
+func (w *fixtureWriter) active(f Field) bool {
+	if w.plus {
+		return f.Plus && !(f.Base && (f.Multiple || f.Type == "array"))
+	}
+	return f.Base
+}
+
+func (w *fixtureWriter) hasId(obj Object) bool {
+	for _, k := range []string{"id", "local_id"} {
+		if f, ok := obj.Fields[k]; ok && w.active(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// fields writes the fields of obj, ids first as the plus parser expects.
+func (w *fixtureWriter) fields(obj Object, i int) {
+	keys := util.Keys(obj.Fields)
+	sort.Strings(keys)
+	sort.SliceStable(keys, func(a, b int) bool {
+		return (keys[a] == "id" || keys[a] == "local_id") && keys[b] != "id" && keys[b] != "local_id"
+	})
+	for fi, k := range keys {
+		if f := obj.Fields[k]; w.active(f) {
+			w.field(obj, k, f, i, fi)
+		}
+	}
+}
+
+func (w *fixtureWriter) field(obj Object, k string, f Field, i, fi int) {
+	switch f.Type {
+	case "int", "string":
+		for _, v := range w.values(obj, k, f, i, fi) {
+			w.element(k, v)
+		}
+	case "bool":
+		n := (i + fi) % 2
+		if f.Multiple {
+			n = i%2 + 1
+		}
+		for j := 0; j < n; j++ {
+			w.line("<%s/>", k)
+		}
+	case "enum":
+		values := *f.EnumValues
+		w.element(k, values[i%len(values)])
+		if f.Multiple {
+			w.element(k, values[(i+1)%len(values)])
+		}
+	case "object":
+		n := 1
+		if f.Multiple {
+			n = 2
+		}
+		for j := 0; j < n; j++ {
+			w.open(k)
+			w.fields(w.objects[*f.ElementType], i+j)
+			w.close(k)
+		}
+	case "map", "array":
+		el := w.objects[*f.ElementType]
+		if f.Type == "map" && !w.hasId(el) {
+			return
+		}
+		w.open(k)
+		switch el.Name {
+		case "HistoricalEvent":
+			w.historicalEvents()
+		case "HistoricalEventCollection":
+			w.historicalEventCollections()
+		default:
+			n := 2
+			if strings.HasPrefix(el.Name, "HistoricalEventRelationship") {
+				n = w.variants(w.objects["HistoricalEventRelationship"])
+			}
+			for j := 0; j < n; j++ {
+				w.open(singular(k))
+				w.fields(el, j)
+				w.close(singular(k))
+			}
+		}
+		w.close(k)
+	}
+}
+
+// values are the values of an int or string field. Ids and references are 0
+// or 1, so every reference points to an object of the fixture.
+func (w *fixtureWriter) values(obj Object, k string, f Field, i, fi int) []interface{} {
+	x, y := i%8*2, i%8
+	switch {
+	case k == "id" || k == "local_id":
+		// objects within objects are numbered from the variant of their parent
+		return []interface{}{i % 2}
+	case k == "event" && strings.HasPrefix(obj.Name, "HistoricalEventRelationship"):
+		// relationships are events of their own
+		return []interface{}{len(w.events) + i}
+	case k == "name" && obj.Name == "DfWorld":
+		return []interface{}{"fixture world"}
+	case k == "name":
+		return []interface{}{fmt.Sprintf("%s %d", strcase.ToDelimited(obj.Name, ' '), i)}
+	case k == "race" || strings.HasSuffix(k, "_race") && f.Type == "string":
+		return pair(f, i, "DWARF", "ELF")
+	case k == "caste" || strings.HasSuffix(k, "_caste") && f.Type == "string":
+		return pair(f, i, "FEMALE", "MALE")
+	case k == "coords":
+		return []interface{}{fmt.Sprintf("%d,%d|%d,%d|%d,%d|%d,%d", x, y, x+1, y, x, y+1, x+1, y+1)}
+	case k == "rectangle":
+		return []interface{}{fmt.Sprintf("%d,%d:%d,%d", x*16, y*16, x*16+15, y*16+15)}
+	case k == "coord_1":
+		return []interface{}{fmt.Sprintf("%d,%d", x, y)}
+	case k == "coord_2":
+		return []interface{}{fmt.Sprintf("%d,%d", x+3, y+3)}
+	case k == "path":
+		return []interface{}{fmt.Sprintf("%d,%d,0,1,100|%d,%d,0,1,100|", x, y, x+1, y)}
+	case k == "end_pos":
+		return []interface{}{fmt.Sprintf("%d,%d", x+1, y)}
+	case f.Type == "string":
+		s := strings.ReplaceAll(k, "_", " ")
+		return pair(f, i, fmt.Sprintf("%s %d", s, i), fmt.Sprintf("%s %d", s, i+1))
+	case strings.HasPrefix(k, "death_") && strings.HasSuffix(k, "year") || strings.HasPrefix(k, "end_") && strings.HasSuffix(k, "year"):
+		if i%2 == 0 {
+			return []interface{}{-1}
+		}
+		return []interface{}{90}
+	case strings.Contains(k, "year"):
+		return []interface{}{10 + i}
+	case strings.Contains(k, "second"):
+		return []interface{}{i % 10 * 40320}
+	case k == "ordinal":
+		return []interface{}{1 + i%3}
+	default:
+		return pair(f, i+fi, 0, 1)
+	}
+}
+
+// pair is a or b by the parity of i, or both for multiple fields.
+func pair(f Field, i int, a, b interface{}) []interface{} {
+	if i%2 == 1 {
+		a, b = b, a
+	}
+	if f.Multiple {
+		return []interface{}{a, b}
+	}
+	return []interface{}{a}
+}
+
+func singular(s string) string {
+	if strings.HasSuffix(s, "ies") {
+		return strings.TrimSuffix(s, "ies") + "y"
+	}
+	return strings.TrimSuffix(s, "s")
+}
+
+func (w *fixtureWriter) historicalEvents() {
+	for _, e := range w.events {
+		obj := w.objects["HistoricalEvent"+e.Type.Name]
+		if w.plus {
+			if e.Type.PlusType == "" {
+				continue
+			}
+			w.open("historical_event")
+			w.element("id", e.Id)
+			w.element("type", e.Type.PlusType)
+		} else {
+			w.open("historical_event")
+			w.element("id", e.Id)
+			w.element("year", e.Year)
+			w.element("seconds72", e.Seconds)
+			w.element("type", e.Type.BaseType)
+		}
+		w.fields(obj, e.Variant)
+		w.close("historical_event")
+	}
+}
+
+func (w *fixtureWriter) historicalEventCollections() {
+	for _, c := range w.collections {
+		obj := w.objects["HistoricalEventCollection"+c.Type.Name]
+		first, last := w.events[c.Events[0]], w.events[c.Events[1]]
+		if last.Id < first.Id {
+			first, last = last, first
+		}
+		w.open("historical_event_collection")
+		w.element("id", c.Id)
+		w.element("start_year", first.Year)
+		w.element("start_seconds72", first.Seconds)
+		w.element("end_year", last.Year)
+		w.element("end_seconds72", last.Seconds)
+		for _, id := range c.Events {
+			w.element("event", id)
+		}
+		for _, id := range c.Children {
+			w.element("eventcol", id)
+		}
+		w.element("type", c.Type.BaseType)
+		keys := util.Keys(obj.Fields)
+		sort.Strings(keys)
+		for fi, k := range keys {
+			f := obj.Fields[k]
+			if !w.active(f) {
+				continue
+			}
+			if id, ok := c.Refs[k]; ok {
+				w.element(k, id)
+			} else {
+				w.field(obj, k, f, c.Variant, fi)
+			}
+		}
+		w.close("historical_event_collection")
+	}
+}
+
+// history lists the figures 0 and 1 as leaders of the entities 0 and 1.
+func (w *fixtureWriter) history() string {
+	var b strings.Builder
+	b.WriteString("Fixture World\nThe Synthetic World\n")
+	for id := 0; id < 2; id++ {
+		fmt.Fprintf(&b, "\nEntity %d, Dwarves\n", id)
+		b.WriteString(" Worship List\n  Historical Figure 1, deity: sphere 1\n Leaders\n")
+		for hf := 0; hf < 2; hf++ {
+			fmt.Fprintf(&b, "  [*] Historical Figure %d (b.1 d. ---, Reign Began: %d), *** Original Line\n", (id+hf)%2, 20+10*hf)
+		}
+	}
+	return b.String()
+}
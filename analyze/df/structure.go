@@ -136,6 +136,8 @@ type AnalyzeContext struct {
 type AdditionalField struct {
 	Name string
 	Type string
+	// NoExport leaves the field out of the json of the object
+	NoExport bool
 }
 
 type Overwrites struct {
@@ -177,10 +179,10 @@ func analyze(file string, a *AnalyzeData) error {
 	fmt.Println("\nAnalyzing", file)
 	defer xmlFile.Close()
 
-	converter := util.NewConvertReader(xmlFile)
-	barReader := bar.NewProxyReader(converter)
+	barReader := bar.NewProxyReader(xmlFile)
+	converter := util.NewConvertReader(barReader)
 
-	_, err = analyzeElement(xml.NewDecoder(barReader), a, make([]string, 0), &ctx)
+	_, err = analyzeElement(xml.NewDecoder(converter), a, make([]string, 0), &ctx)
 	if err != nil {
 		return err
 	}
@@ -207,10 +209,10 @@ func analyze(file string, a *AnalyzeData) error {
 	fmt.Println("\nAnalyzing", file)
 	defer xmlFile.Close()
 
-	converter = util.NewConvertReader(xmlFile)
-	barReader = bar.NewProxyReader(converter)
+	barReader = bar.NewProxyReader(xmlFile)
+	converter = util.NewConvertReader(barReader)
 
-	_, err = analyzeElement(xml.NewDecoder(barReader), a, make([]string, 0), &ctx)
+	_, err = analyzeElement(xml.NewDecoder(converter), a, make([]string, 0), &ctx)
 
 	bar.Finish()
 
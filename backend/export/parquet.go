@@ -0,0 +1,231 @@
+//go:build parquet
+
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/apache/arrow/go/v11/arrow/array"
+	"github.com/apache/arrow/go/v11/arrow/memory"
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/compress"
+	"github.com/apache/arrow/go/v11/parquet/pqarrow"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+)
+
+// rows of a row group
+const parquetRowGroup = 64 * 1024
+
+// ExportParquet writes the world to a directory with a parquet file for
+// every type of object, event and collection. Besides the files of all
+// events and collections, there is a file per type with the columns of the
+// base type, like the year, followed by the columns of the details. Nested
+// objects reference the row of their parent with parent_id, columns with
+// multiple values become lists and enum values are dictionary encoded
+// strings.
+func ExportParquet(world *model.DfWorld, dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	writers := make(map[*Table]*parquetWriter)
+	defer func() {
+		for _, w := range writers {
+			w.file.Close()
+		}
+	}()
+	// the last rows visited, the base rows of the details
+	current := make(map[*Table]reflect.Value)
+
+	err := walk(world, func(t *Table, key, parent int, x reflect.Value) error {
+		current[t] = x
+		w, ok := writers[t]
+		if !ok {
+			var err error
+			if w, err = newParquetWriter(t, filepath.Join(dir, t.Name+".parquet")); err != nil {
+				return fmt.Errorf("%s: %w", t.Name, err)
+			}
+			writers[t] = w
+		}
+		var base reflect.Value
+		if t.SubType {
+			base = current[t.parent]
+		}
+		if err := w.append(key, parent, base, x); err != nil {
+			return fmt.Errorf("%s %d: %w", t.Name, key, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tables {
+		if w, ok := writers[t]; ok {
+			if err := w.close(); err != nil {
+				return fmt.Errorf("%s: %w", t.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+type parquetColumn struct {
+	*Column
+	// base columns are read from the base object of details
+	base bool
+}
+
+type parquetWriter struct {
+	table   *Table
+	file    *os.File
+	writer  *pqarrow.FileWriter
+	builder *array.RecordBuilder
+	columns []parquetColumn
+	rows    int
+}
+
+func parquetType(c *Column) arrow.DataType {
+	var t arrow.DataType
+	switch c.Kind {
+	case Int:
+		t = arrow.PrimitiveTypes.Int64
+	case Bool:
+		t = arrow.FixedWidthTypes.Boolean
+	default:
+		t = arrow.BinaryTypes.String
+	}
+	if c.Multiple {
+		return arrow.ListOf(t)
+	}
+	return t
+}
+
+func newParquetWriter(t *Table, path string) (*parquetWriter, error) {
+	w := &parquetWriter{table: t}
+
+	fields := []arrow.Field{{Name: t.Key(), Type: arrow.PrimitiveTypes.Int64}}
+	if p := t.ParentKey(); p != "" {
+		fields = append(fields, arrow.Field{Name: p, Type: arrow.PrimitiveTypes.Int64})
+	}
+	names := make(map[string]bool)
+	add := func(c *Column, base bool) {
+		if c.Name == t.Key() || c.Name == t.ParentKey() || names[c.Name] {
+			return
+		}
+		names[c.Name] = true
+		w.columns = append(w.columns, parquetColumn{Column: c, base: base})
+		fields = append(fields, arrow.Field{Name: c.Name, Type: parquetType(c), Nullable: c.Kind == Int || c.Kind == Enum || c.Kind == Type})
+	}
+	if t.SubType {
+		for _, c := range t.parent.Columns {
+			if c.Kind != Type && !c.Multiple {
+				add(c, true)
+			}
+		}
+	}
+	for _, c := range t.Columns {
+		add(c, false)
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	opts := []parquet.WriterProperty{
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithDictionaryDefault(false),
+		parquet.WithMaxRowGroupLength(parquetRowGroup),
+	}
+	for _, c := range w.columns {
+		if c.Kind == Enum || c.Kind == Type {
+			path := c.Name
+			if c.Multiple {
+				path += ".list.element"
+			}
+			opts = append(opts, parquet.WithDictionaryFor(path, true))
+		}
+	}
+
+	var err error
+	if w.file, err = os.Create(path); err != nil {
+		return nil, err
+	}
+	w.writer, err = pqarrow.NewFileWriter(schema, w.file, parquet.NewWriterProperties(opts...), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	w.builder = array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	return w, nil
+}
+
+func (w *parquetWriter) append(key, parent int, base, x reflect.Value) error {
+	i := 0
+	next := func() array.Builder {
+		b := w.builder.Field(i)
+		i++
+		return b
+	}
+	next().(*array.Int64Builder).Append(int64(key))
+	if w.table.ParentKey() != "" {
+		next().(*array.Int64Builder).Append(int64(parent))
+	}
+
+	for _, c := range w.columns {
+		v := x
+		if c.base {
+			v = base
+		}
+		b := next()
+		if c.Multiple {
+			l := b.(*array.ListBuilder)
+			l.Append(true)
+			for _, value := range c.Values(v) {
+				appendParquet(l.ValueBuilder(), c.Column, value)
+			}
+		} else {
+			appendParquet(b, c.Column, c.Value(v))
+		}
+	}
+
+	w.rows++
+	if w.rows == parquetRowGroup {
+		return w.flush()
+	}
+	return nil
+}
+
+func appendParquet(b array.Builder, c *Column, v any) {
+	if v == nil {
+		b.AppendNull()
+		return
+	}
+	switch c.Kind {
+	case Int:
+		b.(*array.Int64Builder).Append(v.(int64))
+	case Bool:
+		b.(*array.BooleanBuilder).Append(v.(bool))
+	case Enum:
+		b.(*array.StringBuilder).Append(enums[c.Enum][v.(int64)])
+	default:
+		b.(*array.StringBuilder).Append(v.(string))
+	}
+}
+
+func (w *parquetWriter) flush() error {
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+	w.rows = 0
+	return w.writer.Write(rec)
+}
+
+func (w *parquetWriter) close() error {
+	defer w.builder.Release()
+	if w.rows > 0 {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	return w.writer.Close()
+}
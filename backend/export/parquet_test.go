@@ -0,0 +1,101 @@
+//go:build parquet
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/apache/arrow/go/v11/arrow"
+	"github.com/apache/arrow/go/v11/arrow/array"
+	"github.com/apache/arrow/go/v11/arrow/memory"
+	"github.com/apache/arrow/go/v11/parquet/pqarrow"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+	"golang.org/x/exp/slices"
+)
+
+func readParquet(t *testing.T, path string) arrow.Table {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	table, err := pqarrow.ReadTable(context.Background(), f, nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return table
+}
+
+// ints reads an int column of a table.
+func ints(t *testing.T, table arrow.Table, name string) []int {
+	t.Helper()
+	fields := table.Schema().FieldIndices(name)
+	if len(fields) == 0 {
+		t.Fatalf("no column %s", name)
+	}
+	var list []int
+	for _, chunk := range table.Column(fields[0]).Data().Chunks() {
+		c := chunk.(*array.Int64)
+		for i := 0; i < c.Len(); i++ {
+			list = append(list, int(c.Value(i)))
+		}
+	}
+	sort.Ints(list)
+	return list
+}
+
+func TestExportParquet(t *testing.T) {
+	world := parseFixture(t)
+	dir := t.TempDir()
+	if err := ExportParquet(world, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	hfs := readParquet(t, filepath.Join(dir, "historical_figure.parquet"))
+	defer hfs.Release()
+	if int(hfs.NumRows()) != world.HfCount() {
+		t.Errorf("%d figures, want %d", hfs.NumRows(), world.HfCount())
+	}
+	var hfIds []int
+	world.EachHf(func(hf *model.HistoricalFigure) { hfIds = append(hfIds, hf.Id_) })
+	sort.Ints(hfIds)
+	if got := ints(t, hfs, "id"); !slices.Equal(got, hfIds) {
+		t.Errorf("figure ids %v, want %v", got, hfIds)
+	}
+
+	died := readParquet(t, filepath.Join(dir, "historical_event_hf_died.parquet"))
+	defer died.Release()
+	var ids, victims []int
+	world.EachEvent(func(e *model.HistoricalEvent) {
+		if d, ok := e.Details.(*model.HistoricalEventHfDied); ok {
+			ids = append(ids, e.Id_)
+			victims = append(victims, d.Hfid)
+		}
+	})
+	sort.Ints(ids)
+	sort.Ints(victims)
+	if len(ids) == 0 {
+		t.Fatal("no hf died events in the fixture")
+	}
+	if got := ints(t, died, "id"); !slices.Equal(got, ids) {
+		t.Errorf("hf died ids %v, want %v", got, ids)
+	}
+	if got := ints(t, died, "hfid"); !slices.Equal(got, victims) {
+		t.Errorf("hf died hfids %v, want %v", got, victims)
+	}
+	// columns of the base type
+	if len(died.Schema().FieldIndices("year")) == 0 {
+		t.Error("no year column in hf died")
+	}
+
+	rivers := readParquet(t, filepath.Join(dir, "river.parquet"))
+	defer rivers.Release()
+	if int(rivers.NumRows()) != len(world.Rivers) || len(world.Rivers) == 0 {
+		t.Errorf("%d rivers, want %d", rivers.NumRows(), len(world.Rivers))
+	}
+}
@@ -0,0 +1,3396 @@
+// Code generated by legendsbrowser; DO NOT EDIT.
+package export
+
+var tables = []*Table{
+	{
+		Name: "df_world",
+		Type: "DfWorld",
+		Columns: []*Column{
+			{Name: "altname", Field: "Altname", Kind: String},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "end_year", Field: "EndYear", Kind: Int},
+			{Name: "file_path", Field: "FilePath", Kind: String},
+			{Name: "height", Field: "Height", Kind: Int},
+			{Name: "map_ready", Field: "MapReady", Kind: Bool},
+			{Name: "plus", Field: "Plus", Kind: Bool},
+			{Name: "plus_file_path", Field: "PlusFilePath", Kind: String},
+			{Name: "width", Field: "Width", Kind: Int},
+		},
+	},
+	{
+		Name:   "artifact",
+		Type:   "Artifact",
+		Field:  "Artifacts",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "abs_tile_x", Field: "AbsTileX", Kind: Int},
+			{Name: "abs_tile_y", Field: "AbsTileY", Kind: Int},
+			{Name: "abs_tile_z", Field: "AbsTileZ", Kind: Int},
+			{Name: "holder_hfid", Field: "HolderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "item_description", Field: "ItemDescription", Kind: String},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: String},
+			{Name: "item_type", Field: "ItemType", Kind: String},
+			{Name: "mat", Field: "Mat", Kind: String},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "page_count", Field: "PageCount", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_local_id", Field: "StructureLocalId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "writing", Field: "Writing", Kind: Int},
+		},
+	},
+	{
+		Name:   "item",
+		Type:   "Item",
+		Field:  "Item",
+		Parent: "artifact",
+		Columns: []*Column{
+			{Name: "name_string", Field: "NameString", Kind: String},
+			{Name: "page_number", Field: "PageNumber", Kind: Int},
+			{Name: "page_written_content_id", Field: "PageWrittenContentId", Kind: Int},
+			{Name: "writing_written_content_id", Field: "WritingWrittenContentId", Kind: Int},
+		},
+	},
+	{
+		Name:   "creature",
+		Type:   "Creature",
+		Field:  "CreatureRaw",
+		Parent: "df_world",
+		Columns: []*Column{
+			{Name: "all_castes_alive", Field: "AllCastesAlive", Kind: Bool},
+			{Name: "artificial_hiveable", Field: "ArtificialHiveable", Kind: Bool},
+			{Name: "biome_desert_badland", Field: "BiomeDesertBadland", Kind: Bool},
+			{Name: "biome_desert_rock", Field: "BiomeDesertRock", Kind: Bool},
+			{Name: "biome_desert_sand", Field: "BiomeDesertSand", Kind: Bool},
+			{Name: "biome_forest_taiga", Field: "BiomeForestTaiga", Kind: Bool},
+			{Name: "biome_forest_temperate_broadleaf", Field: "BiomeForestTemperateBroadleaf", Kind: Bool},
+			{Name: "biome_forest_temperate_conifer", Field: "BiomeForestTemperateConifer", Kind: Bool},
+			{Name: "biome_forest_tropical_conifer", Field: "BiomeForestTropicalConifer", Kind: Bool},
+			{Name: "biome_forest_tropical_dry_broadleaf", Field: "BiomeForestTropicalDryBroadleaf", Kind: Bool},
+			{Name: "biome_forest_tropical_moist_broadleaf", Field: "BiomeForestTropicalMoistBroadleaf", Kind: Bool},
+			{Name: "biome_glacier", Field: "BiomeGlacier", Kind: Bool},
+			{Name: "biome_grassland_temperate", Field: "BiomeGrasslandTemperate", Kind: Bool},
+			{Name: "biome_grassland_tropical", Field: "BiomeGrasslandTropical", Kind: Bool},
+			{Name: "biome_lake_temperate_brackishwater", Field: "BiomeLakeTemperateBrackishwater", Kind: Bool},
+			{Name: "biome_lake_temperate_freshwater", Field: "BiomeLakeTemperateFreshwater", Kind: Bool},
+			{Name: "biome_lake_temperate_saltwater", Field: "BiomeLakeTemperateSaltwater", Kind: Bool},
+			{Name: "biome_lake_tropical_brackishwater", Field: "BiomeLakeTropicalBrackishwater", Kind: Bool},
+			{Name: "biome_lake_tropical_freshwater", Field: "BiomeLakeTropicalFreshwater", Kind: Bool},
+			{Name: "biome_lake_tropical_saltwater", Field: "BiomeLakeTropicalSaltwater", Kind: Bool},
+			{Name: "biome_marsh_temperate_freshwater", Field: "BiomeMarshTemperateFreshwater", Kind: Bool},
+			{Name: "biome_marsh_temperate_saltwater", Field: "BiomeMarshTemperateSaltwater", Kind: Bool},
+			{Name: "biome_marsh_tropical_freshwater", Field: "BiomeMarshTropicalFreshwater", Kind: Bool},
+			{Name: "biome_marsh_tropical_saltwater", Field: "BiomeMarshTropicalSaltwater", Kind: Bool},
+			{Name: "biome_mountain", Field: "BiomeMountain", Kind: Bool},
+			{Name: "biome_ocean_arctic", Field: "BiomeOceanArctic", Kind: Bool},
+			{Name: "biome_ocean_temperate", Field: "BiomeOceanTemperate", Kind: Bool},
+			{Name: "biome_ocean_tropical", Field: "BiomeOceanTropical", Kind: Bool},
+			{Name: "biome_pool_temperate_brackishwater", Field: "BiomePoolTemperateBrackishwater", Kind: Bool},
+			{Name: "biome_pool_temperate_freshwater", Field: "BiomePoolTemperateFreshwater", Kind: Bool},
+			{Name: "biome_pool_temperate_saltwater", Field: "BiomePoolTemperateSaltwater", Kind: Bool},
+			{Name: "biome_pool_tropical_brackishwater", Field: "BiomePoolTropicalBrackishwater", Kind: Bool},
+			{Name: "biome_pool_tropical_freshwater", Field: "BiomePoolTropicalFreshwater", Kind: Bool},
+			{Name: "biome_pool_tropical_saltwater", Field: "BiomePoolTropicalSaltwater", Kind: Bool},
+			{Name: "biome_river_temperate_brackishwater", Field: "BiomeRiverTemperateBrackishwater", Kind: Bool},
+			{Name: "biome_river_temperate_freshwater", Field: "BiomeRiverTemperateFreshwater", Kind: Bool},
+			{Name: "biome_river_temperate_saltwater", Field: "BiomeRiverTemperateSaltwater", Kind: Bool},
+			{Name: "biome_river_tropical_brackishwater", Field: "BiomeRiverTropicalBrackishwater", Kind: Bool},
+			{Name: "biome_river_tropical_freshwater", Field: "BiomeRiverTropicalFreshwater", Kind: Bool},
+			{Name: "biome_river_tropical_saltwater", Field: "BiomeRiverTropicalSaltwater", Kind: Bool},
+			{Name: "biome_savanna_temperate", Field: "BiomeSavannaTemperate", Kind: Bool},
+			{Name: "biome_savanna_tropical", Field: "BiomeSavannaTropical", Kind: Bool},
+			{Name: "biome_shrubland_temperate", Field: "BiomeShrublandTemperate", Kind: Bool},
+			{Name: "biome_shrubland_tropical", Field: "BiomeShrublandTropical", Kind: Bool},
+			{Name: "biome_subterranean_chasm", Field: "BiomeSubterraneanChasm", Kind: Bool},
+			{Name: "biome_subterranean_lava", Field: "BiomeSubterraneanLava", Kind: Bool},
+			{Name: "biome_subterranean_water", Field: "BiomeSubterraneanWater", Kind: Bool},
+			{Name: "biome_swamp_mangrove", Field: "BiomeSwampMangrove", Kind: Bool},
+			{Name: "biome_swamp_temperate_freshwater", Field: "BiomeSwampTemperateFreshwater", Kind: Bool},
+			{Name: "biome_swamp_temperate_saltwater", Field: "BiomeSwampTemperateSaltwater", Kind: Bool},
+			{Name: "biome_swamp_tropical_freshwater", Field: "BiomeSwampTropicalFreshwater", Kind: Bool},
+			{Name: "biome_swamp_tropical_saltwater", Field: "BiomeSwampTropicalSaltwater", Kind: Bool},
+			{Name: "biome_tundra", Field: "BiomeTundra", Kind: Bool},
+			{Name: "creature_id", Field: "CreatureId", Kind: String},
+			{Name: "does_not_exist", Field: "DoesNotExist", Kind: Bool},
+			{Name: "equipment", Field: "Equipment", Kind: Bool},
+			{Name: "equipment_wagon", Field: "EquipmentWagon", Kind: Bool},
+			{Name: "evil", Field: "Evil", Kind: Bool},
+			{Name: "fanciful", Field: "Fanciful", Kind: Bool},
+			{Name: "generated", Field: "Generated", Kind: Bool},
+			{Name: "good", Field: "Good", Kind: Bool},
+			{Name: "has_any_benign", Field: "HasAnyBenign", Kind: Bool},
+			{Name: "has_any_can_swim", Field: "HasAnyCanSwim", Kind: Bool},
+			{Name: "has_any_cannot_breathe_air", Field: "HasAnyCannotBreatheAir", Kind: Bool},
+			{Name: "has_any_cannot_breathe_water", Field: "HasAnyCannotBreatheWater", Kind: Bool},
+			{Name: "has_any_carnivore", Field: "HasAnyCarnivore", Kind: Bool},
+			{Name: "has_any_common_domestic", Field: "HasAnyCommonDomestic", Kind: Bool},
+			{Name: "has_any_curious_beast", Field: "HasAnyCuriousBeast", Kind: Bool},
+			{Name: "has_any_demon", Field: "HasAnyDemon", Kind: Bool},
+			{Name: "has_any_feature_beast", Field: "HasAnyFeatureBeast", Kind: Bool},
+			{Name: "has_any_flier", Field: "HasAnyFlier", Kind: Bool},
+			{Name: "has_any_fly_race_gait", Field: "HasAnyFlyRaceGait", Kind: Bool},
+			{Name: "has_any_grasp", Field: "HasAnyGrasp", Kind: Bool},
+			{Name: "has_any_grazer", Field: "HasAnyGrazer", Kind: Bool},
+			{Name: "has_any_has_blood", Field: "HasAnyHasBlood", Kind: Bool},
+			{Name: "has_any_immobile", Field: "HasAnyImmobile", Kind: Bool},
+			{Name: "has_any_intelligent_learns", Field: "HasAnyIntelligentLearns", Kind: Bool},
+			{Name: "has_any_intelligent_speaks", Field: "HasAnyIntelligentSpeaks", Kind: Bool},
+			{Name: "has_any_large_predator", Field: "HasAnyLargePredator", Kind: Bool},
+			{Name: "has_any_local_pops_controllable", Field: "HasAnyLocalPopsControllable", Kind: Bool},
+			{Name: "has_any_local_pops_produce_heroes", Field: "HasAnyLocalPopsProduceHeroes", Kind: Bool},
+			{Name: "has_any_megabeast", Field: "HasAnyMegabeast", Kind: Bool},
+			{Name: "has_any_mischievous", Field: "HasAnyMischievous", Kind: Bool},
+			{Name: "has_any_natural_animal", Field: "HasAnyNaturalAnimal", Kind: Bool},
+			{Name: "has_any_night_creature", Field: "HasAnyNightCreature", Kind: Bool},
+			{Name: "has_any_night_creature_bogeyman", Field: "HasAnyNightCreatureBogeyman", Kind: Bool},
+			{Name: "has_any_night_creature_hunter", Field: "HasAnyNightCreatureHunter", Kind: Bool},
+			{Name: "has_any_night_creature_nightmare", Field: "HasAnyNightCreatureNightmare", Kind: Bool},
+			{Name: "has_any_not_fireimmune", Field: "HasAnyNotFireimmune", Kind: Bool},
+			{Name: "has_any_not_living", Field: "HasAnyNotLiving", Kind: Bool},
+			{Name: "has_any_outsider_controllable", Field: "HasAnyOutsiderControllable", Kind: Bool},
+			{Name: "has_any_race_gait", Field: "HasAnyRaceGait", Kind: Bool},
+			{Name: "has_any_semimegabeast", Field: "HasAnySemimegabeast", Kind: Bool},
+			{Name: "has_any_slow_learner", Field: "HasAnySlowLearner", Kind: Bool},
+			{Name: "has_any_supernatural", Field: "HasAnySupernatural", Kind: Bool},
+			{Name: "has_any_titan", Field: "HasAnyTitan", Kind: Bool},
+			{Name: "has_any_unique_demon", Field: "HasAnyUniqueDemon", Kind: Bool},
+			{Name: "has_any_utterances", Field: "HasAnyUtterances", Kind: Bool},
+			{Name: "has_any_vermin_hateable", Field: "HasAnyVerminHateable", Kind: Bool},
+			{Name: "has_any_vermin_micro", Field: "HasAnyVerminMicro", Kind: Bool},
+			{Name: "has_female", Field: "HasFemale", Kind: Bool},
+			{Name: "has_male", Field: "HasMale", Kind: Bool},
+			{Name: "large_roaming", Field: "LargeRoaming", Kind: Bool},
+			{Name: "loose_clusters", Field: "LooseClusters", Kind: Bool},
+			{Name: "mates_to_breed", Field: "MatesToBreed", Kind: Bool},
+			{Name: "mundane", Field: "Mundane", Kind: Bool},
+			{Name: "name_plural", Field: "NamePlural", Kind: String},
+			{Name: "name_singular", Field: "NameSingular", Kind: String},
+			{Name: "occurs_as_entity_race", Field: "OccursAsEntityRace", Kind: Bool},
+			{Name: "savage", Field: "Savage", Kind: Bool},
+			{Name: "small_race", Field: "SmallRace", Kind: Bool},
+			{Name: "two_genders", Field: "TwoGenders", Kind: Bool},
+			{Name: "ubiquitous", Field: "Ubiquitous", Kind: Bool},
+			{Name: "vermin_eater", Field: "VerminEater", Kind: Bool},
+			{Name: "vermin_fish", Field: "VerminFish", Kind: Bool},
+			{Name: "vermin_grounder", Field: "VerminGrounder", Kind: Bool},
+			{Name: "vermin_rotter", Field: "VerminRotter", Kind: Bool},
+			{Name: "vermin_soil", Field: "VerminSoil", Kind: Bool},
+			{Name: "vermin_soil_colony", Field: "VerminSoilColony", Kind: Bool},
+		},
+	},
+	{
+		Name:   "dance_form",
+		Type:   "DanceForm",
+		Field:  "DanceForms",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "description", Field: "Description", Kind: String},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "entity",
+		Type:   "Entity",
+		Field:  "Entities",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "child", Field: "Child", Kind: Int, Multiple: true},
+			{Name: "claims", Field: "Claims", Kind: String},
+			{Name: "histfig_id", Field: "HistfigId", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "profession", Field: "Profession", Kind: Enum, Enum: "EntityProfession"},
+			{Name: "race", Field: "Race", Kind: String},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "EntityType"},
+			{Name: "weapon", Field: "Weapon", Kind: Enum, Multiple: true, Enum: "EntityWeapon"},
+			{Name: "worship_id", Field: "WorshipId", Kind: Int, Multiple: true},
+			{Name: "necromancer", Field: "Necromancer", Kind: Bool},
+			{Name: "parent", Field: "Parent", Kind: Int},
+			{Name: "sites", Field: "Sites", Kind: Int, Multiple: true},
+		},
+	},
+	{
+		Name:   "entity_entity_link",
+		Type:   "EntityEntityLink",
+		Field:  "EntityLink",
+		Parent: "entity",
+		Columns: []*Column{
+			{Name: "strength", Field: "Strength", Kind: Int},
+			{Name: "target", Field: "Target", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "EntityEntityLinkType"},
+		},
+	},
+	{
+		Name:   "entity_position",
+		Type:   "EntityPosition",
+		Field:  "EntityPosition",
+		Parent: "entity",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "name_female", Field: "NameFemale", Kind: String},
+			{Name: "name_male", Field: "NameMale", Kind: String},
+			{Name: "spouse", Field: "Spouse", Kind: String},
+			{Name: "spouse_female", Field: "SpouseFemale", Kind: String},
+			{Name: "spouse_male", Field: "SpouseMale", Kind: String},
+		},
+	},
+	{
+		Name:   "entity_position_assignment",
+		Type:   "EntityPositionAssignment",
+		Field:  "EntityPositionAssignment",
+		Parent: "entity",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "position_id", Field: "PositionId", Kind: Int},
+			{Name: "squad_id", Field: "SquadId", Kind: Int},
+		},
+	},
+	{
+		Name:   "honor",
+		Type:   "Honor",
+		Field:  "Honor",
+		Parent: "entity",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "exempt_epid", Field: "ExemptEpid", Kind: Int},
+			{Name: "exempt_former_epid", Field: "ExemptFormerEpid", Kind: Int},
+			{Name: "gives_precedence", Field: "GivesPrecedence", Kind: Int},
+			{Name: "granted_to_everybody", Field: "GrantedToEverybody", Kind: Bool},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "required_battles", Field: "RequiredBattles", Kind: Int},
+			{Name: "required_kills", Field: "RequiredKills", Kind: Int},
+			{Name: "required_skill", Field: "RequiredSkill", Kind: Enum, Enum: "HonorRequiredSkill"},
+			{Name: "required_skill_ip_total", Field: "RequiredSkillIpTotal", Kind: Int},
+			{Name: "required_years", Field: "RequiredYears", Kind: Int},
+			{Name: "requires_any_melee_or_ranged_skill", Field: "RequiresAnyMeleeOrRangedSkill", Kind: Bool},
+		},
+	},
+	{
+		Name:   "occasion",
+		Type:   "Occasion",
+		Field:  "Occasion",
+		Parent: "entity",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "event", Field: "Event", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "schedule",
+		Type:   "Schedule",
+		Field:  "Schedule",
+		Parent: "occasion",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: Enum, Enum: "ScheduleItemSubtype"},
+			{Name: "item_type", Field: "ItemType", Kind: Enum, Enum: "ScheduleItemType"},
+			{Name: "reference", Field: "Reference", Kind: Int},
+			{Name: "reference_2", Field: "Reference2", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "ScheduleType"},
+		},
+	},
+	{
+		Name:   "feature",
+		Type:   "Feature",
+		Field:  "Feature",
+		Parent: "schedule",
+		Columns: []*Column{
+			{Name: "reference", Field: "Reference", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "FeatureType"},
+		},
+	},
+	{
+		Name:   "entity_population",
+		Type:   "EntityPopulation",
+		Field:  "EntityPopulations",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "race", Field: "Race", Kind: String},
+		},
+	},
+	{
+		Name:   "historical_era",
+		Type:   "HistoricalEra",
+		Field:  "HistoricalEras",
+		Parent: "df_world",
+		Columns: []*Column{
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "start_year", Field: "StartYear", Kind: Int},
+		},
+	},
+	{
+		Name:   "historical_event_collection",
+		Type:   "HistoricalEventCollection",
+		Field:  "HistoricalEventCollections",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "end_seconds_72", Field: "EndSeconds72", Kind: Int},
+			{Name: "end_year", Field: "EndYear", Kind: Int},
+			{Name: "event", Field: "Event", Kind: Int, Multiple: true},
+			{Name: "eventcol", Field: "Eventcol", Kind: Int, Multiple: true},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "start_seconds_72", Field: "StartSeconds72", Kind: Int},
+			{Name: "start_year", Field: "StartYear", Kind: Int},
+			{Name: "type", Field: "Details", Kind: Type},
+		},
+	},
+	{
+		Name:    "historical_event_collection_abduction",
+		Type:    "HistoricalEventCollectionAbduction",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacking_enid", Field: "AttackingEnid", Kind: Int, References: "entity"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "defending_enid", Field: "DefendingEnid", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "parent_eventcol", Field: "ParentEventcol", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "target_hfids", Field: "TargetHfids", Kind: Int, Multiple: true},
+		},
+	},
+	{
+		Name:    "historical_event_collection_battle",
+		Type:    "HistoricalEventCollectionBattle",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "a_support_merc_enid", Field: "ASupportMercEnid", Kind: Int, References: "entity"},
+			{Name: "a_support_merc_hfid", Field: "ASupportMercHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "attacking_hfid", Field: "AttackingHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "attacking_merc_enid", Field: "AttackingMercEnid", Kind: Int, References: "entity"},
+			{Name: "attacking_squad_animated", Field: "AttackingSquadAnimated", Kind: Bool, Multiple: true},
+			{Name: "attacking_squad_deaths", Field: "AttackingSquadDeaths", Kind: Int, Multiple: true},
+			{Name: "attacking_squad_entity_pop", Field: "AttackingSquadEntityPop", Kind: Int, Multiple: true},
+			{Name: "attacking_squad_number", Field: "AttackingSquadNumber", Kind: Int, Multiple: true},
+			{Name: "attacking_squad_race", Field: "AttackingSquadRace", Kind: String, Multiple: true},
+			{Name: "attacking_squad_site", Field: "AttackingSquadSite", Kind: Int, Multiple: true, References: "site"},
+			{Name: "company_merc", Field: "CompanyMerc", Kind: Bool, Multiple: true},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "d_support_merc_enid", Field: "DSupportMercEnid", Kind: Int, References: "entity"},
+			{Name: "d_support_merc_hfid", Field: "DSupportMercHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "defending_hfid", Field: "DefendingHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "defending_merc_enid", Field: "DefendingMercEnid", Kind: Int, References: "entity"},
+			{Name: "defending_squad_animated", Field: "DefendingSquadAnimated", Kind: Bool, Multiple: true},
+			{Name: "defending_squad_deaths", Field: "DefendingSquadDeaths", Kind: Int, Multiple: true},
+			{Name: "defending_squad_entity_pop", Field: "DefendingSquadEntityPop", Kind: Int, Multiple: true},
+			{Name: "defending_squad_number", Field: "DefendingSquadNumber", Kind: Int, Multiple: true},
+			{Name: "defending_squad_race", Field: "DefendingSquadRace", Kind: String, Multiple: true},
+			{Name: "defending_squad_site", Field: "DefendingSquadSite", Kind: Int, Multiple: true, References: "site"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "individual_merc", Field: "IndividualMerc", Kind: Bool, Multiple: true},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "noncom_hfid", Field: "NoncomHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "outcome", Field: "Outcome", Kind: Enum, Enum: "HistoricalEventCollectionBattleOutcome"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "war_eventcol", Field: "WarEventcol", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_beast_attack",
+		Type:    "HistoricalEventCollectionBeastAttack",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "defending_enid", Field: "DefendingEnid", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "parent_eventcol", Field: "ParentEventcol", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "attacker_hf_ids", Field: "AttackerHfIds", Kind: Int, Multiple: true},
+		},
+	},
+	{
+		Name:    "historical_event_collection_ceremony",
+		Type:    "HistoricalEventCollectionCeremony",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "occasion_eventcol", Field: "OccasionEventcol", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_competition",
+		Type:    "HistoricalEventCollectionCompetition",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "occasion_eventcol", Field: "OccasionEventcol", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_duel",
+		Type:    "HistoricalEventCollectionDuel",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacking_hfid", Field: "AttackingHfid", Kind: Int, References: "historical_figure"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "defending_hfid", Field: "DefendingHfid", Kind: Int, References: "historical_figure"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "parent_eventcol", Field: "ParentEventcol", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_entity_overthrown",
+		Type:    "HistoricalEventCollectionEntityOverthrown",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "target_entity_id", Field: "TargetEntityId", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_insurrection",
+		Type:    "HistoricalEventCollectionInsurrection",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "target_enid", Field: "TargetEnid", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_journey",
+		Type:    "HistoricalEventCollectionJourney",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "traveller_hf_ids", Field: "TravellerHfIds", Kind: Int, Multiple: true},
+		},
+	},
+	{
+		Name:    "historical_event_collection_occasion",
+		Type:    "HistoricalEventCollectionOccasion",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "occasion_id", Field: "OccasionId", Kind: Int},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_performance",
+		Type:    "HistoricalEventCollectionPerformance",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "occasion_eventcol", Field: "OccasionEventcol", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_persecution",
+		Type:    "HistoricalEventCollectionPersecution",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "target_entity_id", Field: "TargetEntityId", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_procession",
+		Type:    "HistoricalEventCollectionProcession",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "occasion_eventcol", Field: "OccasionEventcol", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_purge",
+		Type:    "HistoricalEventCollectionPurge",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "adjective", Field: "Adjective", Kind: Enum, Enum: "HistoricalEventCollectionPurgeAdjective"},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_raid",
+		Type:    "HistoricalEventCollectionRaid",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacking_enid", Field: "AttackingEnid", Kind: Int, References: "entity"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "defending_enid", Field: "DefendingEnid", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "parent_eventcol", Field: "ParentEventcol", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_site_conquered",
+		Type:    "HistoricalEventCollectionSiteConquered",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacking_enid", Field: "AttackingEnid", Kind: Int, References: "entity"},
+			{Name: "defending_enid", Field: "DefendingEnid", Kind: Int, References: "entity"},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "war_eventcol", Field: "WarEventcol", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_collection_theft",
+		Type:    "HistoricalEventCollectionTheft",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacking_enid", Field: "AttackingEnid", Kind: Int, References: "entity"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "defending_enid", Field: "DefendingEnid", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "ordinal", Field: "Ordinal", Kind: Int},
+			{Name: "parent_eventcol", Field: "ParentEventcol", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_collection_war",
+		Type:    "HistoricalEventCollectionWar",
+		Field:   "Details",
+		Parent:  "historical_event_collection",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "aggressor_ent_id", Field: "AggressorEntId", Kind: Int},
+			{Name: "defender_ent_id", Field: "DefenderEntId", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "historical_event_relationship_supplement",
+		Type:   "HistoricalEventRelationshipSupplement",
+		Field:  "HistoricalEventRelationshipSupplements",
+		Parent: "df_world",
+		Columns: []*Column{
+			{Name: "event", Field: "Event", Kind: Int},
+			{Name: "occasion_type", Field: "OccasionType", Kind: Int},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "unk_1", Field: "Unk1", Kind: Int},
+		},
+	},
+	{
+		Name:   "historical_event_relationship",
+		Type:   "HistoricalEventRelationship",
+		Field:  "HistoricalEventRelationships",
+		Parent: "df_world",
+		Columns: []*Column{
+			{Name: "event", Field: "Event", Kind: Int},
+			{Name: "relationship", Field: "Relationship", Kind: Enum, Enum: "HistoricalEventRelationshipRelationship"},
+			{Name: "source_hf", Field: "SourceHf", Kind: Int, References: "historical_figure"},
+			{Name: "target_hf", Field: "TargetHf", Kind: Int, References: "historical_figure"},
+			{Name: "year", Field: "Year", Kind: Int},
+		},
+	},
+	{
+		Name:   "historical_event",
+		Type:   "HistoricalEvent",
+		Field:  "HistoricalEvents",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "seconds_72", Field: "Seconds72", Kind: Int},
+			{Name: "year", Field: "Year", Kind: Int},
+			{Name: "type", Field: "Details", Kind: Type},
+			{Name: "collection", Field: "Collection", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_add_hf_entity_honor",
+		Type:    "HistoricalEventAddHfEntityHonor",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "honor_id", Field: "HonorId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_add_hf_entity_link",
+		Type:    "HistoricalEventAddHfEntityLink",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "appointer_hfid", Field: "AppointerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "link", Field: "Link", Kind: Enum, Enum: "HistoricalEventAddHfEntityLinkLink"},
+			{Name: "position", Field: "Position", Kind: String},
+			{Name: "position_id", Field: "PositionId", Kind: Int},
+			{Name: "promise_to_hfid", Field: "PromiseToHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_add_hf_hf_link",
+		Type:    "HistoricalEventAddHfHfLink",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "hfid_target", Field: "HfidTarget", Kind: Int, References: "historical_figure"},
+			{Name: "link_type", Field: "LinkType", Kind: Enum, Enum: "HistoricalEventAddHfHfLinkLinkType"},
+		},
+	},
+	{
+		Name:    "historical_event_add_hf_site_link",
+		Type:    "HistoricalEventAddHfSiteLink",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ", Field: "Civ", Kind: Int, References: "entity"},
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "link_type", Field: "LinkType", Kind: Enum, Enum: "HistoricalEventAddHfSiteLinkLinkType"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure", Field: "Structure", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_agreement_concluded",
+		Type:    "HistoricalEventAgreementConcluded",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "destination", Field: "Destination", Kind: Int, References: "entity"},
+			{Name: "result", Field: "Result", Kind: Int},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "source", Field: "Source", Kind: Int, References: "entity"},
+			{Name: "topic", Field: "Topic", Kind: Enum, Enum: "HistoricalEventAgreementConcludedTopic"},
+		},
+	},
+	{
+		Name:    "historical_event_agreement_formed",
+		Type:    "HistoricalEventAgreementFormed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventAgreementFormedAction"},
+			{Name: "agreement_id", Field: "AgreementId", Kind: Int},
+			{Name: "agreement_subject_id", Field: "AgreementSubjectId", Kind: Int},
+			{Name: "ally_defense_bonus", Field: "AllyDefenseBonus", Kind: Int},
+			{Name: "coconspirator_bonus", Field: "CoconspiratorBonus", Kind: Int},
+			{Name: "concluder_hfid", Field: "ConcluderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "delegated", Field: "Delegated", Kind: Bool},
+			{Name: "failed_judgment_test", Field: "FailedJudgmentTest", Kind: Bool},
+			{Name: "method", Field: "Method", Kind: Enum, Enum: "HistoricalEventAgreementFormedMethod"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventAgreementFormedReason"},
+			{Name: "relevant_entity_id", Field: "RelevantEntityId", Kind: Int, References: "entity"},
+			{Name: "relevant_id_for_method", Field: "RelevantIdForMethod", Kind: Int},
+			{Name: "relevant_position_profile_id", Field: "RelevantPositionProfileId", Kind: Int},
+			{Name: "successful", Field: "Successful", Kind: Bool},
+			{Name: "top_facet", Field: "TopFacet", Kind: Enum, Enum: "HistoricalEventAgreementFormedTopFacet"},
+			{Name: "top_facet_modifier", Field: "TopFacetModifier", Kind: Int},
+			{Name: "top_facet_rating", Field: "TopFacetRating", Kind: Int},
+			{Name: "top_relationship_factor", Field: "TopRelationshipFactor", Kind: Enum, Enum: "HistoricalEventAgreementFormedTopRelationshipFactor"},
+			{Name: "top_relationship_modifier", Field: "TopRelationshipModifier", Kind: Int},
+			{Name: "top_relationship_rating", Field: "TopRelationshipRating", Kind: Int},
+			{Name: "top_value", Field: "TopValue", Kind: Enum, Enum: "HistoricalEventAgreementFormedTopValue"},
+			{Name: "top_value_modifier", Field: "TopValueModifier", Kind: Int},
+			{Name: "top_value_rating", Field: "TopValueRating", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_agreement_made",
+		Type:    "HistoricalEventAgreementMade",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "destination", Field: "Destination", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "source", Field: "Source", Kind: Int, References: "entity"},
+			{Name: "topic", Field: "Topic", Kind: Enum, Enum: "HistoricalEventAgreementMadeTopic"},
+		},
+	},
+	{
+		Name:    "historical_event_agreement_rejected",
+		Type:    "HistoricalEventAgreementRejected",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "destination", Field: "Destination", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "source", Field: "Source", Kind: Int, References: "entity"},
+			{Name: "topic", Field: "Topic", Kind: Enum, Enum: "HistoricalEventAgreementRejectedTopic"},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_claim_formed",
+		Type:    "HistoricalEventArtifactClaimFormed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventArtifactClaimFormedCircumstance"},
+			{Name: "claim", Field: "Claim", Kind: Enum, Enum: "HistoricalEventArtifactClaimFormedClaim"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "position_profile_id", Field: "PositionProfileId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_copied",
+		Type:    "HistoricalEventArtifactCopied",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "dest_entity_id", Field: "DestEntityId", Kind: Int, References: "entity"},
+			{Name: "dest_site_id", Field: "DestSiteId", Kind: Int, References: "site"},
+			{Name: "dest_structure_id", Field: "DestStructureId", Kind: Int},
+			{Name: "from_original", Field: "FromOriginal", Kind: Bool},
+			{Name: "source_entity_id", Field: "SourceEntityId", Kind: Int, References: "entity"},
+			{Name: "source_site_id", Field: "SourceSiteId", Kind: Int, References: "site"},
+			{Name: "source_structure_id", Field: "SourceStructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_created",
+		Type:    "HistoricalEventArtifactCreated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "name_only", Field: "NameOnly", Kind: Bool},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventArtifactCreatedReason"},
+			{Name: "sanctify_hf", Field: "SanctifyHf", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "unit_id", Field: "UnitId", Kind: Int},
+		},
+	},
+	{
+		Name:   "historical_event_artifact_created_circumstance",
+		Type:   "HistoricalEventArtifactCreatedCircumstance",
+		Field:  "Circumstance",
+		Parent: "historical_event_artifact_created",
+		Columns: []*Column{
+			{Name: "defeated", Field: "Defeated", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "HistoricalEventArtifactCreatedCircumstanceType"},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_destroyed",
+		Type:    "HistoricalEventArtifactDestroyed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "destroyer_enid", Field: "DestroyerEnid", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_found",
+		Type:    "HistoricalEventArtifactFound",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "site_property_id", Field: "SitePropertyId", Kind: Int},
+			{Name: "unit_id", Field: "UnitId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_given",
+		Type:    "HistoricalEventArtifactGiven",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "giver_entity_id", Field: "GiverEntityId", Kind: Int, References: "entity"},
+			{Name: "giver_hist_figure_id", Field: "GiverHistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "inherited", Field: "Inherited", Kind: Bool},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventArtifactGivenReason"},
+			{Name: "receiver_entity_id", Field: "ReceiverEntityId", Kind: Int, References: "entity"},
+			{Name: "receiver_hist_figure_id", Field: "ReceiverHistFigureId", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_lost",
+		Type:    "HistoricalEventArtifactLost",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "site_property_id", Field: "SitePropertyId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_possessed",
+		Type:    "HistoricalEventArtifactPossessed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventArtifactPossessedCircumstance"},
+			{Name: "circumstance_id", Field: "CircumstanceId", Kind: Int},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventArtifactPossessedReason"},
+			{Name: "reason_id", Field: "ReasonId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "unit_id", Field: "UnitId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_recovered",
+		Type:    "HistoricalEventArtifactRecovered",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "unit_id", Field: "UnitId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_stored",
+		Type:    "HistoricalEventArtifactStored",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "unit_id", Field: "UnitId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_artifact_transformed",
+		Type:    "HistoricalEventArtifactTransformed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "new_artifact_id", Field: "NewArtifactId", Kind: Int, References: "artifact"},
+			{Name: "old_artifact_id", Field: "OldArtifactId", Kind: Int, References: "artifact"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "unit_id", Field: "UnitId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_assume_identity",
+		Type:    "HistoricalEventAssumeIdentity",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "identity_caste", Field: "IdentityCaste", Kind: String},
+			{Name: "identity_id", Field: "IdentityId", Kind: Int, References: "identity"},
+			{Name: "identity_name", Field: "IdentityName", Kind: String},
+			{Name: "identity_nemesis_id", Field: "IdentityNemesisId", Kind: Int, References: "identity"},
+			{Name: "identity_race", Field: "IdentityRace", Kind: String},
+			{Name: "target_enid", Field: "TargetEnid", Kind: Int, References: "entity"},
+			{Name: "trickster_hfid", Field: "TricksterHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_attacked_site",
+		Type:    "HistoricalEventAttackedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "a_support_merc_enid", Field: "ASupportMercEnid", Kind: Int, References: "entity"},
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "attacker_general_hfid", Field: "AttackerGeneralHfid", Kind: Int, References: "historical_figure"},
+			{Name: "attacker_merc_enid", Field: "AttackerMercEnid", Kind: Int, References: "entity"},
+			{Name: "d_support_merc_enid", Field: "DSupportMercEnid", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "defender_general_hfid", Field: "DefenderGeneralHfid", Kind: Int, References: "historical_figure"},
+			{Name: "defender_merc_enid", Field: "DefenderMercEnid", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_body_abused",
+		Type:    "HistoricalEventBodyAbused",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "abuse_type", Field: "AbuseType", Kind: Enum, Enum: "HistoricalEventBodyAbusedAbuseType"},
+			{Name: "bodies", Field: "Bodies", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "civ", Field: "Civ", Kind: Int, References: "entity"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "interaction", Field: "Interaction", Kind: Int},
+			{Name: "item_mat", Field: "ItemMat", Kind: String},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: Enum, Enum: "HistoricalEventBodyAbusedItemSubtype"},
+			{Name: "item_type", Field: "ItemType", Kind: Enum, Enum: "HistoricalEventBodyAbusedItemType"},
+			{Name: "pile_type", Field: "PileType", Kind: Enum, Enum: "HistoricalEventBodyAbusedPileType"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure", Field: "Structure", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "tree", Field: "Tree", Kind: Int},
+			{Name: "victim_entity", Field: "VictimEntity", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_building_profile_acquired",
+		Type:    "HistoricalEventBuildingProfileAcquired",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "acquirer_enid", Field: "AcquirerEnid", Kind: Int, References: "entity"},
+			{Name: "acquirer_hfid", Field: "AcquirerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "building_profile_id", Field: "BuildingProfileId", Kind: Int},
+			{Name: "inherited", Field: "Inherited", Kind: Bool},
+			{Name: "last_owner_hfid", Field: "LastOwnerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "purchased_unowned", Field: "PurchasedUnowned", Kind: Bool},
+			{Name: "rebuilt_ruined", Field: "RebuiltRuined", Kind: Bool},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_ceremony",
+		Type:    "HistoricalEventCeremony",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "occasion_id", Field: "OccasionId", Kind: Int},
+			{Name: "schedule_id", Field: "ScheduleId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_change_hf_body_state",
+		Type:    "HistoricalEventChangeHfBodyState",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "body_state", Field: "BodyState", Kind: Enum, Enum: "HistoricalEventChangeHfBodyStateBodyState"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_change_hf_job",
+		Type:    "HistoricalEventChangeHfJob",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "new_job", Field: "NewJob", Kind: String},
+			{Name: "old_job", Field: "OldJob", Kind: String},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_change_hf_state",
+		Type:    "HistoricalEventChangeHfState",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "mood", Field: "Mood", Kind: Enum, Enum: "HistoricalEventChangeHfStateMood"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventChangeHfStateReason"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "state", Field: "State", Kind: Enum, Enum: "HistoricalEventChangeHfStateState"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_changed_creature_type",
+		Type:    "HistoricalEventChangedCreatureType",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "changee_hfid", Field: "ChangeeHfid", Kind: Int, References: "historical_figure"},
+			{Name: "changer_hfid", Field: "ChangerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "new_caste", Field: "NewCaste", Kind: String},
+			{Name: "new_race", Field: "NewRace", Kind: String},
+			{Name: "old_caste", Field: "OldCaste", Kind: String},
+			{Name: "old_race", Field: "OldRace", Kind: String},
+		},
+	},
+	{
+		Name:    "historical_event_competition",
+		Type:    "HistoricalEventCompetition",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "competitor_hfid", Field: "CompetitorHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "occasion_id", Field: "OccasionId", Kind: Int},
+			{Name: "schedule_id", Field: "ScheduleId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "winner_hfid", Field: "WinnerHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_create_entity_position",
+		Type:    "HistoricalEventCreateEntityPosition",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ", Field: "Civ", Kind: Int, References: "entity"},
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "position", Field: "Position", Kind: String},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventCreateEntityPositionReason"},
+			{Name: "site_civ", Field: "SiteCiv", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_created_site",
+		Type:    "HistoricalEventCreatedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "builder_hfid", Field: "BuilderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "resident_civ_id", Field: "ResidentCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_created_structure",
+		Type:    "HistoricalEventCreatedStructure",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "builder_hfid", Field: "BuilderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "rebuild", Field: "Rebuild", Kind: Enum, Enum: "HistoricalEventCreatedStructureRebuild"},
+			{Name: "rebuilt", Field: "Rebuilt", Kind: Bool},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_created_world_construction",
+		Type:    "HistoricalEventCreatedWorldConstruction",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "master_wcid", Field: "MasterWcid", Kind: Int, References: "world_construction"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id_1", Field: "SiteId1", Kind: Int, References: "site"},
+			{Name: "site_id_2", Field: "SiteId2", Kind: Int, References: "site"},
+			{Name: "wcid", Field: "Wcid", Kind: Int, References: "world_construction"},
+		},
+	},
+	{
+		Name:    "historical_event_creature_devoured",
+		Type:    "HistoricalEventCreatureDevoured",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "caste", Field: "Caste", Kind: String},
+			{Name: "eater", Field: "Eater", Kind: Int},
+			{Name: "entity", Field: "Entity", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "race", Field: "Race", Kind: String},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "victim", Field: "Victim", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_dance_form_created",
+		Type:    "HistoricalEventDanceFormCreated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventDanceFormCreatedCircumstance"},
+			{Name: "circumstance_id", Field: "CircumstanceId", Kind: Int},
+			{Name: "form_id", Field: "FormId", Kind: Int, References: "dance_form"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventDanceFormCreatedReason"},
+			{Name: "reason_id", Field: "ReasonId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_destroyed_site",
+		Type:    "HistoricalEventDestroyedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "no_defeat_mention", Field: "NoDefeatMention", Kind: Bool},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_diplomat_lost",
+		Type:    "HistoricalEventDiplomatLost",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity", Field: "Entity", Kind: Int, References: "entity"},
+			{Name: "involved", Field: "Involved", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_alliance_formed",
+		Type:    "HistoricalEventEntityAllianceFormed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "initiating_enid", Field: "InitiatingEnid", Kind: Int, References: "entity"},
+			{Name: "joining_enid", Field: "JoiningEnid", Kind: Int, Multiple: true, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_breach_feature_layer",
+		Type:    "HistoricalEventEntityBreachFeatureLayer",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_entity_id", Field: "CivEntityId", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "site_entity_id", Field: "SiteEntityId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_created",
+		Type:    "HistoricalEventEntityCreated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "creator_hfid", Field: "CreatorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_entity_dissolved",
+		Type:    "HistoricalEventEntityDissolved",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventEntityDissolvedReason"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_equipment_purchase",
+		Type:    "HistoricalEventEntityEquipmentPurchase",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "new_equipment_level", Field: "NewEquipmentLevel", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_entity_expels_hf",
+		Type:    "HistoricalEventEntityExpelsHf",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_fled_site",
+		Type:    "HistoricalEventEntityFledSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "fled_civ_id", Field: "FledCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_incorporated",
+		Type:    "HistoricalEventEntityIncorporated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "joined_entity_id", Field: "JoinedEntityId", Kind: Int, References: "entity"},
+			{Name: "joiner_entity_id", Field: "JoinerEntityId", Kind: Int, References: "entity"},
+			{Name: "leader_hfid", Field: "LeaderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "partial_incorporation", Field: "PartialIncorporation", Kind: Bool},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_law",
+		Type:    "HistoricalEventEntityLaw",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "law_add", Field: "LawAdd", Kind: Enum, Enum: "HistoricalEventEntityLawLawAdd"},
+			{Name: "law_remove", Field: "LawRemove", Kind: Enum, Enum: "HistoricalEventEntityLawLawRemove"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_overthrown",
+		Type:    "HistoricalEventEntityOverthrown",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "conspirator_hfid", Field: "ConspiratorHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "instigator_hfid", Field: "InstigatorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "overthrown_hfid", Field: "OverthrownHfid", Kind: Int, References: "historical_figure"},
+			{Name: "pos_taker_hfid", Field: "PosTakerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "position_profile_id", Field: "PositionProfileId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_persecuted",
+		Type:    "HistoricalEventEntityPersecuted",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "destroyed_structure_id", Field: "DestroyedStructureId", Kind: Int},
+			{Name: "expelled_creature", Field: "ExpelledCreature", Kind: Int, Multiple: true},
+			{Name: "expelled_hfid", Field: "ExpelledHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "expelled_number", Field: "ExpelledNumber", Kind: Int, Multiple: true},
+			{Name: "expelled_pop_id", Field: "ExpelledPopId", Kind: Int, Multiple: true},
+			{Name: "persecutor_enid", Field: "PersecutorEnid", Kind: Int, References: "entity"},
+			{Name: "persecutor_hfid", Field: "PersecutorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "property_confiscated_from_hfid", Field: "PropertyConfiscatedFromHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "shrine_amount_destroyed", Field: "ShrineAmountDestroyed", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "target_enid", Field: "TargetEnid", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_primary_criminals",
+		Type:    "HistoricalEventEntityPrimaryCriminals",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventEntityPrimaryCriminalsAction"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_entity_rampaged_in_site",
+		Type:    "HistoricalEventEntityRampagedInSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "rampage_civ_id", Field: "RampageCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_entity_relocate",
+		Type:    "HistoricalEventEntityRelocate",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventEntityRelocateAction"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_entity_searched_site",
+		Type:    "HistoricalEventEntitySearchedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "result", Field: "Result", Kind: Enum, Enum: "HistoricalEventEntitySearchedSiteResult"},
+			{Name: "searcher_civ_id", Field: "SearcherCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_failed_frame_attempt",
+		Type:    "HistoricalEventFailedFrameAttempt",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "convicter_enid", Field: "ConvicterEnid", Kind: Int, References: "entity"},
+			{Name: "crime", Field: "Crime", Kind: Enum, Enum: "HistoricalEventFailedFrameAttemptCrime"},
+			{Name: "fooled_hfid", Field: "FooledHfid", Kind: Int, References: "historical_figure"},
+			{Name: "framer_hfid", Field: "FramerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "plotter_hfid", Field: "PlotterHfid", Kind: Int, References: "historical_figure"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_failed_intrigue_corruption",
+		Type:    "HistoricalEventFailedIntrigueCorruption",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventFailedIntrigueCorruptionAction"},
+			{Name: "ally_defense_bonus", Field: "AllyDefenseBonus", Kind: Int},
+			{Name: "coconspirator_bonus", Field: "CoconspiratorBonus", Kind: Int},
+			{Name: "corruptor_hfid", Field: "CorruptorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "corruptor_identity", Field: "CorruptorIdentity", Kind: Int, References: "identity"},
+			{Name: "failed_judgment_test", Field: "FailedJudgmentTest", Kind: Bool},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "lure_hfid", Field: "LureHfid", Kind: Int, References: "historical_figure"},
+			{Name: "method", Field: "Method", Kind: Enum, Enum: "HistoricalEventFailedIntrigueCorruptionMethod"},
+			{Name: "relevant_entity_id", Field: "RelevantEntityId", Kind: Int, References: "entity"},
+			{Name: "relevant_id_for_method", Field: "RelevantIdForMethod", Kind: Int},
+			{Name: "relevant_position_profile_id", Field: "RelevantPositionProfileId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+			{Name: "target_identity", Field: "TargetIdentity", Kind: Int, References: "identity"},
+			{Name: "top_facet", Field: "TopFacet", Kind: Enum, Enum: "HistoricalEventFailedIntrigueCorruptionTopFacet"},
+			{Name: "top_facet_modifier", Field: "TopFacetModifier", Kind: Int},
+			{Name: "top_facet_rating", Field: "TopFacetRating", Kind: Int},
+			{Name: "top_relationship_factor", Field: "TopRelationshipFactor", Kind: Enum, Enum: "HistoricalEventFailedIntrigueCorruptionTopRelationshipFactor"},
+			{Name: "top_relationship_modifier", Field: "TopRelationshipModifier", Kind: Int},
+			{Name: "top_relationship_rating", Field: "TopRelationshipRating", Kind: Int},
+			{Name: "top_value", Field: "TopValue", Kind: Enum, Enum: "HistoricalEventFailedIntrigueCorruptionTopValue"},
+			{Name: "top_value_modifier", Field: "TopValueModifier", Kind: Int},
+			{Name: "top_value_rating", Field: "TopValueRating", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_field_battle",
+		Type:    "HistoricalEventFieldBattle",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "a_support_merc_enid", Field: "ASupportMercEnid", Kind: Int, References: "entity"},
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "attacker_general_hfid", Field: "AttackerGeneralHfid", Kind: Int, References: "historical_figure"},
+			{Name: "attacker_merc_enid", Field: "AttackerMercEnid", Kind: Int, References: "entity"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "d_support_merc_enid", Field: "DSupportMercEnid", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "defender_general_hfid", Field: "DefenderGeneralHfid", Kind: Int, References: "historical_figure"},
+			{Name: "defender_merc_enid", Field: "DefenderMercEnid", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_first_contact",
+		Type:    "HistoricalEventFirstContact",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "contacted_enid", Field: "ContactedEnid", Kind: Int, References: "entity"},
+			{Name: "contactor_enid", Field: "ContactorEnid", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_first_contact_failed",
+		Type:    "HistoricalEventFirstContactFailed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "contactor_enid", Field: "ContactorEnid", Kind: Int, References: "entity"},
+			{Name: "rejector_enid", Field: "RejectorEnid", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_gamble",
+		Type:    "HistoricalEventGamble",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "gambler_hfid", Field: "GamblerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "new_account", Field: "NewAccount", Kind: Int},
+			{Name: "old_account", Field: "OldAccount", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_abducted",
+		Type:    "HistoricalEventHfAbducted",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "snatcher_hfid", Field: "SnatcherHfid", Kind: Int, References: "historical_figure"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_asked_about_artifact",
+		Type:    "HistoricalEventHfAskedAboutArtifact",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "hist_fig_id", Field: "HistFigId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_attacked_site",
+		Type:    "HistoricalEventHfAttackedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_hfid", Field: "AttackerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_carouse",
+		Type:    "HistoricalEventHfCarouse",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_hfid", Field: "GroupHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_confronted",
+		Type:    "HistoricalEventHfConfronted",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Multiple: true, Enum: "HistoricalEventHfConfrontedReason"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "situation", Field: "Situation", Kind: Enum, Enum: "HistoricalEventHfConfrontedSituation"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_convicted",
+		Type:    "HistoricalEventHfConvicted",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "beating", Field: "Beating", Kind: Bool},
+			{Name: "coconspirator_hfid", Field: "CoconspiratorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "confessed_after_apb_arrest_enid", Field: "ConfessedAfterApbArrestEnid", Kind: Int, References: "entity"},
+			{Name: "contact_hfid", Field: "ContactHfid", Kind: Int, References: "historical_figure"},
+			{Name: "convict_is_contact", Field: "ConvictIsContact", Kind: Bool},
+			{Name: "convicted_hfid", Field: "ConvictedHfid", Kind: Int, References: "historical_figure"},
+			{Name: "convicter_enid", Field: "ConvicterEnid", Kind: Int, References: "entity"},
+			{Name: "corrupt_convicter_hfid", Field: "CorruptConvicterHfid", Kind: Int, References: "historical_figure"},
+			{Name: "crime", Field: "Crime", Kind: Enum, Enum: "HistoricalEventHfConvictedCrime"},
+			{Name: "death_penalty", Field: "DeathPenalty", Kind: Bool},
+			{Name: "did_not_reveal_all_in_interrogation", Field: "DidNotRevealAllInInterrogation", Kind: Bool},
+			{Name: "exiled", Field: "Exiled", Kind: Bool},
+			{Name: "fooled_hfid", Field: "FooledHfid", Kind: Int, References: "historical_figure"},
+			{Name: "framer_hfid", Field: "FramerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "hammerstrokes", Field: "Hammerstrokes", Kind: Int},
+			{Name: "held_firm_in_interrogation", Field: "HeldFirmInInterrogation", Kind: Bool},
+			{Name: "implicated_hfid", Field: "ImplicatedHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "interrogator_hfid", Field: "InterrogatorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "no_prison_available", Field: "NoPrisonAvailable", Kind: Bool},
+			{Name: "plotter_hfid", Field: "PlotterHfid", Kind: Int, References: "historical_figure"},
+			{Name: "prison_months", Field: "PrisonMonths", Kind: Int},
+			{Name: "surveiled_coconspirator", Field: "SurveiledCoconspirator", Kind: Bool},
+			{Name: "surveiled_contact", Field: "SurveiledContact", Kind: Bool},
+			{Name: "surveiled_convicted", Field: "SurveiledConvicted", Kind: Bool},
+			{Name: "surveiled_target", Field: "SurveiledTarget", Kind: Bool},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+			{Name: "wrongful_conviction", Field: "WrongfulConviction", Kind: Bool},
+		},
+	},
+	{
+		Name:    "historical_event_hf_destroyed_site",
+		Type:    "HistoricalEventHfDestroyedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_hfid", Field: "AttackerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_died",
+		Type:    "HistoricalEventHfDied",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "cause", Field: "Cause", Kind: Enum, Enum: "HistoricalEventHfDiedCause"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: String},
+			{Name: "item_type", Field: "ItemType", Kind: String},
+			{Name: "mat", Field: "Mat", Kind: String},
+			{Name: "shooter_artifact_id", Field: "ShooterArtifactId", Kind: Int, References: "artifact"},
+			{Name: "shooter_item", Field: "ShooterItem", Kind: Int, References: "artifact"},
+			{Name: "shooter_item_subtype", Field: "ShooterItemSubtype", Kind: Enum, Enum: "HistoricalEventHfDiedShooterItemSubtype"},
+			{Name: "shooter_item_type", Field: "ShooterItemType", Kind: Enum, Enum: "HistoricalEventHfDiedShooterItemType"},
+			{Name: "shooter_mat", Field: "ShooterMat", Kind: String},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "slayer_caste", Field: "SlayerCaste", Kind: String},
+			{Name: "slayer_hfid", Field: "SlayerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "slayer_item_id", Field: "SlayerItemId", Kind: Int, References: "artifact"},
+			{Name: "slayer_race", Field: "SlayerRace", Kind: String},
+			{Name: "slayer_shooter_item_id", Field: "SlayerShooterItemId", Kind: Int, References: "artifact"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_disturbed_structure",
+		Type:    "HistoricalEventHfDisturbedStructure",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventHfDisturbedStructureAction"},
+			{Name: "hist_fig_id", Field: "HistFigId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_does_interaction",
+		Type:    "HistoricalEventHfDoesInteraction",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "doer_hfid", Field: "DoerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "interaction", Field: "Interaction", Kind: String},
+			{Name: "interaction_action", Field: "InteractionAction", Kind: String},
+			{Name: "region", Field: "Region", Kind: Int},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "source", Field: "Source", Kind: Int, References: "entity"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_enslaved",
+		Type:    "HistoricalEventHfEnslaved",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "enslaved_hfid", Field: "EnslavedHfid", Kind: Int, References: "historical_figure"},
+			{Name: "moved_to_site_id", Field: "MovedToSiteId", Kind: Int, References: "site"},
+			{Name: "payer_entity_id", Field: "PayerEntityId", Kind: Int, References: "entity"},
+			{Name: "seller_hfid", Field: "SellerHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_equipment_purchase",
+		Type:    "HistoricalEventHfEquipmentPurchase",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_hfid", Field: "GroupHfid", Kind: Int, References: "historical_figure"},
+			{Name: "quality", Field: "Quality", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_freed",
+		Type:    "HistoricalEventHfFreed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "freeing_civ_id", Field: "FreeingCivId", Kind: Int, References: "entity"},
+			{Name: "freeing_hfid", Field: "FreeingHfid", Kind: Int, References: "historical_figure"},
+			{Name: "holding_civ_id", Field: "HoldingCivId", Kind: Int, References: "entity"},
+			{Name: "rescued_hfid", Field: "RescuedHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_gains_secret_goal",
+		Type:    "HistoricalEventHfGainsSecretGoal",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "secret_goal", Field: "SecretGoal", Kind: Enum, Enum: "HistoricalEventHfGainsSecretGoalSecretGoal"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_interrogated",
+		Type:    "HistoricalEventHfInterrogated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "arresting_enid", Field: "ArrestingEnid", Kind: Int, References: "entity"},
+			{Name: "held_firm_in_interrogation", Field: "HeldFirmInInterrogation", Kind: Bool},
+			{Name: "implicated_hfid", Field: "ImplicatedHfid", Kind: Int, References: "historical_figure"},
+			{Name: "interrogator_hfid", Field: "InterrogatorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+			{Name: "wanted_and_recognized", Field: "WantedAndRecognized", Kind: Bool},
+		},
+	},
+	{
+		Name:    "historical_event_hf_learns_secret",
+		Type:    "HistoricalEventHfLearnsSecret",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "interaction", Field: "Interaction", Kind: String},
+			{Name: "secret_text", Field: "SecretText", Kind: Enum, Enum: "HistoricalEventHfLearnsSecretSecretText"},
+			{Name: "student_hfid", Field: "StudentHfid", Kind: Int, References: "historical_figure"},
+			{Name: "teacher_hfid", Field: "TeacherHfid", Kind: Int, References: "historical_figure"},
+			{Name: "unk_1", Field: "Unk1", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_new_pet",
+		Type:    "HistoricalEventHfNewPet",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_hfid", Field: "GroupHfid", Kind: Int, References: "historical_figure"},
+			{Name: "pets", Field: "Pets", Kind: String},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_performed_horrible_experiments",
+		Type:    "HistoricalEventHfPerformedHorribleExperiments",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_hfid", Field: "GroupHfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_prayed_inside_structure",
+		Type:    "HistoricalEventHfPrayedInsideStructure",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventHfPrayedInsideStructureAction"},
+			{Name: "hist_fig_id", Field: "HistFigId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_preach",
+		Type:    "HistoricalEventHfPreach",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_1", Field: "Entity1", Kind: Int, References: "entity"},
+			{Name: "entity_2", Field: "Entity2", Kind: Int, References: "entity"},
+			{Name: "site_hfid", Field: "SiteHfid", Kind: Int, References: "site"},
+			{Name: "speaker_hfid", Field: "SpeakerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "topic", Field: "Topic", Kind: Enum, Enum: "HistoricalEventHfPreachTopic"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_profaned_structure",
+		Type:    "HistoricalEventHfProfanedStructure",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventHfProfanedStructureAction"},
+			{Name: "hist_fig_id", Field: "HistFigId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_ransomed",
+		Type:    "HistoricalEventHfRansomed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "moved_to_site_id", Field: "MovedToSiteId", Kind: Int, References: "site"},
+			{Name: "payer_entity_id", Field: "PayerEntityId", Kind: Int, References: "entity"},
+			{Name: "payer_hfid", Field: "PayerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "ransomed_hfid", Field: "RansomedHfid", Kind: Int, References: "historical_figure"},
+			{Name: "ransomer_hfid", Field: "RansomerHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_reach_summit",
+		Type:    "HistoricalEventHfReachSummit",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group", Field: "Group", Kind: Int, Multiple: true},
+			{Name: "group_hfid", Field: "GroupHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "mountain_peak_id", Field: "MountainPeakId", Kind: Int, References: "mountain_peak"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_recruited_unit_type_for_entity",
+		Type:    "HistoricalEventHfRecruitedUnitTypeForEntity",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "unit_type", Field: "UnitType", Kind: Enum, Enum: "HistoricalEventHfRecruitedUnitTypeForEntityUnitType"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_relationship_denied",
+		Type:    "HistoricalEventHfRelationshipDenied",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventHfRelationshipDeniedReason"},
+			{Name: "reason_id", Field: "ReasonId", Kind: Int},
+			{Name: "relationship", Field: "Relationship", Kind: Enum, Enum: "HistoricalEventHfRelationshipDeniedRelationship"},
+			{Name: "seeker_hfid", Field: "SeekerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_reunion",
+		Type:    "HistoricalEventHfReunion",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_1_hfid", Field: "Group1Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "group_2_hfid", Field: "Group2Hfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_revived",
+		Type:    "HistoricalEventHfRevived",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "actor_hfid", Field: "ActorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "disturbance", Field: "Disturbance", Kind: Bool},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "ghost", Field: "Ghost", Kind: Enum, Enum: "HistoricalEventHfRevivedGhost"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "raised_before", Field: "RaisedBefore", Kind: Bool},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_simple_battle_event",
+		Type:    "HistoricalEventHfSimpleBattleEvent",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_1_hfid", Field: "Group1Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "group_2_hfid", Field: "Group2Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "subtype", Field: "Subtype", Kind: Enum, Enum: "HistoricalEventHfSimpleBattleEventSubtype"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_travel",
+		Type:    "HistoricalEventHfTravel",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "group_hfid", Field: "GroupHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "return", Field: "Return", Kind: Bool},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_hf_viewed_artifact",
+		Type:    "HistoricalEventHfViewedArtifact",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "hist_fig_id", Field: "HistFigId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hf_wounded",
+		Type:    "HistoricalEventHfWounded",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "body_part", Field: "BodyPart", Kind: Int},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "injury_type", Field: "InjuryType", Kind: Enum, Enum: "HistoricalEventHfWoundedInjuryType"},
+			{Name: "part_lost", Field: "PartLost", Kind: Enum, Enum: "HistoricalEventHfWoundedPartLost"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "was_torture", Field: "WasTorture", Kind: Bool},
+			{Name: "woundee_caste", Field: "WoundeeCaste", Kind: Int},
+			{Name: "woundee_hfid", Field: "WoundeeHfid", Kind: Int, References: "historical_figure"},
+			{Name: "woundee_race", Field: "WoundeeRace", Kind: Int},
+			{Name: "wounder_hfid", Field: "WounderHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_hfs_formed_intrigue_relationship",
+		Type:    "HistoricalEventHfsFormedIntrigueRelationship",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "action", Field: "Action", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipAction"},
+			{Name: "ally_defense_bonus", Field: "AllyDefenseBonus", Kind: Int},
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipCircumstance"},
+			{Name: "circumstance_id", Field: "CircumstanceId", Kind: Int},
+			{Name: "coconspirator_bonus", Field: "CoconspiratorBonus", Kind: Int},
+			{Name: "corruptor_hfid", Field: "CorruptorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "corruptor_identity", Field: "CorruptorIdentity", Kind: Int, References: "identity"},
+			{Name: "corruptor_seen_as", Field: "CorruptorSeenAs", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipCorruptorSeenAs"},
+			{Name: "failed_judgment_test", Field: "FailedJudgmentTest", Kind: Bool},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "lure_hfid", Field: "LureHfid", Kind: Int, References: "historical_figure"},
+			{Name: "method", Field: "Method", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipMethod"},
+			{Name: "relevant_entity_id", Field: "RelevantEntityId", Kind: Int, References: "entity"},
+			{Name: "relevant_id_for_method", Field: "RelevantIdForMethod", Kind: Int},
+			{Name: "relevant_position_profile_id", Field: "RelevantPositionProfileId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "successful", Field: "Successful", Kind: Bool},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+			{Name: "target_identity", Field: "TargetIdentity", Kind: Int, References: "identity"},
+			{Name: "target_seen_as", Field: "TargetSeenAs", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipTargetSeenAs"},
+			{Name: "top_facet", Field: "TopFacet", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipTopFacet"},
+			{Name: "top_facet_modifier", Field: "TopFacetModifier", Kind: Int},
+			{Name: "top_facet_rating", Field: "TopFacetRating", Kind: Int},
+			{Name: "top_relationship_factor", Field: "TopRelationshipFactor", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipTopRelationshipFactor"},
+			{Name: "top_relationship_modifier", Field: "TopRelationshipModifier", Kind: Int},
+			{Name: "top_relationship_rating", Field: "TopRelationshipRating", Kind: Int},
+			{Name: "top_value", Field: "TopValue", Kind: Enum, Enum: "HistoricalEventHfsFormedIntrigueRelationshipTopValue"},
+			{Name: "top_value_modifier", Field: "TopValueModifier", Kind: Int},
+			{Name: "top_value_rating", Field: "TopValueRating", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_hfs_formed_reputation_relationship",
+		Type:    "HistoricalEventHfsFormedReputationRelationship",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "hf_rep_1_of_2", Field: "HfRep1Of2", Kind: Enum, Enum: "HistoricalEventHfsFormedReputationRelationshipHfRep1Of2"},
+			{Name: "hf_rep_2_of_1", Field: "HfRep2Of1", Kind: Enum, Enum: "HistoricalEventHfsFormedReputationRelationshipHfRep2Of1"},
+			{Name: "hfid_1", Field: "Hfid1", Kind: Int, References: "historical_figure"},
+			{Name: "hfid_2", Field: "Hfid2", Kind: Int, References: "historical_figure"},
+			{Name: "identity_id_1", Field: "IdentityId1", Kind: Int, References: "identity"},
+			{Name: "identity_id_2", Field: "IdentityId2", Kind: Int, References: "identity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_holy_city_declaration",
+		Type:    "HistoricalEventHolyCityDeclaration",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "religion_id", Field: "ReligionId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_insurrection_started",
+		Type:    "HistoricalEventInsurrectionStarted",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "outcome", Field: "Outcome", Kind: Enum, Enum: "HistoricalEventInsurrectionStartedOutcome"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "target_civ_id", Field: "TargetCivId", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_item_stolen",
+		Type:    "HistoricalEventItemStolen",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "circumstance_id", Field: "CircumstanceId", Kind: Int},
+			{Name: "entity", Field: "Entity", Kind: Int, References: "entity"},
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "item", Field: "Item", Kind: Int, References: "artifact"},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: String},
+			{Name: "item_type", Field: "ItemType", Kind: String},
+			{Name: "mat", Field: "Mat", Kind: String},
+			{Name: "matindex", Field: "Matindex", Kind: Int},
+			{Name: "mattype", Field: "Mattype", Kind: Int},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "stash_site", Field: "StashSite", Kind: Int, References: "site"},
+			{Name: "structure", Field: "Structure", Kind: Int},
+			{Name: "theft_method", Field: "TheftMethod", Kind: Enum, Enum: "HistoricalEventItemStolenTheftMethod"},
+		},
+	},
+	{
+		Name:   "historical_event_item_stolen_circumstance",
+		Type:   "HistoricalEventItemStolenCircumstance",
+		Field:  "Circumstance",
+		Parent: "historical_event_item_stolen",
+		Columns: []*Column{
+			{Name: "defeated", Field: "Defeated", Kind: Int},
+			{Name: "hist_event_collection", Field: "HistEventCollection", Kind: Int},
+			{Name: "murdered", Field: "Murdered", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "HistoricalEventItemStolenCircumstanceType"},
+		},
+	},
+	{
+		Name:    "historical_event_knowledge_discovered",
+		Type:    "HistoricalEventKnowledgeDiscovered",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "first", Field: "First", Kind: Bool},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "knowledge", Field: "Knowledge", Kind: Enum, Enum: "HistoricalEventKnowledgeDiscoveredKnowledge"},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_arch_constructed",
+		Type:    "HistoricalEventMasterpieceArchConstructed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "building_custom", Field: "BuildingCustom", Kind: Int},
+			{Name: "building_subtype", Field: "BuildingSubtype", Kind: Enum, Enum: "HistoricalEventMasterpieceArchConstructedBuildingSubtype"},
+			{Name: "building_type", Field: "BuildingType", Kind: Enum, Enum: "HistoricalEventMasterpieceArchConstructedBuildingType"},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "skill_at_time", Field: "SkillAtTime", Kind: Enum, Enum: "HistoricalEventMasterpieceArchConstructedSkillAtTime"},
+			{Name: "unk_2", Field: "Unk2", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_dye",
+		Type:    "HistoricalEventMasterpieceDye",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "dye_mat", Field: "DyeMat", Kind: String},
+			{Name: "dye_mat_index", Field: "DyeMatIndex", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "item_type", Field: "ItemType", Kind: Enum, Enum: "HistoricalEventMasterpieceDyeItemType"},
+			{Name: "maker", Field: "Maker", Kind: Int},
+			{Name: "maker_entity", Field: "MakerEntity", Kind: Int, References: "entity"},
+			{Name: "mat", Field: "Mat", Kind: Enum, Enum: "HistoricalEventMasterpieceDyeMat"},
+			{Name: "mat_index", Field: "MatIndex", Kind: Int},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "skill_at_time", Field: "SkillAtTime", Kind: Enum, Enum: "HistoricalEventMasterpieceDyeSkillAtTime"},
+			{Name: "unk_2", Field: "Unk2", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_engraving",
+		Type:    "HistoricalEventMasterpieceEngraving",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "art_id", Field: "ArtId", Kind: Int},
+			{Name: "art_subid", Field: "ArtSubid", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "skill_at_time", Field: "SkillAtTime", Kind: String},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_food",
+		Type:    "HistoricalEventMasterpieceFood",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "item_id", Field: "ItemId", Kind: Int, References: "artifact"},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: Enum, Enum: "HistoricalEventMasterpieceFoodItemSubtype"},
+			{Name: "item_type", Field: "ItemType", Kind: Enum, Enum: "HistoricalEventMasterpieceFoodItemType"},
+			{Name: "maker", Field: "Maker", Kind: Int},
+			{Name: "maker_entity", Field: "MakerEntity", Kind: Int, References: "entity"},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "skill_at_time", Field: "SkillAtTime", Kind: Enum, Enum: "HistoricalEventMasterpieceFoodSkillAtTime"},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_item",
+		Type:    "HistoricalEventMasterpieceItem",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "item_id", Field: "ItemId", Kind: Int, References: "artifact"},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: String},
+			{Name: "item_type", Field: "ItemType", Kind: String},
+			{Name: "mat", Field: "Mat", Kind: String},
+			{Name: "mat_index", Field: "MatIndex", Kind: Int},
+			{Name: "mat_type", Field: "MatType", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "skill_at_time", Field: "SkillAtTime", Kind: String},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_item_improvement",
+		Type:    "HistoricalEventMasterpieceItemImprovement",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "art_id", Field: "ArtId", Kind: Int},
+			{Name: "art_subid", Field: "ArtSubid", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "imp_mat", Field: "ImpMat", Kind: String},
+			{Name: "improvement_subtype", Field: "ImprovementSubtype", Kind: Int},
+			{Name: "improvement_type", Field: "ImprovementType", Kind: Enum, Enum: "HistoricalEventMasterpieceItemImprovementImprovementType"},
+			{Name: "item_subtype", Field: "ItemSubtype", Kind: String},
+			{Name: "item_type", Field: "ItemType", Kind: String},
+			{Name: "maker", Field: "Maker", Kind: Int},
+			{Name: "maker_entity", Field: "MakerEntity", Kind: Int, References: "entity"},
+			{Name: "mat", Field: "Mat", Kind: String},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "skill_at_time", Field: "SkillAtTime", Kind: Enum, Enum: "HistoricalEventMasterpieceItemImprovementSkillAtTime"},
+			{Name: "unk_2", Field: "Unk2", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_masterpiece_lost",
+		Type:    "HistoricalEventMasterpieceLost",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "creation_event", Field: "CreationEvent", Kind: Int},
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "method", Field: "Method", Kind: String},
+			{Name: "site", Field: "Site", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_merchant",
+		Type:    "HistoricalEventMerchant",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "all_dead", Field: "AllDead", Kind: Bool},
+			{Name: "depot_entity_id", Field: "DepotEntityId", Kind: Int, References: "entity"},
+			{Name: "hardship", Field: "Hardship", Kind: Bool},
+			{Name: "is_tribute", Field: "IsTribute", Kind: Bool},
+			{Name: "lost_value", Field: "LostValue", Kind: Bool},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "trader_entity_id", Field: "TraderEntityId", Kind: Int, References: "entity"},
+		},
+	},
+	{
+		Name:    "historical_event_modified_building",
+		Type:    "HistoricalEventModifiedBuilding",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "modification", Field: "Modification", Kind: Enum, Enum: "HistoricalEventModifiedBuildingModification"},
+			{Name: "modifier_hfid", Field: "ModifierHfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_musical_form_created",
+		Type:    "HistoricalEventMusicalFormCreated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventMusicalFormCreatedCircumstance"},
+			{Name: "circumstance_id", Field: "CircumstanceId", Kind: Int},
+			{Name: "form_id", Field: "FormId", Kind: Int, References: "musical_form"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventMusicalFormCreatedReason"},
+			{Name: "reason_id", Field: "ReasonId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_new_site_leader",
+		Type:    "HistoricalEventNewSiteLeader",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "new_leader_hfid", Field: "NewLeaderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "new_site_civ_id", Field: "NewSiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_peace_accepted",
+		Type:    "HistoricalEventPeaceAccepted",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "destination", Field: "Destination", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "source", Field: "Source", Kind: Int, References: "entity"},
+			{Name: "topic", Field: "Topic", Kind: Enum, Enum: "HistoricalEventPeaceAcceptedTopic"},
+		},
+	},
+	{
+		Name:    "historical_event_peace_rejected",
+		Type:    "HistoricalEventPeaceRejected",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "destination", Field: "Destination", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "source", Field: "Source", Kind: Int, References: "entity"},
+			{Name: "topic", Field: "Topic", Kind: Enum, Enum: "HistoricalEventPeaceRejectedTopic"},
+		},
+	},
+	{
+		Name:    "historical_event_performance",
+		Type:    "HistoricalEventPerformance",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "occasion_id", Field: "OccasionId", Kind: Int},
+			{Name: "schedule_id", Field: "ScheduleId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_plundered_site",
+		Type:    "HistoricalEventPlunderedSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "detected", Field: "Detected", Kind: Bool},
+			{Name: "no_defeat_mention", Field: "NoDefeatMention", Kind: Bool},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "took_items", Field: "TookItems", Kind: Bool},
+			{Name: "took_livestock", Field: "TookLivestock", Kind: Bool},
+			{Name: "was_raid", Field: "WasRaid", Kind: Bool},
+		},
+	},
+	{
+		Name:    "historical_event_poetic_form_created",
+		Type:    "HistoricalEventPoeticFormCreated",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventPoeticFormCreatedCircumstance"},
+			{Name: "form_id", Field: "FormId", Kind: Int, References: "poetic_form"},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_procession",
+		Type:    "HistoricalEventProcession",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "occasion_id", Field: "OccasionId", Kind: Int},
+			{Name: "schedule_id", Field: "ScheduleId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_razed_structure",
+		Type:    "HistoricalEventRazedStructure",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_reclaim_site",
+		Type:    "HistoricalEventReclaimSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "unretire", Field: "Unretire", Kind: Bool},
+		},
+	},
+	{
+		Name:    "historical_event_regionpop_incorporated_into_entity",
+		Type:    "HistoricalEventRegionpopIncorporatedIntoEntity",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "join_entity_id", Field: "JoinEntityId", Kind: Int, References: "entity"},
+			{Name: "pop_flid", Field: "PopFlid", Kind: Int},
+			{Name: "pop_number_moved", Field: "PopNumberMoved", Kind: Int},
+			{Name: "pop_race", Field: "PopRace", Kind: Int},
+			{Name: "pop_srid", Field: "PopSrid", Kind: Int, References: "region"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_remove_hf_entity_link",
+		Type:    "HistoricalEventRemoveHfEntityLink",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "link", Field: "Link", Kind: Enum, Enum: "HistoricalEventRemoveHfEntityLinkLink"},
+			{Name: "position", Field: "Position", Kind: String},
+			{Name: "position_id", Field: "PositionId", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_remove_hf_hf_link",
+		Type:    "HistoricalEventRemoveHfHfLink",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "hfid_target", Field: "HfidTarget", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_remove_hf_site_link",
+		Type:    "HistoricalEventRemoveHfSiteLink",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ", Field: "Civ", Kind: Int, References: "entity"},
+			{Name: "histfig", Field: "Histfig", Kind: Int, References: "historical_figure"},
+			{Name: "link_type", Field: "LinkType", Kind: Enum, Enum: "HistoricalEventRemoveHfSiteLinkLinkType"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure", Field: "Structure", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_replaced_structure",
+		Type:    "HistoricalEventReplacedStructure",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "new_ab_id", Field: "NewAbId", Kind: Int},
+			{Name: "old_ab_id", Field: "OldAbId", Kind: Int},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_sabotage",
+		Type:    "HistoricalEventSabotage",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "saboteur_hfid", Field: "SaboteurHfid", Kind: Int, References: "historical_figure"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "target_hfid", Field: "TargetHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_site_died",
+		Type:    "HistoricalEventSiteDied",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "abandoned", Field: "Abandoned", Kind: Bool},
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_site_dispute",
+		Type:    "HistoricalEventSiteDispute",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "dispute", Field: "Dispute", Kind: Enum, Enum: "HistoricalEventSiteDisputeDispute"},
+			{Name: "entity_id_1", Field: "EntityId1", Kind: Int, References: "entity"},
+			{Name: "entity_id_2", Field: "EntityId2", Kind: Int, References: "entity"},
+			{Name: "site_id_1", Field: "SiteId1", Kind: Int},
+			{Name: "site_id_2", Field: "SiteId2", Kind: Int},
+		},
+	},
+	{
+		Name:    "historical_event_site_retired",
+		Type:    "HistoricalEventSiteRetired",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "first", Field: "First", Kind: Bool},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_site_surrendered",
+		Type:    "HistoricalEventSiteSurrendered",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_site_taken_over",
+		Type:    "HistoricalEventSiteTakenOver",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "new_site_civ_id", Field: "NewSiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_site_tribute_forced",
+		Type:    "HistoricalEventSiteTributeForced",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "season", Field: "Season", Kind: Enum, Enum: "HistoricalEventSiteTributeForcedSeason"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_sneak_into_site",
+		Type:    "HistoricalEventSneakIntoSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "attacker_civ_id", Field: "AttackerCivId", Kind: Int, References: "entity"},
+			{Name: "defender_civ_id", Field: "DefenderCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:    "historical_event_spotted_leaving_site",
+		Type:    "HistoricalEventSpottedLeavingSite",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "leaver_civ_id", Field: "LeaverCivId", Kind: Int, References: "entity"},
+			{Name: "site_civ_id", Field: "SiteCivId", Kind: Int, References: "entity"},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "spotter_hfid", Field: "SpotterHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_squad_vs_squad",
+		Type:    "HistoricalEventSquadVsSquad",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "a_hfid", Field: "AHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "a_leader_hfid", Field: "ALeaderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "a_leadership_roll", Field: "ALeadershipRoll", Kind: Int},
+			{Name: "a_squad_id", Field: "ASquadId", Kind: Int},
+			{Name: "d_effect", Field: "DEffect", Kind: Int},
+			{Name: "d_hfid", Field: "DHfid", Kind: Int, Multiple: true, References: "historical_figure"},
+			{Name: "d_interaction", Field: "DInteraction", Kind: Int},
+			{Name: "d_leader_hfid", Field: "DLeaderHfid", Kind: Int, References: "historical_figure"},
+			{Name: "d_leadership_roll", Field: "DLeadershipRoll", Kind: Int},
+			{Name: "d_number", Field: "DNumber", Kind: Int},
+			{Name: "d_race", Field: "DRace", Kind: Int},
+			{Name: "d_slain", Field: "DSlain", Kind: Int},
+			{Name: "d_squad_id", Field: "DSquadId", Kind: Int},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_tactical_situation",
+		Type:    "HistoricalEventTacticalSituation",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "a_tactician_hfid", Field: "ATacticianHfid", Kind: Int, References: "historical_figure"},
+			{Name: "a_tactics_roll", Field: "ATacticsRoll", Kind: Int},
+			{Name: "d_tactician_hfid", Field: "DTacticianHfid", Kind: Int, References: "historical_figure"},
+			{Name: "d_tactics_roll", Field: "DTacticsRoll", Kind: Int},
+			{Name: "feature_layer_id", Field: "FeatureLayerId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "situation", Field: "Situation", Kind: Enum, Enum: "HistoricalEventTacticalSituationSituation"},
+			{Name: "start", Field: "Start", Kind: Bool},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+		},
+	},
+	{
+		Name:    "historical_event_trade",
+		Type:    "HistoricalEventTrade",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "account_shift", Field: "AccountShift", Kind: Int},
+			{Name: "allotment", Field: "Allotment", Kind: Int},
+			{Name: "allotment_index", Field: "AllotmentIndex", Kind: Int},
+			{Name: "dest_site_id", Field: "DestSiteId", Kind: Int, References: "site"},
+			{Name: "production_zone_id", Field: "ProductionZoneId", Kind: Int},
+			{Name: "source_site_id", Field: "SourceSiteId", Kind: Int, References: "site"},
+			{Name: "trader_entity_id", Field: "TraderEntityId", Kind: Int, References: "entity"},
+			{Name: "trader_hfid", Field: "TraderHfid", Kind: Int, References: "historical_figure"},
+		},
+	},
+	{
+		Name:    "historical_event_written_content_composed",
+		Type:    "HistoricalEventWrittenContentComposed",
+		Field:   "Details",
+		Parent:  "historical_event",
+		SubType: true,
+		Columns: []*Column{
+			{Name: "circumstance", Field: "Circumstance", Kind: Enum, Enum: "HistoricalEventWrittenContentComposedCircumstance"},
+			{Name: "circumstance_id", Field: "CircumstanceId", Kind: Int},
+			{Name: "hist_figure_id", Field: "HistFigureId", Kind: Int, References: "historical_figure"},
+			{Name: "reason", Field: "Reason", Kind: Enum, Enum: "HistoricalEventWrittenContentComposedReason"},
+			{Name: "reason_id", Field: "ReasonId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "subregion_id", Field: "SubregionId", Kind: Int, References: "region"},
+			{Name: "wc_id", Field: "WcId", Kind: Int, References: "written_content"},
+		},
+	},
+	{
+		Name:   "historical_figure",
+		Type:   "HistoricalFigure",
+		Field:  "HistoricalFigures",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "active_interaction", Field: "ActiveInteraction", Kind: String, Multiple: true},
+			{Name: "adventurer", Field: "Adventurer", Kind: Bool},
+			{Name: "animated", Field: "Animated", Kind: Bool},
+			{Name: "animated_string", Field: "AnimatedString", Kind: String},
+			{Name: "appeared", Field: "Appeared", Kind: Int},
+			{Name: "associated_type", Field: "AssociatedType", Kind: String},
+			{Name: "birth_seconds_72", Field: "BirthSeconds72", Kind: Int},
+			{Name: "birth_year", Field: "BirthYear", Kind: Int},
+			{Name: "breed_id", Field: "BreedId", Kind: Int},
+			{Name: "caste", Field: "Caste", Kind: String},
+			{Name: "current_identity_id", Field: "CurrentIdentityId", Kind: Int, References: "identity"},
+			{Name: "death_seconds_72", Field: "DeathSeconds72", Kind: Int},
+			{Name: "death_year", Field: "DeathYear", Kind: Int},
+			{Name: "deity", Field: "Deity", Kind: Bool},
+			{Name: "ent_pop_id", Field: "EntPopId", Kind: Int},
+			{Name: "force", Field: "Force", Kind: Bool},
+			{Name: "ghost", Field: "Ghost", Kind: Bool},
+			{Name: "goal", Field: "Goal", Kind: Enum, Multiple: true, Enum: "HistoricalFigureGoal"},
+			{Name: "holds_artifact", Field: "HoldsArtifact", Kind: Int, Multiple: true},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "interaction_knowledge", Field: "InteractionKnowledge", Kind: String, Multiple: true},
+			{Name: "journey_pet", Field: "JourneyPet", Kind: String, Multiple: true},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "race", Field: "Race", Kind: String},
+			{Name: "sex", Field: "Sex", Kind: Int},
+			{Name: "sphere", Field: "Sphere", Kind: String, Multiple: true},
+			{Name: "used_identity_id", Field: "UsedIdentityId", Kind: Int, Multiple: true, References: "identity"},
+			{Name: "kills", Field: "Kills", Kind: Int, Multiple: true},
+			{Name: "leader", Field: "Leader", Kind: Bool},
+			{Name: "necromancer", Field: "Necromancer", Kind: Bool},
+			{Name: "necromancer_since", Field: "NecromancerSince", Kind: Int},
+			{Name: "vampire", Field: "Vampire", Kind: Bool},
+			{Name: "vampire_since", Field: "VampireSince", Kind: Int},
+			{Name: "werebeast", Field: "Werebeast", Kind: Bool},
+			{Name: "werebeast_since", Field: "WerebeastSince", Kind: Int},
+		},
+	},
+	{
+		Name:   "entity_former_position_link",
+		Type:   "EntityFormerPositionLink",
+		Field:  "EntityFormerPositionLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "end_year", Field: "EndYear", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "position_profile_id", Field: "PositionProfileId", Kind: Int},
+			{Name: "start_year", Field: "StartYear", Kind: Int},
+		},
+	},
+	{
+		Name:   "entity_former_squad_link",
+		Type:   "EntityFormerSquadLink",
+		Field:  "EntityFormerSquadLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "end_year", Field: "EndYear", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "squad_id", Field: "SquadId", Kind: Int},
+			{Name: "start_year", Field: "StartYear", Kind: Int},
+		},
+	},
+	{
+		Name:   "historical_figure_entity_link",
+		Type:   "HistoricalFigureEntityLink",
+		Field:  "EntityLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "link_strength", Field: "LinkStrength", Kind: Int},
+			{Name: "link_type", Field: "LinkType", Kind: Enum, Enum: "HistoricalFigureEntityLinkLinkType"},
+		},
+	},
+	{
+		Name:   "entity_position_link",
+		Type:   "EntityPositionLink",
+		Field:  "EntityPositionLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "position_profile_id", Field: "PositionProfileId", Kind: Int},
+			{Name: "start_year", Field: "StartYear", Kind: Int},
+		},
+	},
+	{
+		Name:   "entity_reputation",
+		Type:   "EntityReputation",
+		Field:  "EntityReputation",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "first_ageless_season_count", Field: "FirstAgelessSeasonCount", Kind: Int},
+			{Name: "first_ageless_year", Field: "FirstAgelessYear", Kind: Int},
+			{Name: "rep_bard", Field: "RepBard", Kind: Int},
+			{Name: "rep_enemy_fighter", Field: "RepEnemyFighter", Kind: Int},
+			{Name: "rep_hero", Field: "RepHero", Kind: Int},
+			{Name: "rep_hunter", Field: "RepHunter", Kind: Int},
+			{Name: "rep_killer", Field: "RepKiller", Kind: Int},
+			{Name: "rep_knowledge_preserver", Field: "RepKnowledgePreserver", Kind: Int},
+			{Name: "rep_poet", Field: "RepPoet", Kind: Int},
+			{Name: "rep_protector_of_weak", Field: "RepProtectorOfWeak", Kind: Int},
+			{Name: "rep_storyteller", Field: "RepStoryteller", Kind: Int},
+			{Name: "rep_thief", Field: "RepThief", Kind: Int},
+			{Name: "rep_treasure_hunter", Field: "RepTreasureHunter", Kind: Int},
+			{Name: "unsolved_murders", Field: "UnsolvedMurders", Kind: Int},
+		},
+	},
+	{
+		Name:   "entity_squad_link",
+		Type:   "EntitySquadLink",
+		Field:  "EntitySquadLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "squad_id", Field: "SquadId", Kind: Int},
+			{Name: "squad_position", Field: "SquadPosition", Kind: Int},
+			{Name: "start_year", Field: "StartYear", Kind: Int},
+		},
+	},
+	{
+		Name:   "hf_link",
+		Type:   "HfLink",
+		Field:  "HfLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "link_strength", Field: "LinkStrength", Kind: Int},
+			{Name: "link_type", Field: "LinkType", Kind: Enum, Enum: "HfLinkLinkType"},
+		},
+	},
+	{
+		Name:   "hf_skill",
+		Type:   "HfSkill",
+		Field:  "HfSkill",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "skill", Field: "Skill", Kind: String},
+			{Name: "total_ip", Field: "TotalIp", Kind: Int},
+		},
+	},
+	{
+		Name:   "honor_entity",
+		Type:   "HonorEntity",
+		Field:  "HonorEntity",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "battles", Field: "Battles", Kind: Int},
+			{Name: "entity", Field: "Entity", Kind: Int, References: "entity"},
+			{Name: "honor_id", Field: "HonorId", Kind: Int, Multiple: true},
+			{Name: "kills", Field: "Kills", Kind: Int},
+		},
+	},
+	{
+		Name:   "intrigue_actor",
+		Type:   "IntrigueActor",
+		Field:  "IntrigueActor",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "handle_actor_id", Field: "HandleActorId", Kind: Int},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "local_id", Field: "LocalId", Kind: Int},
+			{Name: "promised_actor_immortality", Field: "PromisedActorImmortality", Kind: Bool},
+			{Name: "promised_me_immortality", Field: "PromisedMeImmortality", Kind: Bool},
+			{Name: "role", Field: "Role", Kind: Enum, Enum: "IntrigueActorRole"},
+			{Name: "strategy", Field: "Strategy", Kind: Enum, Enum: "IntrigueActorStrategy"},
+			{Name: "strategy_enid", Field: "StrategyEnid", Kind: Int, References: "entity"},
+			{Name: "strategy_eppid", Field: "StrategyEppid", Kind: Int},
+		},
+	},
+	{
+		Name:   "intrigue_plot",
+		Type:   "IntriguePlot",
+		Field:  "IntriguePlot",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "actor_id", Field: "ActorId", Kind: Int},
+			{Name: "artifact_id", Field: "ArtifactId", Kind: Int, References: "artifact"},
+			{Name: "delegated_plot_hfid", Field: "DelegatedPlotHfid", Kind: Int, References: "historical_figure"},
+			{Name: "delegated_plot_id", Field: "DelegatedPlotId", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "local_id", Field: "LocalId", Kind: Int},
+			{Name: "on_hold", Field: "OnHold", Kind: Bool},
+			{Name: "parent_plot_hfid", Field: "ParentPlotHfid", Kind: Int, References: "historical_figure"},
+			{Name: "parent_plot_id", Field: "ParentPlotId", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "IntriguePlotType"},
+		},
+	},
+	{
+		Name:   "plot_actor",
+		Type:   "PlotActor",
+		Field:  "PlotActor",
+		Parent: "intrigue_plot",
+		Columns: []*Column{
+			{Name: "actor_id", Field: "ActorId", Kind: Int},
+			{Name: "agreement_has_messenger", Field: "AgreementHasMessenger", Kind: Bool},
+			{Name: "agreement_id", Field: "AgreementId", Kind: Int},
+			{Name: "plot_role", Field: "PlotRole", Kind: Enum, Enum: "PlotActorPlotRole"},
+		},
+	},
+	{
+		Name:   "relationship_profile_hf_historical",
+		Type:   "RelationshipProfileHfHistorical",
+		Field:  "RelationshipProfileHfHistorical",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "fear", Field: "Fear", Kind: Int},
+			{Name: "hf_id", Field: "HfId", Kind: Int, References: "historical_figure"},
+			{Name: "love", Field: "Love", Kind: Int},
+			{Name: "loyalty", Field: "Loyalty", Kind: Int},
+			{Name: "rep_enemy_fighter", Field: "RepEnemyFighter", Kind: Int},
+			{Name: "rep_hero", Field: "RepHero", Kind: Int},
+			{Name: "rep_hunter", Field: "RepHunter", Kind: Int},
+			{Name: "rep_killer", Field: "RepKiller", Kind: Int},
+			{Name: "rep_psychopath", Field: "RepPsychopath", Kind: Int},
+			{Name: "rep_storyteller", Field: "RepStoryteller", Kind: Int},
+			{Name: "rep_violent", Field: "RepViolent", Kind: Int},
+			{Name: "respect", Field: "Respect", Kind: Int},
+			{Name: "trust", Field: "Trust", Kind: Int},
+		},
+	},
+	{
+		Name:   "relationship_profile_hf_identity",
+		Type:   "RelationshipProfileHfIdentity",
+		Field:  "RelationshipProfileHfIdentity",
+		Parent: "historical_figure",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "fear", Field: "Fear", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "love", Field: "Love", Kind: Int},
+			{Name: "loyalty", Field: "Loyalty", Kind: Int},
+			{Name: "rep_psychopath", Field: "RepPsychopath", Kind: Int},
+			{Name: "respect", Field: "Respect", Kind: Int},
+			{Name: "trust", Field: "Trust", Kind: Int},
+		},
+	},
+	{
+		Name:   "relationship_profile_hf_visual",
+		Type:   "RelationshipProfileHfVisual",
+		Field:  "RelationshipProfileHfVisual",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "fear", Field: "Fear", Kind: Int},
+			{Name: "hf_id", Field: "HfId", Kind: Int, References: "historical_figure"},
+			{Name: "known_identity_id", Field: "KnownIdentityId", Kind: Int, Multiple: true, References: "identity"},
+			{Name: "last_meet_seconds_72", Field: "LastMeetSeconds72", Kind: Int},
+			{Name: "last_meet_year", Field: "LastMeetYear", Kind: Int},
+			{Name: "love", Field: "Love", Kind: Int},
+			{Name: "loyalty", Field: "Loyalty", Kind: Int},
+			{Name: "meet_count", Field: "MeetCount", Kind: Int},
+			{Name: "rep_bonded", Field: "RepBonded", Kind: Int},
+			{Name: "rep_comrade", Field: "RepComrade", Kind: Int},
+			{Name: "rep_flatterer", Field: "RepFlatterer", Kind: Int},
+			{Name: "rep_friendly", Field: "RepFriendly", Kind: Int},
+			{Name: "rep_hero", Field: "RepHero", Kind: Int},
+			{Name: "rep_hunter", Field: "RepHunter", Kind: Int},
+			{Name: "rep_information_source", Field: "RepInformationSource", Kind: Int},
+			{Name: "rep_killer", Field: "RepKiller", Kind: Int},
+			{Name: "rep_psychopath", Field: "RepPsychopath", Kind: Int},
+			{Name: "rep_quarreler", Field: "RepQuarreler", Kind: Int},
+			{Name: "rep_trade_partner", Field: "RepTradePartner", Kind: Int},
+			{Name: "respect", Field: "Respect", Kind: Int},
+			{Name: "trust", Field: "Trust", Kind: Int},
+		},
+	},
+	{
+		Name:   "site_link",
+		Type:   "SiteLink",
+		Field:  "SiteLink",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "link_type", Field: "LinkType", Kind: Enum, Enum: "SiteLinkLinkType"},
+			{Name: "occupation_id", Field: "OccupationId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+			{Name: "sub_id", Field: "SubId", Kind: Int},
+		},
+	},
+	{
+		Name:   "historical_figure_site_property",
+		Type:   "HistoricalFigureSiteProperty",
+		Field:  "SiteProperty",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "property_id", Field: "PropertyId", Kind: Int},
+			{Name: "site_id", Field: "SiteId", Kind: Int, References: "site"},
+		},
+	},
+	{
+		Name:   "vague_relationship",
+		Type:   "VagueRelationship",
+		Field:  "VagueRelationship",
+		Parent: "historical_figure",
+		Columns: []*Column{
+			{Name: "artistic_buddy", Field: "ArtisticBuddy", Kind: Bool},
+			{Name: "atheletic_rival", Field: "AtheleticRival", Kind: Bool},
+			{Name: "athlete_buddy", Field: "AthleteBuddy", Kind: Bool},
+			{Name: "business_rival", Field: "BusinessRival", Kind: Bool},
+			{Name: "childhood_friend", Field: "ChildhoodFriend", Kind: Bool},
+			{Name: "grudge", Field: "Grudge", Kind: Bool},
+			{Name: "hfid", Field: "Hfid", Kind: Int, References: "historical_figure"},
+			{Name: "jealous_obsession", Field: "JealousObsession", Kind: Bool},
+			{Name: "jealous_relationship_grudge", Field: "JealousRelationshipGrudge", Kind: Bool},
+			{Name: "persecution_grudge", Field: "PersecutionGrudge", Kind: Bool},
+			{Name: "religious_persecution_grudge", Field: "ReligiousPersecutionGrudge", Kind: Bool},
+			{Name: "scholar_buddy", Field: "ScholarBuddy", Kind: Bool},
+			{Name: "supernatural_grudge", Field: "SupernaturalGrudge", Kind: Bool},
+			{Name: "war_buddy", Field: "WarBuddy", Kind: Bool},
+		},
+	},
+	{
+		Name:   "identity",
+		Type:   "Identity",
+		Field:  "Identities",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "birth_second", Field: "BirthSecond", Kind: Int},
+			{Name: "birth_year", Field: "BirthYear", Kind: Int},
+			{Name: "caste", Field: "Caste", Kind: String},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "histfig_id", Field: "HistfigId", Kind: Int, References: "historical_figure"},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "nemesis_id", Field: "NemesisId", Kind: Int},
+			{Name: "profession", Field: "Profession", Kind: Enum, Enum: "IdentityProfession"},
+			{Name: "race", Field: "Race", Kind: String},
+		},
+	},
+	{
+		Name:   "landmass",
+		Type:   "Landmass",
+		Field:  "Landmasses",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "coord_1", Field: "Coord1", Kind: String},
+			{Name: "coord_2", Field: "Coord2", Kind: String},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "mountain_peak",
+		Type:   "MountainPeak",
+		Field:  "MountainPeaks",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "height", Field: "Height", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "is_volcano", Field: "IsVolcano", Kind: Bool},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "musical_form",
+		Type:   "MusicalForm",
+		Field:  "MusicalForms",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "description", Field: "Description", Kind: String},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "poetic_form",
+		Type:   "PoeticForm",
+		Field:  "PoeticForms",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "description", Field: "Description", Kind: String},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+		},
+	},
+	{
+		Name:   "region",
+		Type:   "Region",
+		Field:  "Regions",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "evilness", Field: "Evilness", Kind: Enum, Enum: "RegionEvilness"},
+			{Name: "force_id", Field: "ForceId", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "RegionType"},
+		},
+	},
+	{
+		Name:   "river",
+		Type:   "River",
+		Field:  "Rivers",
+		Parent: "df_world",
+		Columns: []*Column{
+			{Name: "end_pos", Field: "EndPos", Kind: String},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "path", Field: "Path", Kind: String},
+			{Name: "id", Field: "Id_", Kind: Int},
+		},
+	},
+	{
+		Name:   "site",
+		Type:   "Site",
+		Field:  "Sites",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "civ_id", Field: "CivId", Kind: Int, References: "entity"},
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "cur_owner_id", Field: "CurOwnerId", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "rectangle", Field: "Rectangle", Kind: String},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "SiteType"},
+			{Name: "owner", Field: "Owner", Kind: Int},
+			{Name: "ruin", Field: "Ruin", Kind: Bool},
+		},
+	},
+	{
+		Name:   "site_site_property",
+		Type:   "SiteSiteProperty",
+		Field:  "SiteProperties",
+		Parent: "site",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "owner_hfid", Field: "OwnerHfid", Kind: Int, References: "historical_figure"},
+			{Name: "structure_id", Field: "StructureId", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "SiteSitePropertyType"},
+		},
+	},
+	{
+		Name:   "structure",
+		Type:   "Structure",
+		Field:  "Structures",
+		Parent: "site",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "copied_artifact_id", Field: "CopiedArtifactId", Kind: Int, Multiple: true, References: "artifact"},
+			{Name: "deity", Field: "Deity", Kind: Int},
+			{Name: "deity_type", Field: "DeityType", Kind: Int},
+			{Name: "dungeon_type", Field: "DungeonType", Kind: Int},
+			{Name: "entity_id", Field: "EntityId", Kind: Int, References: "entity"},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "inhabitant", Field: "Inhabitant", Kind: Int, Multiple: true},
+			{Name: "local_id", Field: "LocalId", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "name_2", Field: "Name2", Kind: String},
+			{Name: "religion", Field: "Religion", Kind: Int},
+			{Name: "subtype", Field: "Subtype", Kind: Enum, Enum: "StructureSubtype"},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "StructureType"},
+			{Name: "worship_hfid", Field: "WorshipHfid", Kind: Int, References: "historical_figure"},
+			{Name: "ruin", Field: "Ruin", Kind: Bool},
+			{Name: "site_id", Field: "SiteId", Kind: Int},
+		},
+	},
+	{
+		Name:   "underground_region",
+		Type:   "UndergroundRegion",
+		Field:  "UndergroundRegions",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "depth", Field: "Depth", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "UndergroundRegionType"},
+		},
+	},
+	{
+		Name:   "world_construction",
+		Type:   "WorldConstruction",
+		Field:  "WorldConstructions",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "coords", Field: "Coords", Kind: String},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "name", Field: "Name_", Kind: String},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "WorldConstructionType"},
+			{Name: "parts", Field: "Parts", Kind: Int, Multiple: true},
+		},
+	},
+	{
+		Name:   "written_content",
+		Type:   "WrittenContent",
+		Field:  "WrittenContents",
+		Parent: "df_world",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "author_hfid", Field: "AuthorHfid", Kind: Int, References: "historical_figure"},
+			{Name: "author_roll", Field: "AuthorRoll", Kind: Int},
+			{Name: "form", Field: "Form", Kind: Enum, Enum: "WrittenContentForm"},
+			{Name: "form_id", Field: "FormId", Kind: Int},
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "page_end", Field: "PageEnd", Kind: Int},
+			{Name: "page_start", Field: "PageStart", Kind: Int},
+			{Name: "style", Field: "Style", Kind: String, Multiple: true},
+			{Name: "title", Field: "Title", Kind: String},
+			{Name: "cited_by", Field: "CitedBy", Kind: Int, Multiple: true},
+			{Name: "copies", Field: "Copies", Kind: Int, Multiple: true},
+		},
+	},
+	{
+		Name:   "reference",
+		Type:   "Reference",
+		Field:  "Reference",
+		Parent: "written_content",
+		Id:     true,
+		Columns: []*Column{
+			{Name: "id", Field: "Id_", Kind: Int},
+			{Name: "type", Field: "Type_", Kind: Enum, Enum: "ReferenceType"},
+		},
+	},
+}
+
+var enums = map[string][]string{
+	"EntityEntityLinkType":                                              {"unknown", "child", "parent", "religious"},
+	"EntityProfession":                                                  {"unknown", "armorer", "blacksmith", "bone carver", "bowyer", "carpenter", "clothier", "craftsman", "doctor", "engineer", "engraver", "farmer", "furnace operator", "gem cutter", "glassmaker", "leatherworker", "mason", "metalcrafter", "metalsmith", "miner", "planter", "pump operator", "ranger", "stoneworker", "tanner", "weaponsmith", "weaver", "woodworker"},
+	"EntityType":                                                        {"unknown", "civilization", "guild", "merchantcompany", "migratinggroup", "militaryunit", "nomadicgroup", "outcast", "performancetroupe", "religion", "sitegovernment"},
+	"EntityWeapon":                                                      {"unknown", "battle axe", "blowgun", "bow", "crossbow", "dagger", "flail", "great axe", "halberd", "long sword", "mace", "maul", "morningstar", "pike", "scimitar", "scourge", "short sword", "spear", "two handed sword", "war hammer", "whip"},
+	"FeatureType":                                                       {"unknown", "acrobats", "banners", "candles", "costumes", "criers in front", "dance performance", "images", "incense burning", "musical performance", "order of precedence", "poetry recital", "storytelling", "the giving of items", "the sacrifice of items"},
+	"HfLinkLinkType":                                                    {"unknown", "apprentice", "child", "companion", "deceased spouse", "deity", "father", "former apprentice", "former master", "former spouse", "imprisoner", "lover", "master", "mother", "pet owner", "prisoner", "spouse"},
+	"HistoricalEventAddHfEntityLinkLink":                                {"unknown", "enemy", "member", "position", "prisoner", "slave", "squad"},
+	"HistoricalEventAddHfHfLinkLinkType":                                {"unknown", "apprentice", "deity", "former master", "lover", "master", "pet owner", "prisoner", "spouse"},
+	"HistoricalEventAddHfSiteLinkLinkType":                              {"unknown", "home site abstract building", "occupation", "prison abstract building", "prison site building profile", "seat of power"},
+	"HistoricalEventAgreementConcludedTopic":                            {"unknown", "treequota"},
+	"HistoricalEventAgreementFormedAction":                              {"unknown", "bribe official", "induce to embezzle"},
+	"HistoricalEventAgreementFormedMethod":                              {"unknown", "blackmail over embezzlement", "bribe", "flatter", "intimidate", "offer immortality", "precedence", "religious sympathy", "revenge on grudge"},
+	"HistoricalEventAgreementFormedReason":                              {"unknown", "reunited with loved one", "violent disagreement", "whim"},
+	"HistoricalEventAgreementFormedTopFacet":                            {"unknown", "ambition", "anxiety propensity", "confidence", "envy propensity", "fearlessness", "greed", "hope", "pride", "stress vulnerability", "swayable", "vanity", "vengeful"},
+	"HistoricalEventAgreementFormedTopRelationshipFactor":               {"unknown", "fear", "love", "loyalty", "respect", "trust"},
+	"HistoricalEventAgreementFormedTopValue":                            {"unknown", "law", "power"},
+	"HistoricalEventAgreementMadeTopic":                                 {"unknown", "becomelandholder", "promotelandholder", "treequota"},
+	"HistoricalEventAgreementRejectedTopic":                             {"unknown", "becomelandholder", "treequota", "tributeagreement", "unknown 10"},
+	"HistoricalEventArtifactClaimFormedCircumstance":                    {"unknown", "during an infiltration mission", "from afar"},
+	"HistoricalEventArtifactClaimFormedClaim":                           {"unknown", "heirloom", "symbol", "treasure"},
+	"HistoricalEventArtifactCreatedCircumstanceType":                    {"unknown", "conflict", "defeated", "favoritepossession", "preservebody", "trauma"},
+	"HistoricalEventArtifactCreatedReason":                              {"unknown", "hire on as scholar", "relax", "sanctify hf", "unknown 1369", "unknown 1745", "unknown 1757", "unknown 176 two", "unknown 1776", "unknown 1803", "unknown 1817", "unknown 1835", "unknown 1837", "unknown 1839", "unknown 1 two two 83", "unknown 310 two 5", "unknown 3550", "unknown 631", "unknown 633", "unknown 9835", "unknown two 084", "unknown two 1758", "unknown two 317 two", "unknown two 6478", "unknown two two 010"},
+	"HistoricalEventArtifactGivenReason":                                {"unknown", "as a symbol of everlasting peace", "part of trade negotiation"},
+	"HistoricalEventArtifactPossessedCircumstance":                      {"unknown", "hf is dead"},
+	"HistoricalEventArtifactPossessedReason":                            {"unknown", "artifact is heirloom of family hfid", "artifact is symbol of entity position"},
+	"HistoricalEventBodyAbusedAbuseType":                                {"unknown", "animated", "flayed", "hung", "impaled", "mutilated", "piled"},
+	"HistoricalEventBodyAbusedItemSubtype":                              {"unknown", "pike", "spear"},
+	"HistoricalEventBodyAbusedItemType":                                 {"unknown", "weapon"},
+	"HistoricalEventBodyAbusedPileType":                                 {"unknown", "grislymound", "grotesquepillar", "gruesomesculpture"},
+	"HistoricalEventChangeHfBodyStateBodyState":                         {"unknown", "entombed at site"},
+	"HistoricalEventChangeHfStateMood":                                  {"unknown", "berserk", "catatonic", "fell", "fey", "insane", "macabre", "melancholy", "possessed", "secretive"},
+	"HistoricalEventChangeHfStateReason":                                {"unknown", "be with master", "conviction exile", "exiled after conviction", "failed mood", "flight", "gather information", "great deal of stress", "lack of sleep", "none", "on a pilgrimage", "scholarship", "unable to leave location"},
+	"HistoricalEventChangeHfStateState":                                 {"unknown", "refugee", "settled", "settler", "visiting", "visitor", "wanderer", "wandering"},
+	"HistoricalEventCollectionBattleOutcome":                            {"unknown", "attacker won", "defender won"},
+	"HistoricalEventCollectionPurgeAdjective":                           {"unknown", "vampire"},
+	"HistoricalEventCreateEntityPositionReason":                         {"unknown", "as a matter of course", "collaboration", "force of argument", "threat of violence", "wave of popular support"},
+	"HistoricalEventCreatedStructureRebuild":                            {"unknown", "false", "true"},
+	"HistoricalEventDanceFormCreatedCircumstance":                       {"unknown", "dream", "dream about hf", "nightmare", "pray to hf"},
+	"HistoricalEventDanceFormCreatedReason":                             {"unknown", "glorify hf"},
+	"HistoricalEventEntityDissolvedReason":                              {"unknown", "heavy losses in battle", "lack of funds"},
+	"HistoricalEventEntityLawLawAdd":                                    {"unknown", "harsh"},
+	"HistoricalEventEntityLawLawRemove":                                 {"unknown", "harsh"},
+	"HistoricalEventEntityPrimaryCriminalsAction":                       {"unknown", "entity primary criminals"},
+	"HistoricalEventEntityRelocateAction":                               {"unknown", "entity relocate"},
+	"HistoricalEventEntitySearchedSiteResult":                           {"unknown", "found nothing"},
+	"HistoricalEventFailedFrameAttemptCrime":                            {"unknown", "treason"},
+	"HistoricalEventFailedIntrigueCorruptionAction":                     {"unknown", "bribe official", "bring into network", "corrupt in place", "induce to embezzle"},
+	"HistoricalEventFailedIntrigueCorruptionMethod":                     {"unknown", "blackmail over embezzlement", "bribe", "flatter", "intimidate", "offer immortality", "precedence", "religious sympathy", "revenge on grudge"},
+	"HistoricalEventFailedIntrigueCorruptionTopFacet":                   {"unknown", "ambition", "anxiety propensity", "confidence", "envy propensity", "fearlessness", "greed", "hope", "pride", "stress vulnerability", "swayable", "vanity", "vengeful"},
+	"HistoricalEventFailedIntrigueCorruptionTopRelationshipFactor":      {"unknown", "fear", "love", "loyalty", "respect", "trust"},
+	"HistoricalEventFailedIntrigueCorruptionTopValue":                   {"unknown", "law", "power"},
+	"HistoricalEventHfConfrontedReason":                                 {"unknown", "ageless", "murder"},
+	"HistoricalEventHfConfrontedSituation":                              {"unknown", "general suspicion"},
+	"HistoricalEventHfConvictedCrime":                                   {"unknown", "attempted kidnapping", "attempted murder", "attempted theft", "bribery", "building destruction", "disorderly conduct", "embezzlement", "espionage", "kidnapping", "murder", "theft", "treason", "vandalism"},
+	"HistoricalEventHfDiedCause":                                        {"unknown", "air", "behead", "bleed", "blood", "blood drained", "burn alive", "bury alive", "cage", "cage blasted", "cavein", "chasm", "collision", "crucify", "crushed", "crushed bridge", "drain blood", "drawbridge", "drown", "drown alt", "drown alt two", "encase ice", "exec beheaded", "exec buried alive", "exec burned alive", "exec crucified", "exec drowned", "exec fed to beasts", "exec generic", "exec hacked to pieces", "execution generic", "falling object", "feed to beasts", "flying object", "freezing water", "hack to pieces", "heat", "hunger", "infection", "leapt from height", "melt", "memorialize", "murder", "murdered", "obstacle", "old age", "put to rest", "quit", "quitdead", "scare", "scared to death", "shot", "slaughter", "slaughtered", "spike", "spikes", "struck", "struck down", "suffocate", "suicide drowned", "suicide leaping", "thirst", "trap", "vanish", "vehicle"},
+	"HistoricalEventHfDiedShooterItemSubtype":                           {"unknown", "bow", "crossbow"},
+	"HistoricalEventHfDiedShooterItemType":                              {"unknown", "weapon"},
+	"HistoricalEventHfDisturbedStructureAction":                         {"unknown", "disturb"},
+	"HistoricalEventHfGainsSecretGoalSecretGoal":                        {"unknown", "immortality"},
+	"HistoricalEventHfLearnsSecretSecretText":                           {"unknown", "the secrets of life and death"},
+	"HistoricalEventHfPrayedInsideStructureAction":                      {"unknown", "prayedinside"},
+	"HistoricalEventHfPreachTopic":                                      {"unknown", "entity 1 should love entity two", "set entity 1 against entity two"},
+	"HistoricalEventHfProfanedStructureAction":                          {"unknown", "profane"},
+	"HistoricalEventHfRecruitedUnitTypeForEntityUnitType":               {"unknown", "monk"},
+	"HistoricalEventHfRelationshipDeniedReason":                         {"unknown", "jealousy", "prefers working alone"},
+	"HistoricalEventHfRelationshipDeniedRelationship":                   {"unknown", "apprentice"},
+	"HistoricalEventHfRevivedGhost":                                     {"unknown", "angry ghost", "energetic poltergeist", "forlorn haunt", "howling spirit", "moaning spirit", "murderous ghost", "restless haunt", "sadistic ghost", "secretive poltergeist", "troublesome poltergeist", "violent ghost"},
+	"HistoricalEventHfSimpleBattleEventSubtype":                         {"unknown", "ambushed", "attacked", "confront", "corner", "got into a brawl", "happen upon", "scuffle", "subdued", "surprised", "two lost after giving wounds", "two lost after mutual wounds", "two lost after receiving wounds"},
+	"HistoricalEventHfWoundedInjuryType":                                {"unknown", "burn", "rip", "slash", "smash", "stab"},
+	"HistoricalEventHfWoundedPartLost":                                  {"unknown", "false", "true"},
+	"HistoricalEventHfsFormedIntrigueRelationshipAction":                {"unknown", "bring into network", "corrupt in place"},
+	"HistoricalEventHfsFormedIntrigueRelationshipCircumstance":          {"unknown", "is entity subordinate"},
+	"HistoricalEventHfsFormedIntrigueRelationshipCorruptorSeenAs":       {"unknown", "master", "possibly unknown director"},
+	"HistoricalEventHfsFormedIntrigueRelationshipMethod":                {"unknown", "blackmail over embezzlement", "bribe", "flatter", "intimidate", "offer immortality", "precedence", "religious sympathy", "revenge on grudge"},
+	"HistoricalEventHfsFormedIntrigueRelationshipTargetSeenAs":          {"unknown", "compromised position holder", "general purpose asset"},
+	"HistoricalEventHfsFormedIntrigueRelationshipTopFacet":              {"unknown", "ambition", "anxiety propensity", "confidence", "envy propensity", "fearlessness", "greed", "hope", "pride", "stress vulnerability", "swayable", "vanity", "vengeful"},
+	"HistoricalEventHfsFormedIntrigueRelationshipTopRelationshipFactor": {"unknown", "fear", "love", "loyalty", "respect", "trust"},
+	"HistoricalEventHfsFormedIntrigueRelationshipTopValue":              {"unknown", "law", "power"},
+	"HistoricalEventHfsFormedReputationRelationshipHfRep1Of2":           {"unknown", "information source"},
+	"HistoricalEventHfsFormedReputationRelationshipHfRep2Of1":           {"unknown", "friendly", "information source"},
+	"HistoricalEventInsurrectionStartedOutcome":                         {"unknown", "leadership overthrown", "population gone"},
+	"HistoricalEventItemStolenCircumstanceType":                         {"unknown", "abducted", "defeated", "histeventcollection", "murdered"},
+	"HistoricalEventItemStolenTheftMethod":                              {"unknown", "confiscated", "looted", "recovered", "theft"},
+	"HistoricalEventKnowledgeDiscoveredKnowledge":                       {"unknown", "astronomy dates of lunar and solar eclipses", "astronomy daylight variation with solar year", "astronomy geocentric model", "astronomy height of tides vs moon and sun", "astronomy heliocentric model", "astronomy method empirical observation", "astronomy method path models", "astronomy path of the moon", "astronomy phases of the moon", "astronomy precession of equinoxes", "astronomy relationship between lunar solar year", "astronomy star catalogues 100", "astronomy star catalogues 1000", "astronomy star charts", "astronomy star color classification", "astronomy star magnitude classification", "astronomy summer winter moon", "astronomy summer winter sun", "astronomy tides and the moon", "chemistry chemicals adhesives", "chemistry chemicals aqua regia", "chemistry chemicals nitric acid", "chemistry chemicals sulfuric acid", "chemistry classification alkali and acids", "chemistry classification combustibles", "chemistry classification elemental theory", "chemistry classification ores", "chemistry classification scratch test", "chemistry laboratory alembic", "chemistry laboratory blast furnace", "chemistry laboratory crucible", "chemistry laboratory glass ampoule", "chemistry laboratory glass beaker", "chemistry laboratory glass flask", "chemistry laboratory glass funnel", "chemistry laboratory glass retort", "chemistry laboratory glass vial", "chemistry laboratory lab ovens", "chemistry laboratory systematic experiments", "chemistry laboratory theory of distillation", "chemistry laboratory theory of evaporation", "chemistry laboratory theory of liquid liquid extraction", "chemistry metallurgy alloys", "engineering astronomy armillary sphere", "engineering astronomy astrolabe", "engineering astronomy dioptra", "engineering construction wood lamination", "engineering design models and templates", "engineering fluid archimedes principle", "engineering fluid force pump", "engineering fluid theory of siphon", "engineering fluid valves", "engineering horology conical water clock", "engineering horology hourglass", "engineering horology shadow clock", "engineering horology water clock", "engineering horology water clock reservoir", "engineering machine balance wheel", "engineering machine bellows", "engineering machine camshaft", "engineering machine chain drive", "engineering machine chariot odometer", "engineering machine combination lock", "engineering machine crank", "engineering machine crankshaft", "engineering machine differential gear", "engineering machine double acting piston bellows", "engineering machine lever", "engineering machine mechanical compass", "engineering machine padlock", "engineering machine piston", "engineering machine pulley", "engineering machine screw", "engineering machine straight beam balance", "engineering machine theory of gears", "engineering machine theory of lever", "engineering machine theory of pulley", "engineering machine theory of screw", "engineering machine theory of wedge", "engineering machine theory of wheel and axle", "engineering machine trip hammer", "engineering machine tumbler lock", "engineering machine verge escapement", "engineering machine warded lock", "engineering machine water powered sawmill", "engineering machine water wheel", "engineering machine windlass", "engineering optics camera obscura", "engineering optics crystal lens", "engineering optics glass lens", "engineering optics theory of color", "engineering optics water filled spheres", "geography cartography basic", "geography cartography distance scale", "geography cartography economic", "geography cartography geological", "geography form atlas", "geography method economic data collection", "geography surveying basic", "geography surveying staff", "geography theory delta formation", "geography theory origin of rainfall from evap condense", "geography theory water cycle", "geography theory wind patterns", "history form alternate history", "history form autobiographical adventure", "history form biographical dictionaries", "history form biography", "history form comparative biography", "history form cultural comparison", "history form cultural history", "history form encyclopedia", "history form genealogy", "history form treatise on tech evolution", "history sourcing basic archaeology", "history sourcing basic reliability", "history sourcing personal interviews", "history sourcing role of cultural differences", "history sourcing role of state bias and propaganda", "history sourcing role of systemic bias", "history theory historical causation", "history theory historical cycles", "history theory social cohesion", "history theory social conflict", "mathematics algebra balancing and completion", "mathematics algebra divergence of harmonic series", "mathematics algebra finite summation formulas", "mathematics algebra pascals triangle", "mathematics algebra quadratic by completing square", "mathematics algebra quadratic formula", "mathematics algebra solving higher order polynomials", "mathematics algebra solving linear systems", "mathematics geometry angle sum difference trig identities", "mathematics geometry area of circle", "mathematics geometry area of triangle from side lengths", "mathematics geometry basic objects", "mathematics geometry existence of incommensurable ratios", "mathematics geometry geometric mean theorem", "mathematics geometry inscribed triangle on diameter is right", "mathematics geometry isosceles base angles equal", "mathematics geometry law of sines", "mathematics geometry pi to 4 digits", "mathematics geometry pi to 6 digits", "mathematics geometry properties of chords", "mathematics geometry pythagorean theorem", "mathematics geometry pythagorean triples 3 digit", "mathematics geometry pythagorean triples 4 digit", "mathematics geometry pythagorean triples small", "mathematics geometry similar and congruent triangles", "mathematics geometry surface area of sphere", "mathematics geometry table of chord values", "mathematics geometry volume of cone", "mathematics geometry volume of pyramid", "mathematics method axiomatic reasoning", "mathematics method exhaustion", "mathematics method proof by contradiction", "mathematics notation early symbols for operations", "mathematics notation large numbers", "mathematics notation negative numbers", "mathematics notation positional", "mathematics notation syncopated algebra", "mathematics notation zero", "mathematics numbers algorithm for computing gcd", "mathematics numbers chinese remainder algorithm", "mathematics numbers division algorithm", "mathematics numbers infinite primes", "mathematics numbers root two irrational", "mathematics numbers root two to 5 digits", "mathematics numbers sieve algorithm for primes", "mathematics numbers unique prime factorization", "medicine method anesthesia", "medicine method asylum for mentally ill", "medicine method autopsy", "medicine method cataract surgery", "medicine method cauterization", "medicine method fracture immobilization", "medicine method fracture treatment", "medicine method hernia surgery", "medicine method hospital lab", "medicine method lithotomy surgery", "medicine method medical school", "medicine method physical examination", "medicine method professional hospital staff", "medicine method specialized wards", "medicine method surgery draining", "medicine method surgery excision", "medicine method surgery incision", "medicine method surgery ligature", "medicine method surgery probing", "medicine method surgery scraping", "medicine method surgery suturing", "medicine method tracheotomy surgery", "medicine method treatment of traumatic injuries", "medicine theory acute and chronic conditions", "medicine theory anatomical studies", "medicine theory blood vessels", "medicine theory classification of bodily fluids", "medicine theory classification of mental illnesses", "medicine theory classification of muscles", "medicine theory comparative anatomy", "medicine theory convalescence", "medicine theory disease and fouled water", "medicine theory disease classification", "medicine theory endemic disease", "medicine theory epidemic disease", "medicine theory exacerbation", "medicine theory eye anatomy", "medicine theory fracture classification", "medicine theory motor vs sensory nerves", "medicine theory nervous system function", "medicine theory paroxysm", "medicine theory prognosis", "medicine theory pulmonary circulation", "medicine theory pulmonary medicine", "medicine theory reaction time", "medicine theory relapse", "medicine theory specialized surgical instruments", "medicine theory surgical models", "medicine theory the voice", "medicine theory toxicology", "medicine theory treatment of mental illnesses", "medicine tool animal remedies", "medicine tool animals as surgical models", "medicine tool bandages", "medicine tool dedicated hospitals", "medicine tool forceps", "medicine tool herbal remedies", "medicine tool mineral remedies", "medicine tool mud bags as surgical models", "medicine tool orthopedic cast", "medicine tool plants as surgical models", "medicine tool scalpel", "medicine tool surgical needles", "medicine tool surgical scissors", "medicine tool traction bench", "naturalist method dissection", "naturalist observation anatomy", "naturalist observation diseases", "naturalist observation embriological development", "naturalist observation foraging behavior and diet", "naturalist observation migration patterns", "naturalist observation reproductive behavior", "naturalist observation social behavior", "naturalist theory classification by physical features", "naturalist theory climatic adaptation", "naturalist theory comparative anatomy", "naturalist theory food chain", "naturalist theory struggle for existence", "philosophy aesthetics nature of beauty", "philosophy aesthetics value of art", "philosophy epistemology belief", "philosophy epistemology justification", "philosophy epistemology perception", "philosophy epistemology truth", "philosophy ethics applied interpersonal conduct", "philosophy ethics applied medical", "philosophy ethics applied military", "philosophy ethics individual value", "philosophy ethics state consequentialism", "philosophy logic analogical inference", "philosophy logic deductive reasoning", "philosophy logic dialectic reasoning", "philosophy logic direct inference", "philosophy logic formal reasoning", "philosophy logic hypothetical syllogisms", "philosophy logic inductive reasoning", "philosophy logic syllogistic logic", "philosophy metaphysics causation", "philosophy metaphysics events", "philosophy metaphysics existence", "philosophy metaphysics mind body", "philosophy metaphysics objects and properties", "philosophy metaphysics processes", "philosophy metaphysics time", "philosophy metaphysics wholes and parts", "philosophy specialized education", "philosophy specialized language dictionary", "philosophy specialized language etymology", "philosophy specialized language grammar", "philosophy specialized law", "philosophy specialized politics diplomacy", "philosophy specialized politics economic policy", "philosophy specialized politics government forms", "philosophy specialized politics social welfare"},
+	"HistoricalEventMasterpieceArchConstructedBuildingSubtype":          {"unknown", "magmasmelter", "smelter", "woodfurnace"},
+	"HistoricalEventMasterpieceArchConstructedBuildingType":             {"unknown", "bridge", "furnace", "screwpump", "tradedepot", "waterwheel", "well"},
+	"HistoricalEventMasterpieceArchConstructedSkillAtTime":              {"unknown", "accomplished", "expert", "highmaster", "legendary 3", "legendary 5", "master", "professional", "proficient", "unknown 33", "unknown two 1", "unknown two 3", "unknown two 4", "unknown two 5", "unknown two two"},
+	"HistoricalEventMasterpieceDyeItemType":                             {"unknown", "cloth"},
+	"HistoricalEventMasterpieceDyeMat":                                  {"unknown", "pig tail"},
+	"HistoricalEventMasterpieceDyeSkillAtTime":                          {"unknown", "skilled"},
+	"HistoricalEventMasterpieceFoodItemSubtype":                         {"unknown", "biscuits", "roast", "stew"},
+	"HistoricalEventMasterpieceFoodItemType":                            {"unknown", "food"},
+	"HistoricalEventMasterpieceFoodSkillAtTime":                         {"unknown", "accomplished", "adept", "competent", "expert", "grandmaster", "great", "highmaster", "legendary", "legendary 1", "legendary 3", "legendary 4", "legendary 5", "legendary two", "master", "professional", "proficient", "skilled", "talented", "unknown 30", "unknown two 1", "unknown two 3", "unknown two 4", "unknown two 5", "unknown two 6", "unknown two 7", "unknown two 8", "unknown two 9", "unknown two two"},
+	"HistoricalEventMasterpieceItemImprovementImprovementType":          {"unknown", "art image", "bands", "covered", "itemspecific", "rings hanging", "spikes"},
+	"HistoricalEventMasterpieceItemImprovementSkillAtTime":              {"unknown", "accomplished", "adept", "adequate", "competent", "expert", "great", "highmaster", "legendary", "legendary 1", "legendary 3", "legendary 4", "legendary 5", "legendary two", "master", "professional", "proficient", "skilled", "talented", "unknown 44", "unknown two 1", "unknown two 3", "unknown two 4", "unknown two 5", "unknown two 6", "unknown two two"},
+	"HistoricalEventModifiedBuildingModification":                       {"unknown", "dungeon", "feast hall", "gated courtyard", "improved fortifications"},
+	"HistoricalEventMusicalFormCreatedCircumstance":                     {"unknown", "dream", "dream about hf", "nightmare", "pray to hf"},
+	"HistoricalEventMusicalFormCreatedReason":                           {"unknown", "glorify hf"},
+	"HistoricalEventPeaceAcceptedTopic":                                 {"unknown", "requestpeace"},
+	"HistoricalEventPeaceRejectedTopic":                                 {"unknown", "requestpeace"},
+	"HistoricalEventPoeticFormCreatedCircumstance":                      {"unknown", "dream", "nightmare"},
+	"HistoricalEventRelationshipRelationship":                           {"unknown", "artistic buddy", "atheletic rival", "athlete buddy", "business rival", "childhood friend", "former lover", "grudge", "jealous obsession", "jealous relationship grudge", "lieutenant", "lover", "persecution grudge", "religious persecution grudge", "scholar buddy", "supernatural grudge", "war buddy"},
+	"HistoricalEventRemoveHfEntityLinkLink":                             {"unknown", "member", "position", "prisoner", "slave"},
+	"HistoricalEventRemoveHfSiteLinkLinkType":                           {"unknown", "home site abstract building", "occupation", "seat of power"},
+	"HistoricalEventSiteDisputeDispute":                                 {"unknown", "fishing rights", "grazing rights", "livestock ownership", "rights of way", "territory", "water rights"},
+	"HistoricalEventSiteTributeForcedSeason":                            {"unknown", "autumn", "spring", "summer", "winter"},
+	"HistoricalEventTacticalSituationSituation":                         {"unknown", "a favored", "a slightly favored", "a strongly favored", "d slightly favored", "d strongly favored", "neither favored"},
+	"HistoricalEventWrittenContentComposedCircumstance":                 {"unknown", "dream", "dream about hf", "nightmare", "pray to hf"},
+	"HistoricalEventWrittenContentComposedReason":                       {"unknown", "glorify hf"},
+	"HistoricalFigureEntityLinkLinkType":                                {"unknown", "criminal", "enemy", "former member", "former prisoner", "former slave", "member", "prisoner", "slave"},
+	"HistoricalFigureGoal":                                              {"unknown", "attain rank in society", "bathe world in chaos", "become a legendary warrior", "bring peace to the world", "craft a masterwork", "create a great work of art", "fall in love", "immortality", "maintain entity status", "make a great discovery", "master a skill", "rule the world", "see the great natural sites", "start a family"},
+	"HonorRequiredSkill":                                                {"unknown", "axe", "bow", "crossbow", "hammer", "mace", "pike", "spear", "sword", "whip"},
+	"IdentityProfession":                                                {"unknown", "bard", "beast hunter", "criminal", "dancer", "mercenary", "merchant", "monk", "monster slayer", "peddler", "pilgrim", "poet", "prophet", "scholar", "snatcher", "standard"},
+	"IntrigueActorRole":                                                 {"unknown", "asset", "corrupt position holder", "delivery target", "enemy", "handler", "lieutenant", "master", "possible threat", "possibly unknown director", "potential employer", "rebuffed", "source of funds", "source of funds for master", "suspected criminal", "underworld contact", "usable assassin", "usable snatcher", "usable thief"},
+	"IntrigueActorStrategy":                                             {"unknown", "avoid", "corrupt and pacify", "monitor", "neutralize", "obey", "tax", "torment", "use", "work if suited"},
+	"IntriguePlotType":                                                  {"unknown", "acquire artifact", "assassinate actor", "attain rank", "become immortal", "corrupt actors government", "corruptly punish actor", "counterintelligence", "direct war to actor", "frame actor", "grow asset network", "grow corruption network", "grow funding network", "infiltrate society", "kidnap actor", "sabotage actor", "undead world conquest"},
+	"PlotActorPlotRole":                                                 {"unknown", "assassin", "source of funds", "thief"},
+	"ReferenceType":                                                     {"unknown", "abstractbuilding", "artifact", "danceform", "entity", "historicalevent", "historicalfigure", "interaction", "knowledgescholarflag", "language", "musicalform", "poeticform", "site", "subregion", "valuelevel", "writtencontent"},
+	"RegionEvilness":                                                    {"unknown", "evil", "good", "neutral"},
+	"RegionType":                                                        {"unknown", "desert", "forest", "glacier", "grassland", "hills", "lake", "mountains", "ocean", "tundra", "wetland"},
+	"ScheduleItemSubtype":                                               {"unknown", "astod stand", "battle axe", "bow", "crossbow", "ebpel blowpipe", "flail", "great axe", "halberd", "hive", "itux body", "long sword", "mace", "maul", "morningstar", "mukar body", "nest box", "pedestal", "pike", "quire", "rohir body", "scimitar", "short sword", "spear", "two handed sword", "war hammer", "whip"},
+	"ScheduleItemType":                                                  {"unknown", "tool", "weapon"},
+	"ScheduleType":                                                      {"unknown", "ceremony", "dance competition", "dance performance", "foot race", "gladiatory competition", "musical competition", "musical performance", "poetry competition", "poetry recital", "procession", "storytelling", "throwing competition", "wrestling competition"},
+	"SiteLinkLinkType":                                                  {"unknown", "hangout", "home saved zone", "home site building", "home site underground", "home structure", "lair", "occupation", "prison building profile", "seat of power"},
+	"SiteSitePropertyType":                                              {"unknown", "house"},
+	"SiteType":                                                          {"unknown", "camp", "castle", "cave", "dark fortress", "dark pits", "forest retreat", "fort", "fortress", "hamlet", "hillocks", "important location", "labyrinth", "lair", "monastery", "mountain halls", "shrine", "tomb", "tower", "town", "vault"},
+	"StructureSubtype":                                                  {"unknown", "catacombs", "sewers"},
+	"StructureType":                                                     {"unknown", "counting house", "dungeon", "guildhall", "hospital", "inn tavern", "keep", "library", "market", "mead hall", "temple", "tomb", "tower", "underworld spire"},
+	"UndergroundRegionType":                                             {"unknown", "cavern", "magma", "underworld"},
+	"WorldConstructionType":                                             {"unknown", "bridge", "road", "tunnel"},
+	"WrittenContentForm":                                                {"unknown", "alternate history", "atlas", "autobiography", "biographical dictionary", "biography", "choreography", "chronicle", "comparative biography", "cultural comparison", "cultural history", "dialog", "dictionary", "encyclopedia", "essay", "genealogy", "guide", "letter", "manual", "musical composition", "novel", "play", "poem", "short story", "star catalogue", "star chart", "treatise on technological evolution"},
+}
@@ -0,0 +1,241 @@
+package export
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+	_ "modernc.org/sqlite"
+)
+
+// ExportSqlite writes the world to a new SQLite database. Every object type
+// gets a table, nested objects reference the row of their parent with
+// parent_id, and the details of events and collections share the id of
+// their base row. Columns with multiple values get a table of their own and
+// enum values reference a lookup table.
+func ExportSqlite(world *model.DfWorld, path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, s := range []string{"PRAGMA journal_mode = OFF", "PRAGMA synchronous = OFF"} {
+		if _, err := db.Exec(s); err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := createEnums(tx); err != nil {
+		return err
+	}
+
+	inserts := make(map[*Table]*sqliteInsert)
+	for _, t := range tables {
+		ins, err := createTable(tx, t)
+		if err != nil {
+			return fmt.Errorf("%s: %w", t.Name, err)
+		}
+		inserts[t] = ins
+	}
+
+	err = walk(world, func(t *Table, key, parent int, x reflect.Value) error {
+		if err := inserts[t].insert(t, key, parent, x); err != nil {
+			return fmt.Errorf("%s %d: %w", t.Name, key, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tables {
+		if err := createIndexes(tx, t); err != nil {
+			return fmt.Errorf("%s: %w", t.Name, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func enumTable(enum string) string {
+	return "enum_" + strcase.ToSnake(enum)
+}
+
+func createEnums(tx *sql.Tx) error {
+	var names []string
+	for n := range enums {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		t := enumTable(n)
+		if _, err := tx.Exec(fmt.Sprintf(`CREATE TABLE %q (id INTEGER PRIMARY KEY, name TEXT NOT NULL)`, t)); err != nil {
+			return err
+		}
+		// 0 is stored as NULL
+		for i, v := range enums[n][1:] {
+			if _, err := tx.Exec(fmt.Sprintf(`INSERT INTO %q VALUES (?, ?)`, t), i+1, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sqliteInsert holds the statements inserting the rows of a table and of the
+// tables of its multiple valued columns.
+type sqliteInsert struct {
+	row    *sql.Stmt
+	single []*Column
+	values map[*Column]*sql.Stmt
+}
+
+// valuesTable is the table of the values of a column with multiple values,
+// avoiding the names of the tables of nested objects.
+func valuesTable(t *Table, c *Column) string {
+	name := t.Name + "_" + c.Name
+	if _, ok := tablesByName[name]; ok {
+		name += "_value"
+	}
+	return name
+}
+
+func sqlType(c *Column) string {
+	switch c.Kind {
+	case Int:
+		if r, ok := tablesByName[c.References]; ok && r.root() {
+			return fmt.Sprintf("INTEGER REFERENCES %q(id)", r.Name)
+		}
+		return "INTEGER"
+	case Bool:
+		return "INTEGER"
+	case Enum:
+		return fmt.Sprintf("INTEGER REFERENCES %q(id)", enumTable(c.Enum))
+	}
+	return "TEXT"
+}
+
+func createTable(tx *sql.Tx, t *Table) (*sqliteInsert, error) {
+	ins := &sqliteInsert{values: make(map[*Column]*sql.Stmt)}
+
+	key := fmt.Sprintf("%q INTEGER PRIMARY KEY", t.Key())
+	if t.SubType {
+		key += fmt.Sprintf(" REFERENCES %q(id)", t.parent.Name)
+	}
+	defs := []string{key}
+	names := []string{t.Key()}
+	if p := t.ParentKey(); p != "" {
+		defs = append(defs, fmt.Sprintf("%q INTEGER NOT NULL REFERENCES %q(%q)", p, t.parent.Name, t.parent.Key()))
+		names = append(names, p)
+	}
+
+	for _, c := range t.Columns {
+		if c.Name == t.Key() || c.Name == t.ParentKey() {
+			continue
+		}
+		if c.Multiple {
+			v := valuesTable(t, c)
+			s := fmt.Sprintf(`CREATE TABLE %q (parent_id INTEGER NOT NULL REFERENCES %q(%q), seq INTEGER NOT NULL, value %s)`,
+				v, t.Name, t.Key(), sqlType(c))
+			if _, err := tx.Exec(s); err != nil {
+				return nil, err
+			}
+			stmt, err := tx.Prepare(fmt.Sprintf(`INSERT INTO %q VALUES (?, ?, ?)`, v))
+			if err != nil {
+				return nil, err
+			}
+			ins.values[c] = stmt
+			continue
+		}
+		defs = append(defs, fmt.Sprintf("%q %s", c.Name, sqlType(c)))
+		names = append(names, c.Name)
+		ins.single = append(ins.single, c)
+	}
+
+	if _, err := tx.Exec(fmt.Sprintf("CREATE TABLE %q (\n  %s\n)", t.Name, strings.Join(defs, ",\n  "))); err != nil {
+		return nil, err
+	}
+	var err error
+	ins.row, err = tx.Prepare(fmt.Sprintf(`INSERT INTO %q VALUES (?%s)`, t.Name, strings.Repeat(", ?", len(names)-1)))
+	return ins, err
+}
+
+func (ins *sqliteInsert) insert(t *Table, key, parent int, x reflect.Value) error {
+	args := []any{key}
+	if t.ParentKey() != "" {
+		args = append(args, parent)
+	}
+	for _, c := range ins.single {
+		args = append(args, c.Value(x))
+	}
+	if _, err := ins.row.Exec(args...); err != nil {
+		return err
+	}
+
+	for _, c := range t.Columns {
+		stmt, ok := ins.values[c]
+		if !ok {
+			continue
+		}
+		for i, v := range c.Values(x) {
+			if _, err := stmt.Exec(key, i, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexed columns are the ones usually filtered by
+func indexed(c *Column) bool {
+	return strings.Contains(c.Name, "year") || c.References == "site" || c.References == "historical_figure"
+}
+
+func createIndexes(tx *sql.Tx, t *Table) error {
+	index := func(table, column string) error {
+		_, err := tx.Exec(fmt.Sprintf(`CREATE INDEX %q ON %q (%q)`, table+"_"+column+"_idx", table, column))
+		return err
+	}
+
+	if p := t.ParentKey(); p != "" {
+		if err := index(t.Name, p); err != nil {
+			return err
+		}
+	}
+	for _, c := range t.Columns {
+		if c.Name == t.Key() || c.Name == t.ParentKey() {
+			continue
+		}
+		if c.Multiple {
+			v := valuesTable(t, c)
+			if err := index(v, "parent_id"); err != nil {
+				return err
+			}
+			if indexed(c) {
+				if err := index(v, "value"); err != nil {
+					return err
+				}
+			}
+		} else if indexed(c) && c.Kind == Int {
+			if err := index(t.Name, c.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
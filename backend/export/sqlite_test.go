@@ -0,0 +1,62 @@
+package export
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+)
+
+const fixtureFile = "../model/testdata/fixture-legends.xml"
+
+func parseFixture(t *testing.T) *model.DfWorld {
+	t.Helper()
+	w, err := model.Parse(fixtureFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestExportSqlite(t *testing.T) {
+	world := parseFixture(t)
+	path := filepath.Join(t.TempDir(), "world.db")
+	if err := ExportSqlite(world, path); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	counts := map[string]int{
+		"historical_event":            world.EventCount(),
+		"historical_figure":           world.HfCount(),
+		"historical_event_collection": len(world.HistoricalEventCollections),
+		"site":                        len(world.Sites),
+		"entity":                      len(world.Entities),
+		"river":                       len(world.Rivers),
+	}
+	for table, want := range counts {
+		var got int
+		if err := db.QueryRow("SELECT count(*) FROM " + table).Scan(&got); err != nil {
+			t.Errorf("%s: %v", table, err)
+			continue
+		}
+		if got != want || want == 0 {
+			t.Errorf("%s has %d rows, want %d", table, got, want)
+		}
+	}
+
+	var id int
+	var name string
+	if err := db.QueryRow("SELECT id, name FROM river ORDER BY id LIMIT 1").Scan(&id, &name); err != nil {
+		t.Fatal(err)
+	}
+	if r := world.Rivers[0]; id != r.Id_ || name != r.Name_ {
+		t.Errorf("river %d %q, want %d %q", id, name, r.Id_, r.Name_)
+	}
+}
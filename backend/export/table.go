@@ -0,0 +1,228 @@
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+)
+
+type Kind int
+
+const (
+	Int Kind = iota
+	String
+	Bool
+	Enum
+	// Type is the type of the details of an event or collection
+	Type
+)
+
+// Table describes how the objects of a model type are exported. The tables
+// are generated from the analyzer metadata by GenerateExportCode.
+type Table struct {
+	Name string
+	// Type is the model type of the objects
+	Type string
+	// Field is the field of the parent object holding the objects
+	Field  string
+	Parent string
+	// SubType tables hold the details of their parent
+	SubType  bool
+	Id       bool
+	Columns  []*Column
+	parent   *Table
+	children []*Table
+	rows     int
+}
+
+type Column struct {
+	Name     string
+	Field    string
+	Kind     Kind
+	Multiple bool
+	// Enum is the model type of enum values
+	Enum string
+	// References is the table of the objects with the ids of the column
+	References string
+}
+
+var tablesByName = make(map[string]*Table)
+
+func init() {
+	for _, t := range tables {
+		tablesByName[t.Name] = t
+		if p, ok := tablesByName[t.Parent]; ok {
+			t.parent = p
+			p.children = append(p.children, t)
+		}
+	}
+}
+
+// root tables hold the objects of the world with unique ids
+func (t *Table) root() bool {
+	return t.Id && t.parent != nil && t.parent.parent == nil
+}
+
+// Key is the column identifying the rows of the table, the id for objects
+// of the world and events and collections, a row number otherwise.
+func (t *Table) Key() string {
+	if t.root() || t.SubType {
+		return "id"
+	}
+	return "row_id"
+}
+
+// ParentKey is the column referencing the parent row, empty for objects of
+// the world and details, which share the id of their parent.
+func (t *Table) ParentKey() string {
+	if t.parent == nil || t.parent.parent == nil || t.SubType {
+		return ""
+	}
+	return "parent_id"
+}
+
+// Value returns the value of a single column, nil for unset ids and
+// unknown enum values.
+func (c *Column) Value(x reflect.Value) any {
+	f := x.FieldByName(c.Field)
+	if c.Kind == Type {
+		if f.IsNil() {
+			return nil
+		}
+		return f.Interface().(interface{ Type() string }).Type()
+	}
+	return value(c.Kind, f)
+}
+
+// Values returns the values of a column holding multiple values.
+func (c *Column) Values(x reflect.Value) []any {
+	f := x.FieldByName(c.Field)
+	var list []any
+	for i := 0; i < f.Len(); i++ {
+		list = append(list, value(c.Kind, f.Index(i)))
+	}
+	return list
+}
+
+func value(kind Kind, f reflect.Value) any {
+	switch kind {
+	case Int:
+		if f.Int() == -1 {
+			return nil
+		}
+		return f.Int()
+	case String:
+		return f.String()
+	case Bool:
+		return f.Bool()
+	case Enum:
+		if f.Int() == 0 {
+			return nil
+		}
+		return f.Int()
+	}
+	return nil
+}
+
+// EnumValues lists the names of the values of an enum type, the index is
+// the value.
+func EnumValues(enum string) []string {
+	return enums[enum]
+}
+
+// visitor is called for every object with the key of its row and the key
+// of the row of its parent.
+type visitor func(t *Table, key, parent int, x reflect.Value) error
+
+// walk visits the world and all its objects, parents before children.
+func walk(world *model.DfWorld, visit visitor) error {
+	for _, t := range tables {
+		t.rows = 0
+	}
+	return tables[0].walk(1, 0, reflect.ValueOf(world).Elem(), visit, world)
+}
+
+func (t *Table) walk(key, parent int, x reflect.Value, visit visitor, world *model.DfWorld) error {
+	if err := visit(t, key, parent, x); err != nil {
+		return err
+	}
+	for _, c := range t.children {
+		if err := c.walkField(key, x, visit, world); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Table) walkField(parent int, x reflect.Value, visit visitor, world *model.DfWorld) error {
+	child := func(v reflect.Value) error {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil
+			}
+			v = v.Elem()
+		}
+		key := parent
+		if t.root() {
+			key = int(v.FieldByName("Id_").Int())
+		} else if !t.SubType {
+			t.rows++
+			key = t.rows
+		}
+		return t.walk(key, parent, v, visit, world)
+	}
+
+	if t.SubType {
+		d := x.FieldByName("Details")
+		if d.IsNil() || d.Elem().Elem().Type().Name() != t.Type {
+			return nil
+		}
+		return child(d.Elem())
+	}
+
+	// events and figures might be kept in a store
+	if world.Store != nil && t.parent.parent == nil {
+		var err error
+		switch t.Type {
+		case "HistoricalEvent":
+			world.EachEvent(func(e *model.HistoricalEvent) {
+				if err == nil {
+					err = child(reflect.ValueOf(e))
+				}
+			})
+			return err
+		case "HistoricalFigure":
+			world.EachHf(func(hf *model.HistoricalFigure) {
+				if err == nil {
+					err = child(reflect.ValueOf(hf))
+				}
+			})
+			return err
+		}
+	}
+
+	f := x.FieldByName(t.Field)
+	switch f.Kind() {
+	case reflect.Map:
+		keys := f.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].Int() < keys[j].Int() })
+		for _, k := range keys {
+			if err := child(f.MapIndex(k)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < f.Len(); i++ {
+			if err := child(f.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr, reflect.Struct:
+		return child(f)
+	default:
+		return fmt.Errorf("%s.%s: cannot export %s", t.parent.Type, t.Field, f.Kind())
+	}
+	return nil
+}
@@ -2,14 +2,21 @@ package main
 
 import (
 	"embed"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"runtime"
+	"runtime/pprof"
+	"syscall"
+	"time"
 
 	"github.com/pkg/profile"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/export"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/server"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/templates"
@@ -21,7 +28,9 @@ var static embed.FS
 
 var (
 	f, c, subUri string
+	textPack     string
 	l, p, d, s   *bool
+	memstats     *bool
 	port         *int
 )
 
@@ -46,10 +55,11 @@ var rootCmd = &cobra.Command{
 
 		config, err := server.LoadConfig(c)
 		if err != nil {
-			log.Fatal(err)
+			fatal(err)
 		}
 
 		templates.DebugTemplates = config.DebugTemplates
+		loadTextPack(config)
 
 		server.DebugJSON = *d
 		config.Port = *port
@@ -71,17 +81,175 @@ var rootCmd = &cobra.Command{
 				world = w
 			}
 		}
+		if *memstats {
+			reportMemStats(world)
+		}
+
+		err = server.StartServer(config, world, nil, static)
+		if err != nil {
+			fatal(err)
+		}
+	},
+}
+
+var diffJson string
+
+var diffCmd = &cobra.Command{
+	Use:   "diff old-legends.xml new-legends.xml",
+	Short: "Compare two exports of the same world",
+	Long: `Compare two exports of the same world and report new events, collections,
+figures and artifacts, deaths, changed site owners and position changes.
+The report is written as JSON with --json, otherwise the browser is started
+with the newer world loaded and the report opened.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		out := os.Stdout
+		if diffJson == "-" {
+			// keep the progress output out of the report
+			model.Log = os.Stderr
+		}
+		config, err := server.LoadConfig(c)
+		if err != nil {
+			fatal(err)
+		}
+		loadTextPack(config)
+
+		old, err := model.Parse(args[0], nil)
+		if err != nil {
+			fatal(err)
+		}
+		world, err := model.Parse(args[1], nil)
+		if err != nil {
+			fatal(err)
+		}
+		diff := model.NewWorldDiff(old, world)
+		runtime.GC()
+		if *memstats {
+			reportMemStats(old, world)
+		}
+
+		if diffJson != "" {
+			if diffJson != "-" {
+				out, err = os.Create(diffJson)
+				if err != nil {
+					fatal(err)
+				}
+				defer out.Close()
+			}
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(diff); err != nil {
+				fatal(err)
+			}
+			return
+		}
+
+		templates.DebugTemplates = config.DebugTemplates
+		server.DebugJSON = *d
+		config.Port = *port
+		config.ServerMode = *s
+		config.SubUri = subUri
 
-		err = server.StartServer(config, world, static)
+		err = server.StartServer(config, world, diff, static)
+		if err != nil {
+			fatal(err)
+		}
+	},
+}
+
+var exportSqliteCmd = &cobra.Command{
+	Use:   "export-sqlite world-legends.xml world.db",
+	Short: "Export a world to a SQLite database",
+	Long: `Export a world to a new SQLite database with a table for every type of
+object, event and collection. Nested objects reference their parent row with
+parent_id, details of events and collections share the id of the event or
+collection and enum values reference enum_* lookup tables.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		world, err := model.Parse(args[0], nil)
 		if err != nil {
-			log.Fatal(err)
+			fatal(err)
 		}
+		defer world.Close()
+
+		start := time.Now()
+		if err := export.ExportSqlite(world, args[1]); err != nil {
+			fatal(err)
+		}
+		fmt.Printf("exported to %s in %v\n", args[1], time.Since(start))
 	},
 }
 
+// fatal removes the stores of the loaded worlds before exiting, as deferred
+// calls are skipped.
+func fatal(err error) {
+	model.CloseStores()
+	log.Fatal(err)
+}
+
+// loadTextPack sets the text pack given with --texts or in the config.
+func loadTextPack(config *server.Config) {
+	if textPack == "" {
+		textPack = config.TextPack
+	}
+	if textPack == "" {
+		return
+	}
+	texts, err := model.LoadTextPack(textPack)
+	if err != nil {
+		fatal(err)
+	}
+	model.Texts = texts
+}
+
+// reportMemStats prints the memory used by the loaded worlds and writes a
+// heap profile, that can be inspected with sample.sh.
+func reportMemStats(worlds ...*model.DfWorld) {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	mb := func(b uint64) float64 { return float64(b) / (1 << 20) }
+	fmt.Fprintln(model.Log)
+	for _, w := range worlds {
+		if w != nil {
+			fmt.Fprintf(model.Log, "%-24s %d events, %d collections, %d figures, %d interned strings\n", filepath.Base(w.FilePath),
+				w.EventCount(), len(w.HistoricalEventCollections), w.HfCount(), w.InternedStrings)
+		}
+	}
+	fmt.Fprintf(model.Log, "%-24s %8.1f MB\n", "heap in use", mb(m.HeapInuse))
+	fmt.Fprintf(model.Log, "%-24s %8.1f MB\n", "heap allocated", mb(m.HeapAlloc))
+	fmt.Fprintf(model.Log, "%-24s %8d\n", "heap objects", m.HeapObjects)
+	fmt.Fprintf(model.Log, "%-24s %8.1f MB\n", "obtained from system", mb(m.Sys))
+	fmt.Fprintf(model.Log, "%-24s %8d\n", "garbage collections", m.NumGC)
+
+	f, err := os.Create("heap.pprof")
+	if err != nil {
+		fmt.Fprintln(model.Log, err)
+		return
+	}
+	defer f.Close()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		fmt.Fprintln(model.Log, err)
+		return
+	}
+	fmt.Fprintln(model.Log, "heap profile written to heap.pprof")
+}
+
 func main() {
 	cobra.MousetrapHelpText = ""
-	if err := rootCmd.Execute(); err != nil {
+
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-interrupted
+		model.CloseStores()
+		os.Exit(1)
+	}()
+
+	err := rootCmd.Execute()
+	model.CloseStores()
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -95,5 +263,13 @@ func init() {
 	p = rootCmd.PersistentFlags().BoolP("profile", "P", false, "start profiling")
 	d = rootCmd.PersistentFlags().BoolP("debug", "d", false, "show debug data")
 	s = rootCmd.PersistentFlags().BoolP("serverMode", "s", false, "run in server mode (disables file chooser)")
+	rootCmd.PersistentFlags().StringVar(&model.StoreDir, "store", "", "keep events and figures in a file in this directory instead of memory")
+	rootCmd.PersistentFlags().StringVarP(&textPack, "texts", "t", "", "text pack with the sentences of events and collections")
+	rootCmd.PersistentFlags().BoolVar(&model.CheckAfterLoading, "check", false, "check the texts of all events after loading")
+	memstats = rootCmd.PersistentFlags().BoolP("memstats", "m", false, "report memory usage after loading")
 	port = rootCmd.PersistentFlags().IntP("port", "p", 58881, "use specific port")
+
+	diffCmd.Flags().StringVarP(&diffJson, "json", "j", "", "write the report as json to a file, - for stdout")
+	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(exportSqliteCmd)
 }
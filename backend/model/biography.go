@@ -0,0 +1,330 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	phaseBirth = iota
+	phaseApprenticeship
+	phaseLife
+	phasePositions
+	phaseWars
+	phaseDeath
+)
+
+var biographyPhases = []string{"Birth and Youth", "Apprenticeship", "Life and Travels", "Positions", "Wars and Battles", "Death"}
+
+// years of life that count towards the birth and youth chapter
+const biographyYouth = 12
+
+type Biography struct {
+	Hf       *HistoricalFigure
+	Chapters []*BiographyChapter
+}
+
+type BiographyChapter struct {
+	Title      string
+	Paragraphs []string
+}
+
+type biographySentence struct {
+	year    int
+	time    string
+	subject bool
+	text    string
+}
+
+type biographyBuilder struct {
+	world   *DfWorld
+	hf      *HistoricalFigure
+	context *Context
+	name    string
+}
+
+func NewBiography(world *DfWorld, hf *HistoricalFigure) *Biography {
+	b := &biographyBuilder{
+		world:   world,
+		hf:      hf,
+		context: &Context{World: world, HfId: hf.Id_},
+	}
+	b.name = b.context.hfShort(hf.Id_)
+
+	events := world.EventsRelatedTo(hf.Id_, func(d HistoricalEventDetails) bool { return d.RelatedToHf(hf.Id_) })
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Year != events[j].Year {
+			return events[i].Year < events[j].Year
+		}
+		return events[i].Seconds72 < events[j].Seconds72
+	})
+
+	phases := make([][]*HistoricalEvent, len(biographyPhases))
+	for _, e := range events {
+		p := b.phase(e)
+		phases[p] = append(phases[p], e)
+	}
+
+	bio := &Biography{Hf: hf}
+	for p, list := range phases {
+		sentences := b.sentences(list)
+		switch p {
+		case phaseBirth:
+			sentences = append([]*biographySentence{b.birth()}, sentences...)
+		case phaseDeath:
+			if hf.DeathYear != -1 && !b.diedIn(list) {
+				sentences = append([]*biographySentence{{
+					year: hf.DeathYear,
+					text: fmt.Sprintf("%s life ended in %d.", util.Capitalize(hf.PossesivePronoun()), hf.DeathYear),
+				}}, sentences...)
+			}
+		}
+		if len(sentences) > 0 {
+			bio.Chapters = append(bio.Chapters, &BiographyChapter{
+				Title:      biographyPhases[p],
+				Paragraphs: b.paragraphs(sentences),
+			})
+		}
+	}
+	if hf.DeathYear == -1 && !hf.Deity && !hf.Force && len(bio.Chapters) > 0 {
+		last := bio.Chapters[len(bio.Chapters)-1]
+		last.Paragraphs = append(last.Paragraphs, fmt.Sprintf("As far as the legends tell, %s is still alive.", b.name))
+	}
+
+	return bio
+}
+
+func (b *biographyBuilder) phase(e *HistoricalEvent) int {
+	hf := b.hf
+	switch d := e.Details.(type) {
+	case *HistoricalEventHfDied:
+		if d.Hfid == hf.Id_ {
+			return phaseDeath
+		}
+		return phaseWars
+	case *HistoricalEventAddHfHfLink:
+		switch d.LinkType {
+		case HistoricalEventAddHfHfLinkLinkType_Apprentice, HistoricalEventAddHfHfLinkLinkType_Master, HistoricalEventAddHfHfLinkLinkType_FormerMaster:
+			return phaseApprenticeship
+		}
+	case *HistoricalEventAddHfEntityLink:
+		if d.Link == HistoricalEventAddHfEntityLinkLink_Position {
+			return phasePositions
+		}
+	case *HistoricalEventRemoveHfEntityLink:
+		if d.Link == HistoricalEventRemoveHfEntityLinkLink_Position {
+			return phasePositions
+		}
+	case *HistoricalEventHfSimpleBattleEvent, *HistoricalEventHfWounded, *HistoricalEventHfAttackedSite, *HistoricalEventHfDestroyedSite:
+		return phaseWars
+	}
+
+	if hf.DeathYear != -1 && e.Year > hf.DeathYear {
+		return phaseDeath
+	}
+	if col, ok := b.world.HistoricalEventCollections[e.Collection]; ok {
+		switch col.Details.(type) {
+		case *HistoricalEventCollectionBattle, *HistoricalEventCollectionWar, *HistoricalEventCollectionDuel,
+			*HistoricalEventCollectionBeastAttack, *HistoricalEventCollectionSiteConquered, *HistoricalEventCollectionRaid:
+			return phaseWars
+		}
+	}
+	if hf.BirthYear != -1 && e.Year < hf.BirthYear+biographyYouth {
+		return phaseBirth
+	}
+	return phaseLife
+}
+
+func (b *biographyBuilder) diedIn(events []*HistoricalEvent) bool {
+	for _, e := range events {
+		if d, ok := e.Details.(*HistoricalEventHfDied); ok && d.Hfid == b.hf.Id_ {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *biographyBuilder) birth() *biographySentence {
+	hf := b.hf
+	c := b.context
+	race := strings.ToLower(strings.ReplaceAll(hf.Race, "_", " "))
+	if hf.Deity || hf.Force {
+		text := b.name + util.If(hf.Deity, " is a deity", " is a force")
+		if len(hf.Sphere) > 0 {
+			text += " associated with " + andList(hf.Sphere)
+		}
+		return &biographySentence{year: -1, subject: true, text: text + "."}
+	}
+
+	var parents []string
+	for _, l := range hf.HfLink {
+		if l.LinkType == HfLinkLinkType_Mother || l.LinkType == HfLinkLinkType_Father {
+			parents = append(parents, c.hfRelated(l.Hfid, hf.Id_))
+		}
+	}
+	text := b.name + " was born"
+	if race != "" {
+		text += " " + articled(race)
+	}
+	if hf.BirthYear != -1 {
+		text += fmt.Sprintf(" in %d", hf.BirthYear)
+	}
+	if len(parents) > 0 {
+		text += " to " + andList(parents)
+	}
+	return &biographySentence{year: hf.BirthYear, subject: true, text: text + "."}
+}
+
+func (b *biographyBuilder) sentences(events []*HistoricalEvent) []*biographySentence {
+	var list []*biographySentence
+	for i := 0; i < len(events); i++ {
+		e := events[i]
+		if t, ok := e.Details.(*HistoricalEventHfTravel); ok {
+			j := i + 1
+			for ; j < len(events); j++ {
+				if n, ok := events[j].Details.(*HistoricalEventHfTravel); !ok || !slices.Equal(t.GroupHfid, n.GroupHfid) {
+					break
+				}
+			}
+			if j-i > 1 {
+				list = append(list, b.travels(events[i:j]))
+				i = j - 1
+				continue
+			}
+		}
+
+		text := strings.TrimSpace(b.context.WithEvent(e).EventHtml(e.Details))
+		list = append(list, &biographySentence{
+			year:    e.Year,
+			time:    "in " + Time(e.Year, e.Seconds72),
+			subject: strings.HasPrefix(text, b.name),
+			text:    text,
+		})
+	}
+	return list
+}
+
+// travels merges consecutive journeys of the same group into a single
+// sentence, collapsing runs of the same kind of journey.
+func (b *biographyBuilder) travels(events []*HistoricalEvent) *biographySentence {
+	c := b.context
+	first, last := events[0], events[len(events)-1]
+
+	var runs []string
+	verb := ""
+	var places []string
+	flush := func() {
+		if len(places) > 0 {
+			runs = append(runs, verb+" to "+andList(places))
+		}
+	}
+	for _, e := range events {
+		t := e.Details.(*HistoricalEventHfTravel)
+		v := util.If(t.Return, "returned", "travelled")
+		if v != verb {
+			flush()
+			verb, places = v, nil
+		}
+		if place := strings.TrimSpace(c.location(t.SiteId, "", t.SubregionId, "")); place != "" && !slices.Contains(places, place) {
+			places = append(places, place)
+		}
+	}
+	flush()
+
+	group := first.Details.(*HistoricalEventHfTravel).GroupHfid
+	text := c.hfList(group) + " " + strings.Join(runs, ", then ")
+
+	s := &biographySentence{
+		year:    first.Year,
+		time:    "in " + Time(first.Year, first.Seconds72),
+		subject: strings.HasPrefix(text, b.name),
+		text:    text,
+	}
+	if last.Year != first.Year {
+		s.time = fmt.Sprintf("between %d and %d", first.Year, last.Year)
+	}
+	return s
+}
+
+// paragraphs groups the sentences by year and replaces repeated mentions of
+// the figure with pronouns.
+func (b *biographyBuilder) paragraphs(sentences []*biographySentence) []string {
+	pronoun := util.Capitalize(b.hf.Pronoun())
+	possessive := b.hf.PossesivePronoun()
+
+	var paragraphs []string
+	var current []string
+	year := -2
+	previous := false
+	for _, s := range sentences {
+		text := s.text
+		if s.year != year {
+			if len(current) > 0 {
+				paragraphs = append(paragraphs, strings.Join(current, " "))
+			}
+			current = nil
+			year = s.year
+			previous = false
+		}
+		if s.subject && previous {
+			text = strings.Replace(text, b.name, pronoun, 1)
+		}
+		if previous || s.subject {
+			text = strings.ReplaceAll(text, b.name+"'s", possessive)
+		}
+
+		switch {
+		case s.time == "":
+			text = util.Capitalize(text)
+		case len(current) == 0:
+			text = util.Capitalize(s.time) + ", " + lowerPronoun(text, pronoun)
+		default:
+			text = "That same year, " + lowerPronoun(text, pronoun)
+		}
+		if !strings.HasSuffix(text, ".") {
+			text += "."
+		}
+		current = append(current, text)
+		previous = s.subject
+	}
+	if len(current) > 0 {
+		paragraphs = append(paragraphs, strings.Join(current, " "))
+	}
+	return paragraphs
+}
+
+func lowerPronoun(text, pronoun string) string {
+	if strings.HasPrefix(text, pronoun+" ") {
+		return strings.ToLower(pronoun) + text[len(pronoun):]
+	}
+	return text
+}
+
+func (b *Biography) Markdown() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n", util.Title(b.Hf.Name()))
+	for _, c := range b.Chapters {
+		fmt.Fprintf(&sb, "\n## %s\n", c.Title)
+		for _, p := range c.Paragraphs {
+			sb.WriteString("\n" + util.Markdown(p) + "\n")
+		}
+	}
+	return sb.String()
+}
+
+func (b *Biography) Text() string {
+	var sb strings.Builder
+	title := util.Title(b.Hf.Name())
+	fmt.Fprintf(&sb, "%s\n%s\n", title, strings.Repeat("=", len([]rune(title))))
+	for _, c := range b.Chapters {
+		fmt.Fprintf(&sb, "\n%s\n%s\n", c.Title, strings.Repeat("-", len(c.Title)))
+		for _, p := range c.Paragraphs {
+			sb.WriteString("\n" + util.PlainText(p) + "\n")
+		}
+	}
+	return sb.String()
+}
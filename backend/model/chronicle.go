@@ -0,0 +1,411 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"html/template"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// number of key figures listed per era
+const chronicleKeyFigures = 10
+
+type Chronicle struct {
+	Entity   *Entity
+	Chapters []*ChronicleChapter
+
+	// anchors maps internal anchors to the index of the chapter defining them
+	anchors map[string]int
+}
+
+type ChronicleChapter struct {
+	Anchor    string
+	Title     string
+	StartYear int
+	EndYear   int
+	Sections  []*ChronicleSection
+}
+
+type ChronicleSection struct {
+	Title   string
+	Entries []*ChronicleEntry
+}
+
+type ChronicleEntry struct {
+	Anchor string
+	Text   string
+}
+
+type chronicleBuilder struct {
+	world   *DfWorld
+	entity  *Entity
+	context *Context
+	members map[int]bool
+}
+
+func NewChronicle(world *DfWorld, entity *Entity) *Chronicle {
+	b := &chronicleBuilder{
+		world:   world,
+		entity:  entity,
+		context: &Context{World: world, HfId: -1},
+		members: make(map[int]bool),
+	}
+	for _, id := range entity.HistfigId {
+		b.members[id] = true
+	}
+	world.EachHf(func(hf *HistoricalFigure) {
+		if _, ok := util.Find(hf.EntityLink, func(l *HistoricalFigureEntityLink) bool { return l.EntityId == entity.Id_ }); ok {
+			b.members[hf.Id_] = true
+		}
+	})
+
+	var events []*HistoricalEvent
+	end := 0
+	world.EachEvent(func(e *HistoricalEvent) {
+		if e.Year > end {
+			end = e.Year
+		}
+		if b.related(e) {
+			events = append(events, e)
+		}
+	})
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Year != events[j].Year {
+			return events[i].Year < events[j].Year
+		}
+		if events[i].Seconds72 != events[j].Seconds72 {
+			return events[i].Seconds72 < events[j].Seconds72
+		}
+		return events[i].Id_ < events[j].Id_
+	})
+
+	ch := &Chronicle{Entity: entity, anchors: make(map[string]int)}
+	for i, era := range b.eras(end) {
+		chapter := &ChronicleChapter{
+			Anchor:    fmt.Sprintf("era-%d", i),
+			Title:     era.Title,
+			StartYear: era.StartYear,
+			EndYear:   era.EndYear,
+		}
+		in := func(year int) bool { return year >= era.StartYear && year <= era.EndYear }
+
+		var founding, gained, lost, artifacts, works []*ChronicleEntry
+		for _, e := range events {
+			if !in(e.Year) {
+				continue
+			}
+			entry := &ChronicleEntry{Text: "In " + Time(e.Year, e.Seconds72) + ", " + b.context.WithEvent(e).EventHtml(e.Details)}
+			switch d := e.Details.(type) {
+			case *HistoricalEventEntityCreated:
+				founding = append(founding, entry)
+			case *HistoricalEventCreatedSite:
+				gained = append(gained, entry)
+			case *HistoricalEventReclaimSite:
+				gained = append(gained, entry)
+			case *HistoricalEventSiteTakenOver:
+				if d.AttackerCivId == entity.Id_ {
+					gained = append(gained, entry)
+				} else {
+					lost = append(lost, entry)
+				}
+			case *HistoricalEventNewSiteLeader:
+				if d.AttackerCivId == entity.Id_ {
+					gained = append(gained, entry)
+				} else {
+					lost = append(lost, entry)
+				}
+			case *HistoricalEventDestroyedSite, *HistoricalEventHfDestroyedSite, *HistoricalEventSiteDied:
+				lost = append(lost, entry)
+			case *HistoricalEventArtifactCreated:
+				artifacts = append(artifacts, entry)
+			case *HistoricalEventWrittenContentComposed:
+				works = append(works, entry)
+			}
+		}
+
+		var leaders []*ChronicleEntry
+		for _, l := range entity.Leaders {
+			if l.Hf != nil && in(l.StartYear) {
+				leaders = append(leaders, &ChronicleEntry{Text: leaderText(b.context, l)})
+			}
+		}
+
+		var wars []*ChronicleEntry
+		for _, w := range entity.Wars {
+			if in(w.StartYear) {
+				anchor := fmt.Sprintf("collection-%d", w.Id_)
+				ch.anchors[anchor] = i
+				text := w.Html(b.context) + fmt.Sprintf(", from %d", w.StartYear)
+				if w.EndYear != -1 {
+					text += fmt.Sprintf(" till %d", w.EndYear)
+				}
+				wars = append(wars, &ChronicleEntry{Anchor: anchor, Text: text})
+			}
+		}
+
+		figures := b.keyFigures(era.StartYear, era.EndYear)
+		for _, f := range figures {
+			ch.anchors[f.Anchor] = i
+		}
+
+		for _, s := range []*ChronicleSection{
+			{"Founding", founding},
+			{"Leaders", leaders},
+			{"Wars", wars},
+			{"Sites Gained", gained},
+			{"Sites Lost", lost},
+			{"Artifacts", artifacts},
+			{"Written Works", works},
+			{"Key Figures", figures},
+		} {
+			if len(s.Entries) > 0 {
+				chapter.Sections = append(chapter.Sections, s)
+			}
+		}
+		ch.anchors[chapter.Anchor] = i
+		ch.Chapters = append(ch.Chapters, chapter)
+	}
+
+	return ch
+}
+
+func (b *chronicleBuilder) related(e *HistoricalEvent) bool {
+	id := b.entity.Id_
+	switch d := e.Details.(type) {
+	case *HistoricalEventEntityCreated:
+		return d.EntityId == id
+	case *HistoricalEventCreatedSite:
+		return d.CivId == id
+	case *HistoricalEventReclaimSite:
+		return d.CivId == id
+	case *HistoricalEventSiteTakenOver:
+		return d.AttackerCivId == id || d.DefenderCivId == id
+	case *HistoricalEventNewSiteLeader:
+		return d.AttackerCivId == id || d.DefenderCivId == id
+	case *HistoricalEventDestroyedSite:
+		return d.DefenderCivId == id
+	case *HistoricalEventHfDestroyedSite:
+		return d.DefenderCivId == id
+	case *HistoricalEventSiteDied:
+		return d.CivId == id
+	case *HistoricalEventArtifactCreated:
+		return d.EntityId == id || b.members[d.HistFigureId]
+	case *HistoricalEventWrittenContentComposed:
+		return b.members[d.HistFigureId]
+	}
+	return false
+}
+
+type chronicleEra struct {
+	Title     string
+	StartYear int
+	EndYear   int
+}
+
+func (b *chronicleBuilder) eras(end int) []chronicleEra {
+	return worldEras(b.world, end)
+}
+
+// worldEras splits the history of the world up to end into its eras.
+func worldEras(w *DfWorld, end int) []chronicleEra {
+	eras := make([]*HistoricalEra, len(w.HistoricalEras))
+	copy(eras, w.HistoricalEras)
+	sort.SliceStable(eras, func(i, j int) bool { return eras[i].StartYear < eras[j].StartYear })
+
+	if len(eras) == 0 {
+		return []chronicleEra{{Title: "History", StartYear: -1, EndYear: end}}
+	}
+	var list []chronicleEra
+	for i, era := range eras {
+		e := chronicleEra{Title: util.Title(era.Name()), StartYear: era.StartYear, EndYear: end}
+		if i == 0 {
+			// events before the first era, if any, belong to it
+			e.StartYear = -1
+		}
+		if i+1 < len(eras) {
+			e.EndYear = eras[i+1].StartYear - 1
+		}
+		list = append(list, e)
+	}
+	return list
+}
+
+// keyFigures lists the most notable members born during the given years,
+// ranked by positions held and kills.
+func (b *chronicleBuilder) keyFigures(start, end int) []*ChronicleEntry {
+	type figure struct {
+		hf    *HistoricalFigure
+		score int
+	}
+	var figures []figure
+	for id := range b.members {
+		hf, ok := b.world.Hf(id)
+		if !ok || hf.BirthYear < start || hf.BirthYear > end {
+			continue
+		}
+		positions := 0
+		for _, l := range hf.EntityPositionLink {
+			if l.EntityId == b.entity.Id_ {
+				positions++
+			}
+		}
+		for _, l := range hf.EntityFormerPositionLink {
+			if l.EntityId == b.entity.Id_ {
+				positions++
+			}
+		}
+		if score := 10*positions + len(hf.Kills); score > 0 {
+			figures = append(figures, figure{hf, score})
+		}
+	}
+	sort.Slice(figures, func(i, j int) bool {
+		if figures[i].score != figures[j].score {
+			return figures[i].score > figures[j].score
+		}
+		return figures[i].hf.Id_ < figures[j].hf.Id_
+	})
+	if len(figures) > chronicleKeyFigures {
+		figures = figures[:chronicleKeyFigures]
+	}
+
+	return util.Map(figures, func(f figure) *ChronicleEntry {
+		hf := f.hf
+		text := util.Capitalize(b.context.hf(hf.Id_)) + fmt.Sprintf(", born %d", hf.BirthYear)
+		if hf.DeathYear != -1 {
+			text += fmt.Sprintf(", died %d", hf.DeathYear)
+		}
+		if len(hf.Kills) > 0 {
+			text += fmt.Sprintf(", %d %s", len(hf.Kills), util.If(len(hf.Kills) == 1, "kill", "kills"))
+		}
+		return &ChronicleEntry{Anchor: fmt.Sprintf("hf-%d", hf.Id_), Text: text}
+	})
+}
+
+func leaderText(c *Context, l *EntityLeader) string {
+	if l.EndYear == -1 {
+		return fmt.Sprintf("Since %d, %s", l.StartYear, c.hf(l.Hf.Id_))
+	}
+	return fmt.Sprintf("From %d till %d, %s", l.StartYear, l.EndYear, c.hf(l.Hf.Id_))
+}
+
+var (
+	chronicleLink       = regexp.MustCompile(`<a class="[^"]*" href="\./([a-z]+)/(\d+)"[^>]*>(.*?)</a>`)
+	chronicleWhitespace = regexp.MustCompile(`\s+`)
+)
+
+// render turns the html of an entry into a self-contained form. Links to
+// objects covered by the chronicle are passed to link, everything else is
+// reduced to text.
+func (ch *Chronicle) render(s string, link func(text, anchor string) string, text func(string) string) string {
+	plain := func(s string) string {
+		return chronicleWhitespace.ReplaceAllString(html.UnescapeString(util.Strip(template.HTML(s))), " ")
+	}
+
+	var sb strings.Builder
+	last := 0
+	for _, m := range chronicleLink.FindAllStringSubmatchIndex(s, -1) {
+		sb.WriteString(text(plain(s[last:m[0]])))
+		label := strings.TrimSpace(plain(s[m[6]:m[7]]))
+		anchor := s[m[2]:m[3]] + "-" + s[m[4]:m[5]]
+		if _, ok := ch.anchors[anchor]; ok {
+			sb.WriteString(link(label, anchor))
+		} else {
+			sb.WriteString(text(label))
+		}
+		last = m[1]
+	}
+	sb.WriteString(text(plain(s[last:])))
+	return strings.TrimSpace(strings.ReplaceAll(sb.String(), "  ", " "))
+}
+
+func (ch *Chronicle) Title() string {
+	return "The Chronicle of " + util.Title(ch.Entity.Name())
+}
+
+func (ch *Chronicle) Markdown() string {
+	link := func(text, anchor string) string { return "[" + text + "](#" + anchor + ")" }
+	text := func(s string) string { return s }
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\n", ch.Title())
+	for _, c := range ch.Chapters {
+		fmt.Fprintf(&sb, "- [%s](#%s)\n", c.Title, c.Anchor)
+	}
+	for _, c := range ch.Chapters {
+		fmt.Fprintf(&sb, "\n<a id=\"%s\"></a>\n\n## %s\n", c.Anchor, c.Title)
+		for _, s := range c.Sections {
+			fmt.Fprintf(&sb, "\n### %s\n\n", s.Title)
+			for _, e := range s.Entries {
+				sb.WriteString("- ")
+				if e.Anchor != "" {
+					fmt.Fprintf(&sb, "<a id=\"%s\"></a>", e.Anchor)
+				}
+				sb.WriteString(ch.render(e.Text, link, text) + "\n")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// body renders the chapter as xhtml, prefix is prepended to internal links
+// to point them to the file containing the anchor.
+func (ch *Chronicle) body(c *ChronicleChapter, prefix func(anchor string) string) string {
+	link := func(text, anchor string) string {
+		return fmt.Sprintf(`<a href="%s#%s">%s</a>`, prefix(anchor), anchor, util.EscapeXml(text))
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<h2 id=\"%s\">%s</h2>\n", c.Anchor, util.EscapeXml(c.Title))
+	for _, s := range c.Sections {
+		fmt.Fprintf(&sb, "<h3>%s</h3>\n<ul>\n", util.EscapeXml(s.Title))
+		for _, e := range s.Entries {
+			if e.Anchor != "" {
+				fmt.Fprintf(&sb, "<li id=\"%s\">", e.Anchor)
+			} else {
+				sb.WriteString("<li>")
+			}
+			sb.WriteString(ch.render(e.Text, link, util.EscapeXml) + "</li>\n")
+		}
+		sb.WriteString("</ul>\n")
+	}
+	return sb.String()
+}
+
+func (ch *Chronicle) Html() string {
+	local := func(string) string { return "" }
+
+	var sb strings.Builder
+	title := util.EscapeXml(ch.Title())
+	fmt.Fprintf(&sb, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n<h1>%s</h1>\n<ul>\n", title, title)
+	for _, c := range ch.Chapters {
+		fmt.Fprintf(&sb, "<li><a href=\"#%s\">%s</a></li>\n", c.Anchor, util.EscapeXml(c.Title))
+	}
+	sb.WriteString("</ul>\n")
+	for _, c := range ch.Chapters {
+		sb.WriteString(ch.body(c, local))
+	}
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String()
+}
+
+func (ch *Chronicle) Epub() ([]byte, error) {
+	file := func(anchor string) string { return fmt.Sprintf("chapter-%d.xhtml", ch.anchors[anchor]) }
+
+	chapters := make([]util.EpubChapter, len(ch.Chapters))
+	for i, c := range ch.Chapters {
+		chapters[i] = util.EpubChapter{
+			File:  fmt.Sprintf("chapter-%d.xhtml", i),
+			Title: c.Title,
+			Body:  ch.body(c, file),
+		}
+	}
+
+	var buf bytes.Buffer
+	err := util.WriteEpub(&buf, fmt.Sprintf("legendsbrowser-entity-%d", ch.Entity.Id_), ch.Title(), chapters)
+	return buf.Bytes(), err
+}
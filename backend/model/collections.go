@@ -65,7 +65,7 @@ func (x *HistoricalEventCollectionBeastAttack) Html(e *HistoricalEventCollection
 	case l == 1:
 		r += e.Link(ord(x.Ordinal)+"rampage") + " of " + c.hf(x.AttackerHfIds[0])
 	case l > 1:
-		if hf, ok := c.World.HistoricalFigures[x.AttackerHfIds[0]]; ok {
+		if hf, ok := c.World.Hf(x.AttackerHfIds[0]); ok {
 			r += e.Link(ord(x.Ordinal) + hf.Race + " " + "rampage")
 		}
 	default:
@@ -78,10 +78,10 @@ func (x *HistoricalEventCollectionBeastAttack) Html(e *HistoricalEventCollection
 func (x *HistoricalEventCollectionCeremony) Html(e *HistoricalEventCollection, c *Context) string {
 	r := "ceremony"
 	if len(e.Event) > 0 {
-		if event, ok := c.World.HistoricalEvents[e.Event[0]]; ok {
+		if event, ok := c.World.Event(e.Event[0]); ok {
 			if d, ok := event.Details.(*HistoricalEventCeremony); ok {
 				if entity, ok := c.World.Entities[d.CivId]; ok {
-					if d.OccasionId < len(entity.Occasion) {
+					if d.OccasionId >= 0 && d.OccasionId < len(entity.Occasion) {
 						occ := entity.Occasion[d.OccasionId]
 						if len(occ.Schedule) > 1 {
 							switch d.ScheduleId {
@@ -104,12 +104,12 @@ func (x *HistoricalEventCollectionCeremony) Html(e *HistoricalEventCollection, c
 func (x *HistoricalEventCollectionCompetition) Html(e *HistoricalEventCollection, c *Context) string {
 	r := "competition"
 	if len(e.Event) > 0 {
-		if event, ok := c.World.HistoricalEvents[e.Event[0]]; ok {
+		if event, ok := c.World.Event(e.Event[0]); ok {
 			if d, ok := event.Details.(*HistoricalEventCompetition); ok {
 				if entity, ok := c.World.Entities[d.CivId]; ok {
-					if d.OccasionId < len(entity.Occasion) {
+					if d.OccasionId >= 0 && d.OccasionId < len(entity.Occasion) {
 						occ := entity.Occasion[d.OccasionId]
-						if d.ScheduleId < len(occ.Schedule) {
+						if d.ScheduleId >= 0 && d.ScheduleId < len(occ.Schedule) {
 							r = occ.Schedule[d.ScheduleId].Type_.String()
 						}
 					}
@@ -143,7 +143,7 @@ func (x *HistoricalEventCollectionJourney) Html(e *HistoricalEventCollection, c
 
 func (x *HistoricalEventCollectionOccasion) Html(e *HistoricalEventCollection, c *Context) string {
 	if civ, ok := c.World.Entities[x.CivId]; ok {
-		if x.OccasionId < len(civ.Occasion) {
+		if x.OccasionId >= 0 && x.OccasionId < len(civ.Occasion) {
 			occ := civ.Occasion[x.OccasionId]
 			return util.If(x.Ordinal > 1, "the "+ord(x.Ordinal)+"occasion of ", "") + e.Link(occ.Name_)
 		}
@@ -154,12 +154,12 @@ func (x *HistoricalEventCollectionOccasion) Html(e *HistoricalEventCollection, c
 func (x *HistoricalEventCollectionPerformance) Html(e *HistoricalEventCollection, c *Context) string {
 	r := "performance"
 	if len(e.Event) > 0 {
-		if event, ok := c.World.HistoricalEvents[e.Event[0]]; ok {
+		if event, ok := c.World.Event(e.Event[0]); ok {
 			if d, ok := event.Details.(*HistoricalEventPerformance); ok {
 				if entity, ok := c.World.Entities[d.CivId]; ok {
-					if d.OccasionId < len(entity.Occasion) {
+					if d.OccasionId >= 0 && d.OccasionId < len(entity.Occasion) {
 						occ := entity.Occasion[d.OccasionId]
-						if d.ScheduleId < len(occ.Schedule) {
+						if d.ScheduleId >= 0 && d.ScheduleId < len(occ.Schedule) {
 							r = occ.Schedule[d.ScheduleId].Type_.String()
 						}
 					}
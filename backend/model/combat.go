@@ -0,0 +1,203 @@
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// number of figures per leaderboard
+const combatTop = 10
+
+type CombatKill struct {
+	EventId     int
+	Year        int
+	VictimHfid  int
+	VictimRace  string
+	SiteId      int
+	SubregionId int
+	Cause       string
+	// ArtifactId is the named weapon of the slayer, -1 if none
+	ArtifactId int
+	Weapon     string
+}
+
+// CombatGroup collects the kills and fights of a figure during one
+// collection, like a battle, duel or beast attack.
+type CombatGroup struct {
+	// CollectionId is -1 for events outside of any collection
+	CollectionId int
+	Year         int
+	Kills        []*CombatKill
+	Events       []*HistoricalEvent
+}
+
+type CombatRecord struct {
+	Hf          *HistoricalFigure
+	Groups      []*CombatGroup
+	Kills       int
+	WoundsDealt int
+	WoundsTaken int
+	Fights      int
+}
+
+func NewCombatRecord(w *DfWorld, hf *HistoricalFigure) *CombatRecord {
+	r := &CombatRecord{Hf: hf}
+
+	events := w.EventsRelatedTo(hf.Id_, func(d HistoricalEventDetails) bool {
+		switch x := d.(type) {
+		case *HistoricalEventHfDied:
+			return x.SlayerHfid == hf.Id_
+		case *HistoricalEventHfWounded:
+			return x.WounderHfid == hf.Id_ || x.WoundeeHfid == hf.Id_
+		case *HistoricalEventHfSimpleBattleEvent:
+			return x.Group1Hfid == hf.Id_ || x.Group2Hfid == hf.Id_
+		}
+		return false
+	})
+	sort.Slice(events, func(i, j int) bool { return events[i].Id_ < events[j].Id_ })
+
+	groups := make(map[int]*CombatGroup)
+	for _, e := range events {
+		g, ok := groups[e.Collection]
+		if !ok {
+			g = &CombatGroup{CollectionId: e.Collection, Year: e.Year}
+			groups[e.Collection] = g
+			r.Groups = append(r.Groups, g)
+		}
+		switch d := e.Details.(type) {
+		case *HistoricalEventHfDied:
+			g.Kills = append(g.Kills, newCombatKill(w, e, d))
+			r.Kills++
+		case *HistoricalEventHfWounded:
+			g.Events = append(g.Events, e)
+			if d.WounderHfid == hf.Id_ {
+				r.WoundsDealt++
+			} else {
+				r.WoundsTaken++
+			}
+		case *HistoricalEventHfSimpleBattleEvent:
+			g.Events = append(g.Events, e)
+			r.Fights++
+		}
+	}
+	sort.SliceStable(r.Groups, func(i, j int) bool { return r.Groups[i].Year < r.Groups[j].Year })
+
+	return r
+}
+
+func newCombatKill(w *DfWorld, e *HistoricalEvent, d *HistoricalEventHfDied) *CombatKill {
+	k := &CombatKill{
+		EventId:     e.Id_,
+		Year:        e.Year,
+		VictimHfid:  d.Hfid,
+		SiteId:      d.SiteId,
+		SubregionId: d.SubregionId,
+		Cause:       d.Cause.String(),
+		ArtifactId:  -1,
+	}
+	if victim, ok := w.Hf(d.Hfid); ok {
+		k.VictimRace = strings.ToLower(victim.Race)
+	}
+	if _, ok := w.Artifacts[d.SlayerItemId]; ok {
+		k.ArtifactId = d.SlayerItemId
+	}
+	var weapon []string
+	for _, s := range []string{d.Mat, util.If(d.ItemSubtype != "", d.ItemSubtype, d.ItemType)} {
+		if s != "" {
+			weapon = append(weapon, s)
+		}
+	}
+	k.Weapon = strings.Join(weapon, " ")
+	return k
+}
+
+type CombatRank struct {
+	Hfid  int
+	Kills int
+}
+
+type CombatBoard struct {
+	Title string
+	Kills int
+	Ranks []*CombatRank
+}
+
+type CombatLeaderboard struct {
+	Overall *CombatBoard
+	Races   []*CombatBoard
+	Eras    []*CombatBoard
+}
+
+func NewCombatLeaderboard(w *DfWorld) *CombatLeaderboard {
+	type kill struct {
+		slayer int
+		year   int
+	}
+	var kills []kill
+	end := 0
+	w.EachEvent(func(e *HistoricalEvent) {
+		if e.Year > end {
+			end = e.Year
+		}
+		if d, ok := e.Details.(*HistoricalEventHfDied); ok && d.SlayerHfid != -1 {
+			if _, ok := w.Hf(d.SlayerHfid); ok {
+				kills = append(kills, kill{d.SlayerHfid, e.Year})
+			}
+		}
+	})
+
+	all := make(map[int]int)
+	races := make(map[string]map[int]int)
+	for _, k := range kills {
+		all[k.slayer]++
+		hf, _ := w.Hf(k.slayer)
+		race := strings.ToLower(hf.Race)
+		if races[race] == nil {
+			races[race] = make(map[int]int)
+		}
+		races[race][k.slayer]++
+	}
+
+	l := &CombatLeaderboard{Overall: newCombatBoard("All Figures", all)}
+	for race, counts := range races {
+		l.Races = append(l.Races, newCombatBoard(util.Title(util.If(race != "", race, "unknown")), counts))
+	}
+	sort.Slice(l.Races, func(i, j int) bool {
+		if l.Races[i].Kills != l.Races[j].Kills {
+			return l.Races[i].Kills > l.Races[j].Kills
+		}
+		return l.Races[i].Title < l.Races[j].Title
+	})
+
+	for _, era := range worldEras(w, end) {
+		counts := make(map[int]int)
+		for _, k := range kills {
+			if k.year >= era.StartYear && k.year <= era.EndYear {
+				counts[k.slayer]++
+			}
+		}
+		l.Eras = append(l.Eras, newCombatBoard(era.Title, counts))
+	}
+
+	return l
+}
+
+func newCombatBoard(title string, counts map[int]int) *CombatBoard {
+	b := &CombatBoard{Title: title}
+	for id, n := range counts {
+		b.Kills += n
+		b.Ranks = append(b.Ranks, &CombatRank{Hfid: id, Kills: n})
+	}
+	sort.Slice(b.Ranks, func(i, j int) bool {
+		if b.Ranks[i].Kills != b.Ranks[j].Kills {
+			return b.Ranks[i].Kills > b.Ranks[j].Kills
+		}
+		return b.Ranks[i].Hfid < b.Ranks[j].Hfid
+	})
+	if len(b.Ranks) > combatTop {
+		b.Ranks = b.Ranks[:combatTop]
+	}
+	return b
+}
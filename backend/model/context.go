@@ -12,6 +12,10 @@ type Context struct {
 	HfId  int
 	Story bool
 	Event *HistoricalEvent
+	// Locale translates the sentences, nil is english
+	Locale *Locale
+	// RevealIdentities adds the real historical figure to every rendered identity
+	RevealIdentities bool
 }
 
 func NewContext(w *DfWorld, ref any) *Context {
@@ -31,6 +35,22 @@ func (c *Context) WithEvent(e *HistoricalEvent) *Context {
 	return &c2
 }
 
+func (c *Context) t(key string, args ...any) string {
+	return c.Locale.T(key, args...)
+}
+
+func (c *Context) time(year, seconds int) string {
+	return c.Locale.Time(year, seconds)
+}
+
+func (c *Context) ord(ordinal int) string {
+	return c.Locale.Ord(ordinal)
+}
+
+func (c *Context) andList(list []string) string {
+	return c.Locale.AndList(list)
+}
+
 func (c *Context) hf(id int) string {
 	if c.HfId != -1 {
 		if c.HfId == id {
@@ -39,8 +59,8 @@ func (c *Context) hf(id int) string {
 			return c.hfRelated(id, c.HfId)
 		}
 	}
-	if x, ok := c.World.HistoricalFigures[id]; ok {
-		return hf(x)
+	if x, ok := c.World.Hf(id); ok {
+		return c.hfLink(x)
 	}
 	return "UNKNOWN HISTORICAL FIGURE"
 }
@@ -49,14 +69,14 @@ func (c *Context) hfUnrelated(id int) string {
 	if c.HfId != -1 && c.HfId == id {
 		return c.hfShort(id)
 	}
-	if x, ok := c.World.HistoricalFigures[id]; ok {
-		return hf(x)
+	if x, ok := c.World.Hf(id); ok {
+		return c.hfLink(x)
 	}
 	return "UNKNOWN HISTORICAL FIGURE"
 }
 
 func (c *Context) hfShort(id int) string {
-	if x, ok := c.World.HistoricalFigures[id]; ok {
+	if x, ok := c.World.Hf(id); ok {
 		return fmt.Sprintf(`<a class="hf" href="./hf/%d">%s%s</a>`, x.Id(), hfIcon(x), util.Title(x.FirstName()))
 	}
 	return "UNKNOWN HISTORICAL FIGURE"
@@ -70,13 +90,14 @@ func (c *Context) hfRelated(id, to int) string {
 			return c.hfRelated(id, c.HfId)
 		}
 	}
-	if x, ok := c.World.HistoricalFigures[id]; ok {
-		if t, ok := c.World.HistoricalFigures[to]; ok {
+	if x, ok := c.World.Hf(id); ok {
+		if t, ok := c.World.Hf(to); ok {
 			if y, ok := util.Find(t.HfLink, func(l *HfLink) bool { return l.Hfid == id }); ok {
-				return fmt.Sprintf(`%s %s <a class="hf" href="./hf/%d">%s%s</a>`, t.PossesivePronoun(), y.LinkType, x.Id(), hfIcon(x), util.Title(x.Name()))
+				return c.t("%s %s %s", c.t(t.PossesivePronoun()), c.t(y.LinkType.String()),
+					fmt.Sprintf(`<a class="hf" href="./hf/%d">%s%s</a>`, x.Id(), hfIcon(x), util.Title(x.Name())))
 			}
 		}
-		return hf(x)
+		return c.hfLink(x)
 	}
 	return "UNKNOWN HISTORICAL FIGURE"
 }
@@ -104,32 +125,32 @@ func hfIcon(x *HistoricalFigure) string {
 	return ""
 }
 
-func hf(x *HistoricalFigure) string {
-	r := x.Race
+func (c *Context) hfLink(x *HistoricalFigure) string {
+	r := c.t(x.Race)
 	if x.Deity {
-		r += " deity"
+		r += " " + c.t("deity")
 	}
 	if x.Force {
-		r += " deity"
+		r += " " + c.t("deity")
 	}
 	if x.Necromancer {
-		r += " necromancer"
+		r += " " + c.t("necromancer")
 	}
 	if x.Werebeast {
-		r += " werebeast"
+		r += " " + c.t("werebeast")
 	}
 	if x.Vampire {
-		r += " vampire"
+		r += " " + c.t("vampire")
 	}
-	return fmt.Sprintf(`the %s <a class="hf" href="./hf/%d">%s%s</a>`, r, x.Id(), hfIcon(x), util.Title(x.Name()))
+	return c.t("the %s %s", r, fmt.Sprintf(`<a class="hf" href="./hf/%d">%s%s</a>`, x.Id(), hfIcon(x), util.Title(x.Name())))
 }
 
 func (c *Context) hfList(ids []int) string {
-	return andList(util.Map(ids, func(id int) string { return c.hf(id) }))
+	return c.andList(util.Map(ids, func(id int) string { return c.hf(id) }))
 }
 
 func (c *Context) hfListRelated(ids []int, to int) string {
-	return andList(util.Map(ids, func(id int) string { return c.hfRelated(id, to) }))
+	return c.andList(util.Map(ids, func(id int) string { return c.hfRelated(id, to) }))
 }
 
 func (c *Context) artifact(id int) string {
@@ -151,12 +172,12 @@ func (c *Context) entity(id int) string {
 }
 
 func (c *Context) entityList(ids []int) string {
-	return andList(util.Map(ids, func(id int) string { return c.entity(id) }))
+	return c.andList(util.Map(ids, func(id int) string { return c.entity(id) }))
 }
 
 func (c *Context) position(entityId, positionId, hfId int) string {
 	if e, ok := c.World.Entities[entityId]; ok {
-		if h, ok := c.World.HistoricalFigures[hfId]; ok {
+		if h, ok := c.World.Hf(hfId); ok {
 			return e.Position(positionId).GenderName(h)
 		}
 	}
@@ -167,14 +188,14 @@ func (c *Context) siteCiv(siteCivId, civId int) string {
 	if siteCivId == civId {
 		return c.entity(civId)
 	}
-	return util.If(siteCivId != -1, c.entity(siteCivId), "") + util.If(civId != -1 && siteCivId != -1, " of ", "") + util.If(civId != -1, c.entity(civId), "")
+	return util.If(siteCivId != -1, c.entity(siteCivId), "") + util.If(civId != -1 && siteCivId != -1, " "+c.t("of")+" ", "") + util.If(civId != -1, c.entity(civId), "")
 }
 
 func (c *Context) siteStructure(siteId, structureId int, prefix string) string {
 	if siteId == -1 {
 		return ""
 	}
-	return " " + prefix + " " + util.If(structureId != -1, c.structure(siteId, structureId)+" in ", "") + c.site(siteId, "")
+	return " " + prefix + " " + util.If(structureId != -1, c.structure(siteId, structureId)+" "+c.t("in")+" ", "") + c.site(siteId, "")
 }
 
 func (c *Context) site(id int, prefix string) string {
@@ -254,18 +275,25 @@ func (c *Context) river(id int) string {
 
 func (c *Context) identity(id int) string {
 	if x, ok := c.World.Identities[id]; ok {
-		return fmt.Sprintf(`<a class="identity" href="./identity/%d">%s</a>`, x.Id(), util.Title(x.Name()))
+		return fmt.Sprintf(`<a class="identity" href="./identity/%d">%s</a>`, x.Id(), util.Title(x.Name())) + c.revealIdentity(x)
 	}
 	return "UNKNOWN IDENTITY"
 }
 
 func (c *Context) fullIdentity(id int) string {
 	if x, ok := c.World.Identities[id]; ok {
-		return fmt.Sprintf(`&quot;the %s <a class="identity" href="./identity/%d">%s</a> of %s&quot;`, x.Profession.String(), x.Id(), util.Title(x.Name()), c.entity(x.EntityId))
+		return fmt.Sprintf(`&quot;the %s <a class="identity" href="./identity/%d">%s</a> of %s&quot;`, x.Profession.String(), x.Id(), util.Title(x.Name()), c.entity(x.EntityId)) + c.revealIdentity(x)
 	}
 	return "UNKNOWN IDENTITY"
 }
 
+func (c *Context) revealIdentity(x *Identity) string {
+	if !c.RevealIdentities || x.HistfigId == -1 {
+		return ""
+	}
+	return " (" + c.t("actually %s", c.hfUnrelated(x.HistfigId)) + ")"
+}
+
 func (c *Context) danceForm(id int) string {
 	if x, ok := c.World.DanceForms[id]; ok {
 		return fmt.Sprintf(`<a class="artform" href="./danceform/%d"><i class="fa-solid fa-shoe-prints fa-xs"></i> %s</a>`, id, util.Title(x.Name()))
@@ -303,7 +331,7 @@ func (c *Context) writtenContent(id int) string {
 
 func (c *Context) collection(id int) string {
 	if x, ok := c.World.HistoricalEventCollections[id]; ok {
-		return x.Details.Html(x, c)
+		return x.Html(c)
 	}
 	return "UNKNOWN EVENT COLLECTION"
 }
@@ -311,58 +339,58 @@ func (c *Context) collection(id int) string {
 func (c *Context) feature(x *Feature) string {
 	switch x.Type_ {
 	case FeatureType_DancePerformance:
-		return "a perfomance of " + c.danceForm(x.Reference)
+		return c.t("a performance of %s", c.danceForm(x.Reference))
 	case FeatureType_Images:
 		if x.Reference != -1 {
-			return "images of " + c.hf(x.Reference)
+			return c.t("images of %s", c.hf(x.Reference))
 		}
-		return "images"
+		return c.t("images")
 	case FeatureType_MusicalPerformance:
-		return "a perfomance of " + c.musicalForm(x.Reference)
+		return c.t("a performance of %s", c.musicalForm(x.Reference))
 	case FeatureType_PoetryRecital:
-		return "a recital of " + c.poeticForm(x.Reference)
+		return c.t("a recital of %s", c.poeticForm(x.Reference))
 	case FeatureType_Storytelling:
 		if x.Reference != -1 {
-			if e, ok := c.World.HistoricalEvents[x.Reference]; ok {
-				return "a telling of the story of " + e.Details.Html(&Context{World: c.World, Story: true}) + " in " + Time(e.Year, e.Seconds72)
+			if e, ok := c.World.Event(x.Reference); ok {
+				return c.t("a telling of the story of %s in %s", (&Context{World: c.World, Story: true, Locale: c.Locale, RevealIdentities: c.RevealIdentities}).EventHtml(e.Details), c.time(e.Year, e.Seconds72))
 			}
 		}
-		return "a story recital"
+		return c.t("a story recital")
 	default:
-		return strcase.ToDelimited(x.Type_.String(), ' ')
+		return c.t(strcase.ToDelimited(x.Type_.String(), ' '))
 	}
 }
 
 func (c *Context) schedule(x *Schedule) string {
 	switch x.Type_ {
 	case ScheduleType_DancePerformance:
-		return "a perfomance of " + c.danceForm(x.Reference)
+		return c.t("a performance of %s", c.danceForm(x.Reference))
 	case ScheduleType_MusicalPerformance:
-		return "a perfomance of " + c.musicalForm(x.Reference)
+		return c.t("a performance of %s", c.musicalForm(x.Reference))
 	case ScheduleType_PoetryRecital:
-		return "a recital of " + c.poeticForm(x.Reference)
+		return c.t("a recital of %s", c.poeticForm(x.Reference))
 	case ScheduleType_Storytelling:
 		if x.Reference != -1 {
-			if e, ok := c.World.HistoricalEvents[x.Reference]; ok {
-				return "the story of " + e.Details.Html(&Context{World: c.World, Story: true}) + " in " + Time(e.Year, e.Seconds72)
+			if e, ok := c.World.Event(x.Reference); ok {
+				return c.t("the story of %s in %s", (&Context{World: c.World, Story: true, Locale: c.Locale, RevealIdentities: c.RevealIdentities}).EventHtml(e.Details), c.time(e.Year, e.Seconds72))
 			}
 		}
-		return "a story recital"
+		return c.t("a story recital")
 	default:
-		return strcase.ToDelimited(x.Type_.String(), ' ')
+		return c.t(strcase.ToDelimited(x.Type_.String(), ' '))
 	}
 }
 
 func (c *Context) pronoun(id int) string {
-	if x, ok := c.World.HistoricalFigures[id]; ok {
-		return x.Pronoun()
+	if x, ok := c.World.Hf(id); ok {
+		return c.t(x.Pronoun())
 	}
-	return "he"
+	return c.t("he")
 }
 
 func (c *Context) posessivePronoun(id int) string {
-	if x, ok := c.World.HistoricalFigures[id]; ok {
-		return x.PossesivePronoun()
+	if x, ok := c.World.Hf(id); ok {
+		return c.t(x.PossesivePronoun())
 	}
-	return "his"
+	return c.t("his")
 }
@@ -0,0 +1,151 @@
+package model
+
+import "sort"
+
+// WorldDiff lists the changes between two exports of the same world.
+// Objects are matched by id, all ids refer to the newer world.
+type WorldDiff struct {
+	OldFile        string                `json:"oldFile"`
+	NewFile        string                `json:"newFile"`
+	OldYear        int                   `json:"oldYear"`
+	NewYear        int                   `json:"newYear"`
+	NewEvents      []int                 `json:"newEvents"`
+	NewCollections []int                 `json:"newCollections"`
+	NewHfs         []int                 `json:"newHfs"`
+	DeadHfs        []int                 `json:"deadHfs"`
+	NewArtifacts   []int                 `json:"newArtifacts"`
+	SiteOwners     []*SiteOwnerChange    `json:"siteOwners"`
+	Positions      []*PositionDiffChange `json:"positions"`
+}
+
+type SiteOwnerChange struct {
+	SiteId   int `json:"siteId"`
+	OldOwner int `json:"oldOwner"`
+	NewOwner int `json:"newOwner"`
+}
+
+// PositionDiffChange is a position gained or lost by a historical figure.
+type PositionDiffChange struct {
+	EntityId   int  `json:"entityId"`
+	PositionId int  `json:"positionId"`
+	Hfid       int  `json:"hfid"`
+	Gained     bool `json:"gained"`
+}
+
+func NewWorldDiff(old, new *DfWorld) *WorldDiff {
+	d := &WorldDiff{
+		OldFile: old.FilePath,
+		NewFile: new.FilePath,
+		OldYear: lastYear(old),
+		NewYear: lastYear(new),
+	}
+
+	new.EachEvent(func(e *HistoricalEvent) {
+		if _, ok := old.Event(e.Id_); !ok {
+			d.NewEvents = append(d.NewEvents, e.Id_)
+		}
+	})
+	sort.Ints(d.NewEvents)
+	d.NewCollections = newIds(old.HistoricalEventCollections, new.HistoricalEventCollections)
+	d.NewArtifacts = newIds(old.Artifacts, new.Artifacts)
+
+	new.EachHf(func(hf *HistoricalFigure) {
+		o, ok := old.Hf(hf.Id_)
+		if !ok {
+			d.NewHfs = append(d.NewHfs, hf.Id_)
+		} else if o.DeathYear == -1 && hf.DeathYear != -1 {
+			d.DeadHfs = append(d.DeadHfs, hf.Id_)
+		}
+	})
+	sort.Ints(d.NewHfs)
+	sort.Ints(d.DeadHfs)
+
+	for id, site := range new.Sites {
+		if o, ok := old.Sites[id]; ok && o.Owner != site.Owner {
+			d.SiteOwners = append(d.SiteOwners, &SiteOwnerChange{SiteId: id, OldOwner: o.Owner, NewOwner: site.Owner})
+		}
+	}
+	sort.Slice(d.SiteOwners, func(i, j int) bool { return d.SiteOwners[i].SiteId < d.SiteOwners[j].SiteId })
+
+	oldPositions := positionHolders(old)
+	newPositions := positionHolders(new)
+	for p := range newPositions {
+		if !oldPositions[p] {
+			d.Positions = append(d.Positions, &PositionDiffChange{EntityId: p.entityId, PositionId: p.positionId, Hfid: p.hfid, Gained: true})
+		}
+	}
+	for p := range oldPositions {
+		if !newPositions[p] {
+			d.Positions = append(d.Positions, &PositionDiffChange{EntityId: p.entityId, PositionId: p.positionId, Hfid: p.hfid})
+		}
+	}
+	sort.Slice(d.Positions, func(i, j int) bool {
+		a, b := d.Positions[i], d.Positions[j]
+		if a.EntityId != b.EntityId {
+			return a.EntityId < b.EntityId
+		}
+		if a.PositionId != b.PositionId {
+			return a.PositionId < b.PositionId
+		}
+		return a.Hfid < b.Hfid
+	})
+
+	return d
+}
+
+func newIds[T any](old, new map[int]T) []int {
+	var ids []int
+	for id := range new {
+		if _, ok := old[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func lastYear(w *DfWorld) int {
+	year := 0
+	w.EachEvent(func(e *HistoricalEvent) {
+		if e.Year > year {
+			year = e.Year
+		}
+	})
+	return year
+}
+
+type positionHolder struct {
+	entityId, positionId, hfid int
+}
+
+func positionHolders(w *DfWorld) map[positionHolder]bool {
+	holders := make(map[positionHolder]bool)
+	w.EachHf(func(hf *HistoricalFigure) {
+		for _, l := range hf.EntityPositionLink {
+			holders[positionHolder{l.EntityId, l.PositionProfileId, hf.Id_}] = true
+		}
+	})
+	return holders
+}
+
+// Empty reports if no changes were found.
+func (d *WorldDiff) Empty() bool {
+	return len(d.NewEvents)+len(d.NewCollections)+len(d.NewHfs)+len(d.DeadHfs)+
+		len(d.NewArtifacts)+len(d.SiteOwners)+len(d.Positions) == 0
+}
+
+// Gained lists the positions taken up.
+func (d *WorldDiff) Gained() []*PositionDiffChange { return d.positions(true) }
+
+// Lost lists the positions given up.
+func (d *WorldDiff) Lost() []*PositionDiffChange { return d.positions(false) }
+
+func (d *WorldDiff) positions(gained bool) []*PositionDiffChange {
+	var list []*PositionDiffChange
+	for _, p := range d.Positions {
+		if p.Gained == gained {
+			list = append(list, p)
+		}
+	}
+	return list
+}
@@ -44,37 +44,37 @@ func NewEventList(world *DfWorld, obj any) *EventList {
 
 	switch x := obj.(type) {
 	case *Entity:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToEntity(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToEntity(x.Id()) })
 	case *HistoricalFigure:
 		el.Context.HfId = x.Id()
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToHf(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToHf(x.Id()) })
 	case *Artifact:
 		el.Context.HfId = x.HolderHfid
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToArtifact(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToArtifact(x.Id()) })
 	case *Site:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToSite(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToSite(x.Id()) })
 	case *Structure:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToStructure(x.SiteId, x.Id()) })
+		el.Events = world.EventsRelatedTo(x.SiteId, func(d HistoricalEventDetails) bool { return d.RelatedToStructure(x.SiteId, x.Id()) })
 	case *Region:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToRegion(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToRegion(x.Id()) })
 	case *WorldConstruction:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToWorldConstruction(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToWorldConstruction(x.Id()) })
 	case *WrittenContent:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToWrittenContent(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToWrittenContent(x.Id()) })
 	case *DanceForm:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToDanceForm(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToDanceForm(x.Id()) })
 	case *MusicalForm:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToMusicalForm(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToMusicalForm(x.Id()) })
 	case *PoeticForm:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToPoeticForm(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToPoeticForm(x.Id()) })
 	case *MountainPeak:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToMountain(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToMountain(x.Id()) })
 	case *Identity:
-		el.Events = world.EventsMatching(func(d HistoricalEventDetails) bool { return d.RelatedToIdentity(x.Id()) })
+		el.Events = world.EventsRelatedTo(x.Id(), func(d HistoricalEventDetails) bool { return d.RelatedToIdentity(x.Id()) })
 	case []*HistoricalEvent:
 		el.Events = x
 	case []int:
-		el.Events = util.Map(x, func(id int) *HistoricalEvent { return world.HistoricalEvents[id] })
+		el.Events = util.Map(x, func(id int) *HistoricalEvent { e, _ := world.Event(id); return e })
 	default:
 		fmt.Printf("unknown type %T\n", obj)
 	}
@@ -10,9 +10,11 @@ import (
 )
 
 func (x *HistoricalEventAddHfEntityHonor) Html(c *Context) string {
-	e := c.World.Entities[x.EntityId]
-	h := e.Honor[x.HonorId]
-	return fmt.Sprintf("%s received the title %s of %s%s", c.hf(x.Hfid), h.Name(), c.entity(x.EntityId), h.Requirement())
+	if e, ok := c.World.Entities[x.EntityId]; ok && x.HonorId >= 0 && x.HonorId < len(e.Honor) {
+		h := e.Honor[x.HonorId]
+		return fmt.Sprintf("%s received the title %s of %s%s", c.hf(x.Hfid), h.Name(), c.entity(x.EntityId), h.Requirement())
+	}
+	return c.hf(x.Hfid) + " received a title of " + c.entity(x.EntityId)
 }
 
 func (x *HistoricalEventAddHfEntityLink) Html(c *Context) string {
@@ -93,7 +95,7 @@ func (x *HistoricalEventAddHfHfLink) Html(c *Context) string {
 			case HistoricalEventRelationshipRelationship_ReligiousPersecutionGrudge:
 				return h + " held a deep hatred of " + t + " due to religious persecution"
 			case HistoricalEventRelationshipRelationship_ScholarBuddy:
-				return h + " and " + t + " became friends due to a shared interest in UNKNOWN KNOWLEDGE after a scholarly lecture " // TODO detail and site
+				return h + " and " + t + " became friends after a scholarly lecture"
 			case HistoricalEventRelationshipRelationship_SupernaturalGrudge:
 				return h + " was bent toward supernatural vengeance upon " + t
 			case HistoricalEventRelationshipRelationship_WarBuddy:
@@ -141,8 +143,36 @@ func (x *HistoricalEventAgreementConcluded) Html(c *Context) string { // TODO wo
 	return r + " proposed by " + c.entity(x.Source) + " was concluded by " + c.entity(x.Destination) + c.site(x.Site, " at")
 }
 
-func (x *HistoricalEventAgreementFormed) Html(c *Context) string { // TODO no info
-	return "UNKNOWN HistoricalEventAgreementFormed"
+func (x *HistoricalEventAgreementFormed) Html(c *Context) string {
+	official := "an official" + util.If(x.RelevantEntityId != -1, " of "+c.entity(x.RelevantEntityId), "")
+	action := "form an agreement"
+	switch x.Action {
+	case HistoricalEventAgreementFormedAction_BribeOfficial:
+		action = "bribe " + official
+	case HistoricalEventAgreementFormedAction_InduceToEmbezzle:
+		action = "induce " + official + " to embezzle"
+	}
+	method := ""
+	switch x.Method {
+	case HistoricalEventAgreementFormedMethod_BlackmailOverEmbezzlement:
+		method = "made a blackmail threat, due to embezzlement using the position " + c.position(x.RelevantEntityId, x.RelevantPositionProfileId, x.ConcluderHfid) + " of " + c.entity(x.RelevantEntityId)
+	case HistoricalEventAgreementFormedMethod_Bribe:
+		method = "offered a bribe"
+	case HistoricalEventAgreementFormedMethod_Flatter:
+		method = "made flattering remarks"
+	case HistoricalEventAgreementFormedMethod_Intimidate:
+		method = "made a threat"
+	case HistoricalEventAgreementFormedMethod_OfferImmortality:
+		method = "offered immortality"
+	case HistoricalEventAgreementFormedMethod_Precedence:
+		method = "pulled rank as " + c.position(x.RelevantEntityId, x.RelevantPositionProfileId, x.ConcluderHfid) + " of " + c.entity(x.RelevantEntityId)
+	case HistoricalEventAgreementFormedMethod_ReligiousSympathy:
+		method = "played for sympathy" + util.If(x.RelevantIdForMethod != -1, " by appealing to shared worship of "+c.hfRelated(x.RelevantIdForMethod, x.ConcluderHfid), "")
+	case HistoricalEventAgreementFormedMethod_RevengeOnGrudge:
+		method = "offered revenge upon the persecutor " + c.hfRelated(x.RelevantIdForMethod, x.ConcluderHfid)
+	}
+	return c.hf(x.ConcluderHfid) + util.If(x.Delegated, " had an agent try to ", " tried to ") + action +
+		util.If(method != "", " and "+method, "") + util.If(x.Successful, ". The plan worked", ". The plan failed")
 }
 
 func (x *HistoricalEventAgreementMade) Html(c *Context) string {
@@ -429,12 +459,14 @@ func (x *HistoricalEventBuildingProfileAcquired) Html(c *Context) string {
 func (x *HistoricalEventCeremony) Html(c *Context) string {
 	r := c.entity(x.CivId) + " held a ceremony in " + c.site(x.SiteId, "")
 	if e, ok := c.World.Entities[x.CivId]; ok {
-		if x.OccasionId < len(e.Occasion) {
+		if x.OccasionId >= 0 && x.OccasionId < len(e.Occasion) {
 			o := e.Occasion[x.OccasionId]
 			r += " as part of " + o.Name()
-			s := o.Schedule[x.ScheduleId]
-			if len(s.Feature) > 0 {
-				r += ". The event featured " + andList(util.Map(s.Feature, c.feature))
+			if x.ScheduleId >= 0 && x.ScheduleId < len(o.Schedule) {
+				s := o.Schedule[x.ScheduleId]
+				if len(s.Feature) > 0 {
+					r += ". The event featured " + andList(util.Map(s.Feature, c.feature))
+				}
 			}
 		}
 	}
@@ -548,10 +580,10 @@ func (x *HistoricalEventCompetition) Html(c *Context) string {
 	oName := "UNKNOWN OCCASION"
 	sType := "competition"
 	if e, ok := c.World.Entities[x.CivId]; ok {
-		if x.OccasionId < len(e.Occasion) {
+		if x.OccasionId >= 0 && x.OccasionId < len(e.Occasion) {
 			o := e.Occasion[x.OccasionId]
 			oName = o.Name_
-			if x.ScheduleId < len(o.Schedule) {
+			if x.ScheduleId >= 0 && x.ScheduleId < len(o.Schedule) {
 				s := o.Schedule[x.ScheduleId]
 				sType = strcase.ToDelimited(s.Type_.String(), ' ')
 			}
@@ -1008,7 +1040,7 @@ func (x *HistoricalEventHfDied) Html(c *Context) string {
 	case HistoricalEventHfDiedCause_Suffocate, HistoricalEventHfDiedCause_Air:
 		return hf + " suffocated, slain by " + slayer + loc
 	case HistoricalEventHfDiedCause_SuicideDrowned, HistoricalEventHfDiedCause_DrownAltTwo:
-		if f, ok := c.World.HistoricalFigures[x.Hfid]; ok {
+		if f, ok := c.World.Hf(x.Hfid); ok {
 			return hf + " drowned " + util.If(f.Female(), "herself ", "himself ") + loc
 		}
 		return hf + " drowned themselves " + loc
@@ -1188,16 +1220,18 @@ func (x *HistoricalEventHfViewedArtifact) Html(c *Context) string {
 
 func (x *HistoricalEventHfWounded) Html(c *Context) string {
 	r := c.hf(x.WoundeeHfid)
-	bp := "UNKNOWN BODYPART" // TODO bodyparts
+	// the legends only have the index of the body part in the body of the
+	// creature, which is defined by the raws
+	lost := x.PartLost == HistoricalEventHfWoundedPartLost_True
 	switch x.InjuryType {
 	case HistoricalEventHfWoundedInjuryType_Rip:
-		r += "'s " + bp + util.If(x.PartLost == HistoricalEventHfWoundedPartLost_True, " was torn out ", " was ripped ")
+		r += util.If(lost, " had a body part torn out", " was ripped")
 	case HistoricalEventHfWoundedInjuryType_Slash:
-		r += "'s " + bp + util.If(x.PartLost == HistoricalEventHfWoundedPartLost_True, " was slashed off ", " was slashed ")
+		r += util.If(lost, " had a body part slashed off", " was slashed")
 	case HistoricalEventHfWoundedInjuryType_Smash:
-		r += "'s " + bp + util.If(x.PartLost == HistoricalEventHfWoundedPartLost_True, " was smashed off ", " was smashed ")
+		r += util.If(lost, " had a body part smashed off", " was smashed")
 	case HistoricalEventHfWoundedInjuryType_Stab:
-		r += "'s " + bp + util.If(x.PartLost == HistoricalEventHfWoundedPartLost_True, " was stabbed off ", " was stabbed")
+		r += util.If(lost, " had a body part stabbed off", " was stabbed")
 	default:
 		r += " was wounded"
 	}
@@ -1320,6 +1354,11 @@ func (x *HistoricalEventItemStolen) Html(c *Context) string {
 }
 
 func (x *HistoricalEventKnowledgeDiscovered) Html(c *Context) string {
+	return c.hf(x.Hfid) + util.If(x.First, " was the very first to discover ", " independently discovered ") + x.Topic()
+}
+
+// Topic describes the discovered knowledge.
+func (x *HistoricalEventKnowledgeDiscovered) Topic() string {
 	knowledge := x.Knowledge.String()
 	switch x.Knowledge {
 	case HistoricalEventKnowledgeDiscoveredKnowledge_Unknown:
@@ -1873,8 +1912,7 @@ func (x *HistoricalEventKnowledgeDiscovered) Html(c *Context) string {
 	case HistoricalEventKnowledgeDiscoveredKnowledge_PhilosophySpecializedPoliticsSocialWelfare:
 		knowledge = "discourse on social welfare"
 	}
-
-	return c.hf(x.Hfid) + util.If(x.First, " was the very first to discover ", " independently discovered ") + knowledge
+	return knowledge
 }
 
 func (x *HistoricalEventMasterpieceArchConstructed) Html(c *Context) string {
@@ -1922,7 +1960,7 @@ func (x *HistoricalEventMasterpieceItemImprovement) Html(c *Context) string {
 		" for " + c.entity(x.EntityId) + c.site(x.SiteId, " in")
 }
 func (x *HistoricalEventMasterpieceLost) Html(c *Context) string {
-	if e, ok := c.World.HistoricalEvents[x.CreationEvent]; ok {
+	if e, ok := c.World.Event(x.CreationEvent); ok {
 		switch y := e.Details.(type) {
 		case *HistoricalEventMasterpieceArchConstructed:
 			return "the " + util.If(y.BuildingSubtype != HistoricalEventMasterpieceArchConstructedBuildingSubtype_Unknown, y.BuildingSubtype.String(), y.BuildingType.String()) +
@@ -1994,10 +2032,10 @@ func (x *HistoricalEventPerformance) Html(c *Context) string {
 	oName := "UNKNOWN OCCASION"
 	sType := "a performance"
 	if e, ok := c.World.Entities[x.CivId]; ok {
-		if x.OccasionId < len(e.Occasion) {
+		if x.OccasionId >= 0 && x.OccasionId < len(e.Occasion) {
 			o := e.Occasion[x.OccasionId]
 			oName = o.Name_
-			if x.ScheduleId < len(o.Schedule) {
+			if x.ScheduleId >= 0 && x.ScheduleId < len(o.Schedule) {
 				s := o.Schedule[x.ScheduleId]
 				sType = c.schedule(s)
 			}
@@ -2027,22 +2065,23 @@ func (x *HistoricalEventPoeticFormCreated) Html(c *Context) string {
 func (x *HistoricalEventProcession) Html(c *Context) string {
 	r := c.entity(x.CivId) + " held a procession in " + c.site(x.SiteId, "")
 	if e, ok := c.World.Entities[x.CivId]; ok {
-		if x.OccasionId < len(e.Occasion) {
+		if x.OccasionId >= 0 && x.OccasionId < len(e.Occasion) {
 			o := e.Occasion[x.OccasionId]
 			r += " as part of " + o.Name()
-			s := o.Schedule[x.ScheduleId]
-			if s.Reference != -1 {
-				r += ". It started at " + c.structure(x.SiteId, s.Reference)
-				if s.Reference2 != -1 && s.Reference2 != s.Reference {
-					r += " and ended at " + c.structure(x.SiteId, s.Reference2)
-				} else {
-					r += " and returned there after following its route"
+			if x.ScheduleId >= 0 && x.ScheduleId < len(o.Schedule) {
+				s := o.Schedule[x.ScheduleId]
+				if s.Reference != -1 {
+					r += ". It started at " + c.structure(x.SiteId, s.Reference)
+					if s.Reference2 != -1 && s.Reference2 != s.Reference {
+						r += " and ended at " + c.structure(x.SiteId, s.Reference2)
+					} else {
+						r += " and returned there after following its route"
+					}
+				}
+				if len(s.Feature) > 0 {
+					r += ". The event featured " + andList(util.Map(s.Feature, c.feature))
 				}
 			}
-			if len(s.Feature) > 0 {
-				r += ". The event featured " + andList(util.Map(s.Feature, c.feature))
-			}
-			r += string(util.Json(s))
 		}
 	}
 	return r
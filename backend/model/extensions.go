@@ -12,69 +12,152 @@ import (
 )
 
 func (w *DfWorld) AllEventTypes() []string {
+	if w.Store != nil {
+		list := util.Keys(w.Store.types)
+		sort.Strings(list)
+		return list
+	}
 	types := make(map[string]bool)
-	for _, e := range w.HistoricalEvents {
+	w.EachEvent(func(e *HistoricalEvent) {
 		types[e.Details.Type()] = true
-	}
+	})
 	var list = util.Keys(types)
 	sort.Strings(list)
 	return list
 }
 
+type EventTypeList struct {
+	Type   string
+	Events []*HistoricalEvent
+}
+
 func (w *DfWorld) EventsOfType(t string) any {
 	var list []*HistoricalEvent
-	for _, e := range w.HistoricalEvents {
-		if e.Details.Type() == t {
-			list = append(list, e)
-		}
+	if w.Store != nil {
+		list = w.events(w.Store.types[t])
+	} else {
+		w.EachEvent(func(e *HistoricalEvent) {
+			if e.Details.Type() == t {
+				list = append(list, e)
+			}
+		})
+		sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
 	}
 
-	sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
-
-	return struct {
-		Type   string
-		Events []*HistoricalEvent
-	}{
+	return &EventTypeList{
 		Type:   t,
 		Events: list,
 	}
 }
 
+// EventYears maps the years to the ids of their events.
+func (w *DfWorld) EventYears() map[int][]int {
+	if w.Store != nil {
+		return w.Store.years
+	}
+	years := make(map[int][]int)
+	w.EachEvent(func(e *HistoricalEvent) {
+		years[e.Year] = append(years[e.Year], e.Id_)
+	})
+	return years
+}
+
+// EventsInYear lists the events of a year ordered by id.
+func (w *DfWorld) EventsInYear(year int) []*HistoricalEvent {
+	if w.Store != nil {
+		return w.events(w.Store.years[year])
+	}
+	var list []*HistoricalEvent
+	w.EachEvent(func(e *HistoricalEvent) {
+		if e.Year == year {
+			list = append(list, e)
+		}
+	})
+	sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
+	return list
+}
+
 func (w *DfWorld) EventsMatching(f func(HistoricalEventDetails) bool) []*HistoricalEvent {
 	var list []*HistoricalEvent
-	for _, e := range w.HistoricalEvents {
+	w.EachEvent(func(e *HistoricalEvent) {
 		if e.Details != nil && f(e.Details) {
 			list = append(list, e)
 		}
-	}
+	})
 	sort.Slice(list, func(a, b int) bool { return list[a].Id_ < list[b].Id_ })
 	return list
 }
 
 func (w *DfWorld) SiteHistory(siteId int) []*HistoricalEvent {
-	var list []*HistoricalEvent
-	for _, e := range w.HistoricalEvents {
-		if e.Details.RelatedToSite(siteId) {
-			switch e.Details.(type) {
-			case *HistoricalEventCreatedSite, *HistoricalEventDestroyedSite, *HistoricalEventSiteTakenOver, *HistoricalEventHfDestroyedSite, *HistoricalEventReclaimSite:
-				list = append(list, e)
-			}
+	return w.EventsRelatedTo(siteId, func(d HistoricalEventDetails) bool {
+		switch d.(type) {
+		case *HistoricalEventCreatedSite, *HistoricalEventDestroyedSite, *HistoricalEventSiteTakenOver, *HistoricalEventHfDestroyedSite, *HistoricalEventReclaimSite:
+			return d.RelatedToSite(siteId)
 		}
-	}
-	sort.Slice(list, func(a, b int) bool { return list[a].Id_ < list[b].Id_ })
-	return list
+		return false
+	})
 }
 
 func (w *DfWorld) Races() []string {
 	races := make(map[string]bool)
-	for _, hf := range w.HistoricalFigures {
+	w.EachHf(func(hf *HistoricalFigure) {
 		races[hf.Race] = true
-	}
+	})
 	list := maps.Keys(races)
 	sort.Strings(list)
 	return list
 }
 
+// HfValues are the values of the figures to filter them by.
+type HfValues struct {
+	Castes  []string
+	Spheres []string
+	Goals   []string
+	Skills  []string
+}
+
+func (w *DfWorld) Castes() []string {
+	return w.HfValues.Castes
+}
+
+func (w *DfWorld) Spheres() []string {
+	return w.HfValues.Spheres
+}
+
+func (w *DfWorld) Goals() []string {
+	return w.HfValues.Goals
+}
+
+func (w *DfWorld) Skills() []string {
+	return w.HfValues.Skills
+}
+
+func (w *DfWorld) processHfValues() {
+	w.HfValues = &HfValues{
+		Castes:  w.hfValues(func(hf *HistoricalFigure) []string { return []string{strings.ToLower(hf.Caste)} }),
+		Spheres: w.hfValues(func(hf *HistoricalFigure) []string { return hf.Sphere }),
+		Goals: w.hfValues(func(hf *HistoricalFigure) []string {
+			return util.Map(hf.Goal, func(g HistoricalFigureGoal) string { return g.String() })
+		}),
+		Skills: w.hfValues(func(hf *HistoricalFigure) []string {
+			return util.Map(hf.HfSkill, func(s *HfSkill) string { return strings.ToLower(s.Skill) })
+		}),
+	}
+}
+
+func (w *DfWorld) hfValues(mapper func(*HistoricalFigure) []string) []string {
+	values := make(map[string]bool)
+	w.EachHf(func(hf *HistoricalFigure) {
+		for _, v := range mapper(hf) {
+			values[v] = true
+		}
+	})
+	delete(values, "")
+	list := maps.Keys(values)
+	sort.Strings(list)
+	return list
+}
+
 func (c *HistoricalEventCollection) Type() string {
 	if c.Details == nil {
 		return "unk"
@@ -86,7 +169,7 @@ func (e *HistoricalEventCollection) Html(c *Context) string {
 	if e.Details == nil {
 		return "unk"
 	}
-	return e.Details.Html(e, c)
+	return c.collectionHtml(e)
 }
 
 func (e *Artifact) Type() string {
@@ -133,6 +216,9 @@ func (e *Entity) Weapons() []string {
 }
 
 func (e *Entity) Position(id int) *EntityPosition {
+	if e == nil {
+		return &EntityPosition{Name_: "UNKNOWN POSITION"}
+	}
 	for _, p := range e.EntityPosition {
 		if p.Id_ == id {
 			return p
@@ -260,8 +346,8 @@ func (r *Reference) Html(c *Context) template.HTML {
 	case ReferenceType_ENTITY:
 		return template.HTML(c.entity(r.Id_))
 	case ReferenceType_HISTORICALEVENT:
-		if e, ok := c.World.HistoricalEvents[r.Id_]; ok {
-			return template.HTML("how in " + Time(e.Year, e.Seconds72) + " " + e.Details.Html(c))
+		if e, ok := c.World.Event(r.Id_); ok {
+			return template.HTML("how in " + Time(e.Year, e.Seconds72) + " " + c.EventHtml(e.Details))
 		}
 	case ReferenceType_HISTORICALFIGURE:
 		return template.HTML(c.hf(r.Id_))
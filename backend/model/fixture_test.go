@@ -0,0 +1,172 @@
+package model
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// The fixture is generated from the same metadata as model.go with
+// `make fixtures` in analyze.
+const fixtureFile = "testdata/fixture-legends.xml"
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func parseFixture(t *testing.T) *DfWorld {
+	t.Helper()
+	w, err := Parse(fixtureFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestFixtureCoversAllTypes(t *testing.T) {
+	w := parseFixture(t)
+
+	events := make(map[string]bool)
+	for _, e := range w.HistoricalEvents {
+		events[e.Details.Type()] = true
+	}
+	for _, typ := range util.Keys(historicalEventTypes) {
+		if !events[typ] {
+			t.Errorf("no event %q", typ)
+		}
+	}
+	collections := make(map[string]bool)
+	for _, e := range w.HistoricalEventCollections {
+		collections[e.Details.Type()] = true
+	}
+	for _, typ := range util.Keys(historicalEventCollectionTypes) {
+		if !collections[typ] {
+			t.Errorf("no collection %q", typ)
+		}
+	}
+
+	if !w.Plus {
+		t.Error("legends_plus.xml not loaded")
+	}
+	if w.Name_ != "Fixture World" {
+		t.Errorf("world history not loaded, name is %q", w.Name_)
+	}
+	if w.Width != 17 || w.Height != 17 {
+		t.Errorf("world gen params not loaded, size is %dx%d", w.Width, w.Height)
+	}
+	if len(w.Entities[0].Leaders) != 2 {
+		t.Errorf("entity 0 has %d leaders", len(w.Entities[0].Leaders))
+	}
+}
+
+// golden compares got to the file in testdata, or replaces it with -update.
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := "testdata/" + name
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Split(string(data), "\n")
+	for i, line := range strings.Split(got, "\n") {
+		if i >= len(want) {
+			t.Errorf("%s:%d: unexpected %q", name, i+1, line)
+			return
+		}
+		if line != want[i] {
+			t.Errorf("%s:%d:\n got %q\nwant %q", name, i+1, line, want[i])
+		}
+	}
+}
+
+// unresolved reports sentences with an unknown object, as every reference of
+// the fixture points to an object. Some sentences lack the data.
+func unresolved(t *testing.T, what string, id int, s string) {
+	t.Helper()
+	if strings.Contains(s, "UNKNOWN") {
+		t.Errorf("%s %d has an unknown object: %s", what, id, s)
+	}
+}
+
+func TestEventsGolden(t *testing.T) {
+	w := parseFixture(t)
+	c := &Context{World: w, HfId: -1, Locale: DefaultLocale}
+
+	var b strings.Builder
+	ids := util.Keys(w.HistoricalEvents)
+	sort.Ints(ids)
+	for _, id := range ids {
+		e := w.HistoricalEvents[id]
+		c.Event = e
+		s, err := renderEvent(c, e.Details)
+		if err != nil {
+			t.Errorf("event %d %s: %v", id, e.Details.Type(), err)
+		}
+		unresolved(t, "event", id, s)
+		fmt.Fprintf(&b, "%d %s: %s\n", id, e.Details.Type(), s)
+	}
+	golden(t, "events.golden", b.String())
+}
+
+func TestCollectionsGolden(t *testing.T) {
+	w := parseFixture(t)
+	c := &Context{World: w, HfId: -1, Locale: DefaultLocale}
+
+	var b strings.Builder
+	ids := util.Keys(w.HistoricalEventCollections)
+	sort.Ints(ids)
+	for _, id := range ids {
+		e := w.HistoricalEventCollections[id]
+		s, err := renderCollection(c, e)
+		if err != nil {
+			t.Errorf("collection %d %s: %v", id, e.Details.Type(), err)
+		}
+		unresolved(t, "collection", id, s)
+		fmt.Fprintf(&b, "%d %s: %s\n", id, e.Details.Type(), s)
+	}
+	golden(t, "collections.golden", b.String())
+}
+
+func TestProvenanceCopy(t *testing.T) {
+	w := parseFixture(t)
+	a := NewArtifact()
+	a.Id_ = 100
+	w.Artifacts[a.Id_] = a
+
+	add := func(id, year int, d HistoricalEventDetails) {
+		e := NewHistoricalEvent()
+		e.Id_, e.Year, e.Details = id, year, d
+		w.HistoricalEvents[id] = e
+	}
+	created := NewHistoricalEventArtifactCreated()
+	created.ArtifactId, created.HistFigureId, created.SiteId = a.Id_, 0, 0
+	add(1000, 100, created)
+	copied := NewHistoricalEventArtifactCopied()
+	copied.ArtifactId, copied.DestEntityId, copied.DestSiteId, copied.DestStructureId = a.Id_, 1, 1, 0
+	copied.SourceSiteId = 0
+	add(1001, 101, copied)
+
+	p := NewProvenance(w, a)
+	if len(p.Custody) != 1 {
+		t.Fatalf("%d custodies, want 1", len(p.Custody))
+	}
+	c := p.Custody[0]
+	if c.HolderHfid != 0 || c.SiteId != 0 || c.StructureId != -1 {
+		t.Errorf("after the copy held by %d in site %d, structure %d, want 0 in site 0", c.HolderHfid, c.SiteId, c.StructureId)
+	}
+	if len(c.Events) != 1 || c.Events[0] != 1001 {
+		t.Errorf("copy not in the timeline: %v", c.Events)
+	}
+	if sites := p.Sites(); len(sites) != 1 || sites[0] != 0 {
+		t.Errorf("trail through %v, want site 0", sites)
+	}
+}
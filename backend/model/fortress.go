@@ -0,0 +1,204 @@
+package model
+
+import (
+	"sort"
+
+	"golang.org/x/exp/slices"
+)
+
+// Fortress aggregates the history of a single site, usually the fortress
+// played by the user.
+type Fortress struct {
+	Site *Site
+	// Founded is the year the site was created, -1 if unknown
+	Founded        int
+	FoundedEventId int
+	CivId          int
+	Citizens       []int
+	Visitors       []*FortressVisitor
+	Artifacts      []int
+	Attacks        []int
+	Masterpieces   []*HistoricalEvent
+	Deaths         []*HistoricalEvent
+	Years          []*FortressYear
+}
+
+type FortressVisitor struct {
+	Hfid      int
+	Visits    int
+	FirstYear int
+	LastYear  int
+}
+
+// FortressYear counts what happened at the fortress in one year.
+type FortressYear struct {
+	Year         int
+	Visitors     int
+	Artifacts    int
+	Attacks      int
+	Masterpieces int
+	Deaths       int
+}
+
+func NewFortress(w *DfWorld, site *Site) *Fortress {
+	f := &Fortress{Site: site, Founded: -1, FoundedEventId: -1, CivId: -1}
+
+	years := make(map[int]*FortressYear)
+	year := func(y int) *FortressYear {
+		if x, ok := years[y]; ok {
+			return x
+		}
+		x := &FortressYear{Year: y}
+		years[y] = x
+		f.Years = append(f.Years, x)
+		return x
+	}
+
+	w.EachHf(func(hf *HistoricalFigure) {
+		if slices.IndexFunc(hf.SiteLink, func(l *SiteLink) bool { return l.SiteId == site.Id_ && l.Home() }) != -1 {
+			f.Citizens = append(f.Citizens, hf.Id_)
+		}
+	})
+	sort.Ints(f.Citizens)
+
+	events := w.EventsRelatedTo(site.Id_, func(d HistoricalEventDetails) bool { return d.RelatedToSite(site.Id_) })
+	sort.Slice(events, func(i, j int) bool { return events[i].Id_ < events[j].Id_ })
+
+	visitors := make(map[int]*FortressVisitor)
+	for _, e := range events {
+		switch d := e.Details.(type) {
+		case *HistoricalEventCreatedSite:
+			if d.SiteId == site.Id_ && f.FoundedEventId == -1 {
+				f.Founded, f.FoundedEventId, f.CivId = e.Year, e.Id_, d.CivId
+			}
+		case *HistoricalEventHfTravel:
+			if d.SiteId != site.Id_ || d.Return {
+				continue
+			}
+			for _, id := range d.GroupHfid {
+				if _, ok := slices.BinarySearch(f.Citizens, id); ok {
+					continue
+				}
+				v, ok := visitors[id]
+				if !ok {
+					v = &FortressVisitor{Hfid: id, FirstYear: e.Year}
+					visitors[id] = v
+					f.Visitors = append(f.Visitors, v)
+				}
+				v.Visits++
+				v.LastYear = e.Year
+				year(e.Year).Visitors++
+			}
+		case *HistoricalEventArtifactCreated:
+			if d.SiteId == site.Id_ {
+				f.Artifacts = append(f.Artifacts, d.ArtifactId)
+				year(e.Year).Artifacts++
+			}
+		case *HistoricalEventMasterpieceItem:
+			f.masterpiece(e, year, d.SiteId == site.Id_)
+		case *HistoricalEventMasterpieceEngraving:
+			f.masterpiece(e, year, d.SiteId == site.Id_)
+		case *HistoricalEventMasterpieceFood:
+			f.masterpiece(e, year, d.SiteId == site.Id_ || d.Site == site.Id_)
+		case *HistoricalEventMasterpieceDye:
+			f.masterpiece(e, year, d.SiteId == site.Id_ || d.Site == site.Id_)
+		case *HistoricalEventHfDied:
+			if d.SiteId == site.Id_ {
+				f.Deaths = append(f.Deaths, e)
+				year(e.Year).Deaths++
+			}
+		}
+	}
+
+	for _, c := range w.HistoricalEventCollections {
+		siteId := -1
+		switch d := c.Details.(type) {
+		case *HistoricalEventCollectionBattle:
+			siteId = d.SiteId
+		case *HistoricalEventCollectionSiteConquered:
+			siteId = d.SiteId
+		case *HistoricalEventCollectionRaid:
+			siteId = d.SiteId
+		case *HistoricalEventCollectionBeastAttack:
+			siteId = d.SiteId
+		case *HistoricalEventCollectionAbduction:
+			siteId = d.SiteId
+		case *HistoricalEventCollectionTheft:
+			siteId = d.SiteId
+		}
+		if siteId == site.Id_ {
+			f.Attacks = append(f.Attacks, c.Id_)
+			year(c.StartYear).Attacks++
+		}
+	}
+	sort.Ints(f.Attacks)
+
+	sort.Slice(f.Years, func(i, j int) bool { return f.Years[i].Year < f.Years[j].Year })
+	return f
+}
+
+func (f *Fortress) masterpiece(e *HistoricalEvent, year func(int) *FortressYear, here bool) {
+	if here {
+		f.Masterpieces = append(f.Masterpieces, e)
+		year(e.Year).Masterpieces++
+	}
+}
+
+// Home reports whether the figure lives at the site of the link.
+func (l *SiteLink) Home() bool {
+	switch l.LinkType {
+	case SiteLinkLinkType_HomeSavedZone, SiteLinkLinkType_HomeSiteBuilding, SiteLinkLinkType_HomeSiteUnderground, SiteLinkLinkType_HomeStructure:
+		return true
+	}
+	return false
+}
+
+// Structures lists the structures of the site ordered by id.
+func (f *Fortress) Structures() []*Structure {
+	var list []*Structure
+	for _, s := range f.Site.Structures {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
+	return list
+}
+
+// FortressSite is a fortress founded by a civilization, the candidates for
+// the fortress played by the user.
+type FortressSite struct {
+	SiteId  int
+	CivId   int
+	Founded int
+}
+
+// FortressSites lists the fortresses founded by a civilization, the most
+// recent first. With civId -1 the fortresses played by the user are
+// detected, which are the ones founded after world generation by their
+// embark civilizations. Without the end of world generation, the fortresses
+// of the civilization that founded the last one are listed.
+func FortressSites(w *DfWorld, civId int) []*FortressSite {
+	var list []*FortressSite
+	w.EachEvent(func(e *HistoricalEvent) {
+		if d, ok := e.Details.(*HistoricalEventCreatedSite); ok && (civId == -1 || d.CivId == civId) {
+			if site, ok := w.Sites[d.SiteId]; ok && site.Type_ == SiteType_Fortress {
+				list = append(list, &FortressSite{SiteId: d.SiteId, CivId: d.CivId, Founded: e.Year})
+			}
+		}
+	})
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Founded != list[j].Founded {
+			return list[i].Founded > list[j].Founded
+		}
+		return list[i].SiteId > list[j].SiteId
+	})
+	if civId != -1 || len(list) == 0 {
+		return list
+	}
+	var played []*FortressSite
+	for _, f := range list {
+		if (w.EndYear != -1 && f.Founded > w.EndYear) || (w.EndYear == -1 && f.CivId == list[0].CivId) {
+			played = append(played, f)
+		}
+	}
+	return played
+}
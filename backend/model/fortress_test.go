@@ -0,0 +1,85 @@
+package model
+
+import (
+	"testing"
+)
+
+// fortressTestWorld has fortresses founded by the civilizations 1 in the
+// years 50 and 150 and 2 in the years 60 and 120.
+func fortressTestWorld() *DfWorld {
+	w := NewDfWorld()
+	for i, f := range []struct{ civ, year int }{{1, 50}, {2, 60}, {2, 120}, {1, 150}} {
+		site := NewSite()
+		site.Id_, site.Type_ = i, SiteType_Fortress
+		w.Sites[i] = site
+
+		d := NewHistoricalEventCreatedSite()
+		d.SiteId, d.CivId = i, f.civ
+		e := NewHistoricalEvent()
+		e.Id_, e.Year, e.Details = i, f.year, d
+		w.HistoricalEvents[i] = e
+	}
+	return w
+}
+
+func TestFortressSites(t *testing.T) {
+	sites := func(list []*FortressSite) []int {
+		var ids []int
+		for _, f := range list {
+			ids = append(ids, f.SiteId)
+		}
+		return ids
+	}
+	w := fortressTestWorld()
+
+	for _, c := range []struct {
+		name    string
+		endYear int
+		civId   int
+		want    []int
+	}{
+		{"civ", 100, 2, []int{2, 1}},
+		{"after world gen", 100, -1, []int{3, 2}},
+		{"last civ", -1, -1, []int{3, 0}},
+	} {
+		w.EndYear = c.endYear
+		got := sites(FortressSites(w, c.civId))
+		if len(got) != len(c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFortress(t *testing.T) {
+	w := fortressTestWorld()
+	for id, typ := range []SiteLinkLinkType{SiteLinkLinkType_HomeSiteBuilding, SiteLinkLinkType_Occupation, SiteLinkLinkType_HomeStructure} {
+		hf := NewHistoricalFigure()
+		hf.Id_ = id
+		l := NewSiteLink()
+		l.SiteId, l.LinkType = 0, typ
+		hf.SiteLink = append(hf.SiteLink, l)
+		w.HistoricalFigures[id] = hf
+	}
+	for id, siteId := range []int{0, 1} {
+		d := NewHistoricalEventCollectionBattle()
+		d.SiteId = siteId
+		c := NewHistoricalEventCollection()
+		c.Id_, c.StartYear, c.Details = id, 160, d
+		w.HistoricalEventCollections[id] = c
+	}
+
+	f := NewFortress(w, w.Sites[0])
+	if len(f.Citizens) != 2 || f.Citizens[0] != 0 || f.Citizens[1] != 2 {
+		t.Errorf("citizens %v", f.Citizens)
+	}
+	if len(f.Attacks) != 1 || f.Attacks[0] != 0 {
+		t.Errorf("attacks %v", f.Attacks)
+	}
+}
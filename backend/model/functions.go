@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
 )
@@ -33,6 +34,12 @@ var LinkLandmass = func(w *DfWorld, id int) template.HTML { return template.HTML
 var LinkRiver = func(w *DfWorld, id int) template.HTML { return template.HTML((&Context{World: w}).river(id)) }
 var LinkIdentity = func(w *DfWorld, id int) template.HTML { return template.HTML((&Context{World: w}).identity(id)) }
 
+// links with text depending on the locale and revealed identities of the context
+var LinkHfIn = func(c *Context, id int) template.HTML { return template.HTML(c.hf(id)) }
+var LinkHfListIn = func(c *Context, id []int) template.HTML { return template.HTML(c.hfList(id)) }
+var LinkCollectionIn = func(c *Context, id int) template.HTML { return template.HTML(c.collection(id)) }
+var LinkIdentityIn = func(c *Context, id int) template.HTML { return template.HTML(c.identity(id)) }
+
 var AddMapLandmass = func(w *DfWorld, id int) template.HTML {
 	if x, ok := w.Landmasses[id]; ok {
 		c1 := strings.Split(x.Coord1, ",")
@@ -90,14 +97,15 @@ var AddMapSite = func(w *DfWorld, id int, color bool) template.HTML {
 
 var AddMapMountain = func(w *DfWorld, id int, color bool) template.HTML {
 	if m, ok := w.MountainPeaks[id]; ok {
-		c1 := strings.Split(m.Coords, ",")
-		x, _ := strconv.Atoi(c1[0])
-		y, _ := strconv.Atoi(c1[1])
+		coords := m.Coordinates()
+		if len(coords) == 0 {
+			return ""
+		}
 		c := "#666"
 		if !color {
 			c = "#fff"
 		}
-		return template.HTML(fmt.Sprintf(`<script>addMountain(%d, %d, %d, '%s')</script>`, m.Id_, x, y, c))
+		return template.HTML(fmt.Sprintf(`<script>addMountain(%d, %d, %d, '%s')</script>`, m.Id_, coords[0].X, coords[0].Y, c))
 	}
 	return ""
 }
@@ -134,10 +142,9 @@ func AddMapCollection(w *DfWorld, id int) template.HTML {
 		case *HistoricalEventCollectionBeastAttack:
 			r += string(AddMapSite(w, d.SiteId, false))
 		case *HistoricalEventCollectionDuel:
-			c1 := strings.Split(d.Coords, ",")
-			x, _ := strconv.Atoi(c1[0])
-			y, _ := strconv.Atoi(c1[1])
-			r += fmt.Sprintf(`<script>addBattle(%d, %d, %d)</script>`, id, x, y)
+			if c := d.Coordinates(); len(c) > 0 {
+				r += fmt.Sprintf(`<script>addBattle(%d, %d, %d)</script>`, id, c[0].X, c[0].Y)
+			}
 		case *HistoricalEventCollectionSiteConquered:
 			r += string(AddMapSite(w, d.SiteId, false))
 		case *HistoricalEventCollectionBattle:
@@ -152,10 +159,9 @@ func AddMapCollection(w *DfWorld, id int) template.HTML {
 				r += string(AddMapSite(w, s, true))
 			}
 
-			c1 := strings.Split(d.Coords, ",")
-			x, _ := strconv.Atoi(c1[0])
-			y, _ := strconv.Atoi(c1[1])
-			r += fmt.Sprintf(`<script>addBattle(%d, %d, %d)</script>`, id, x, y)
+			if c := d.Coordinates(); len(c) > 0 {
+				r += fmt.Sprintf(`<script>addBattle(%d, %d, %d)</script>`, id, c[0].X, c[0].Y)
+			}
 		case *HistoricalEventCollectionWar:
 			for _, y := range x.Eventcol {
 				r += string(AddMapCollection(w, y))
@@ -255,10 +261,10 @@ func LinkDescription(w *DfWorld, desc string) template.HTML {
 	desc = replaceNameDescription(desc, "grew out of the performances of ", `\.`, w.Entities, c.entity)
 	desc = replaceNameDescription(desc, "accompanied by(?: any composition of)? ", `(?: as |\.)`, w.MusicalForms, c.musicalForm)
 	desc = replaceNameDescription(desc, "(?:recites?|acts? out)(?: any composition of)? ", `(?: while |\.)`, w.PoeticForms, c.poeticForm)
-	desc = replacHfDescription(desc, "devised by ", `\.`, w.HistoricalFigures, c.hf)
-	desc = replacHfDescription(desc, "the story of ", `\.`, w.HistoricalFigures, c.hf)
+	desc = replacHfDescription(desc, "devised by ", `\.`, w, c.hf)
+	desc = replacHfDescription(desc, "the story of ", `\.`, w, c.hf)
 	desc = replaceNameDescription(desc, "the words of ", `(?: while |\.)`, w.WrittenContents, c.writtenContent)
-	desc = replacHfDescription(desc, "express pleasure with ", " originally", w.HistoricalFigures, c.hf)
+	desc = replacHfDescription(desc, "express pleasure with ", " originally", w, c.hf)
 	s := strings.Split(desc, "[B]")
 	if len(s) > 1 {
 		desc = s[0] + "<ul><li>" + strings.Join(s[1:], "</li><li>") + "</li></ul>"
@@ -275,20 +281,43 @@ func replaceNameDescription[T NamedIdentifiable](s, prefix, suffix string, input
 	return replaceDescription(s, prefix, suffix, input, func(t T) string { return t.Name() }, mapper)
 }
 
-func replacHfDescription(s, prefix, suffix string, input map[int]*HistoricalFigure, mapper func(int) string) string {
-	return replaceDescription(s, prefix, suffix, input,
-		func(hf *HistoricalFigure) string {
-			if hf.Race != "" && !hf.Deity && !hf.Force {
-				return fmt.Sprintf("the %s %s", hf.Race, hf.Name())
-			} else {
-				return hf.Name()
-			}
-		}, mapper)
+// replacHfDescription links a figure by the names of w.HfNames.
+func replacHfDescription(s, prefix, suffix string, w *DfWorld, mapper func(int) string) string {
+	reg := descriptionPattern(prefix, suffix)
+	res := reg.FindStringSubmatch(s)
+	if res == nil {
+		return s
+	}
+
+	if id, ok := w.HfNames[strings.ToLower(res[2])]; ok {
+		return reg.ReplaceAllString(s, res[1]+mapper(id)+res[3])
+	}
+	return s
+}
+
+// descriptionName is the name of a figure in the descriptions of forms.
+func (hf *HistoricalFigure) descriptionName() string {
+	if hf.Race != "" && !hf.Deity && !hf.Force {
+		return fmt.Sprintf("the %s %s", hf.Race, hf.Name())
+	}
+	return hf.Name()
+}
+
+// the patterns of the names in descriptions by prefix and suffix
+var descriptionPatterns sync.Map
+
+func descriptionPattern(prefix, suffix string) *regexp.Regexp {
+	key := prefix + "\x00" + suffix
+	if reg, ok := descriptionPatterns.Load(key); ok {
+		return reg.(*regexp.Regexp)
+	}
+	reg := regexp.MustCompile("(" + prefix + `)([^.]+?)(` + suffix + ")")
+	descriptionPatterns.Store(key, reg)
+	return reg
 }
 
 func replaceDescription[T NamedIdentifiable](s, prefix, suffix string, input map[int]T, namer func(T) string, mapper func(int) string) string {
-	r := "(" + prefix + `)([^.]+?)(` + suffix + ")"
-	reg := regexp.MustCompile(r)
+	reg := descriptionPattern(prefix, suffix)
 	res := reg.FindStringSubmatch(s)
 	if res == nil {
 		return s
@@ -19,43 +19,64 @@ type EntityLeader struct {
 }
 
 func (w *DfWorld) LoadHistory() {
-	fmt.Println("")
+	fmt.Fprintln(Log, "")
 
 	path := strings.ReplaceAll(w.FilePath, "-legends.xml", "-world_history.txt")
 	data, err := ioutil.ReadFile(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			fmt.Println("no world history found")
+			fmt.Fprintln(Log, "no world history found")
 		} else {
-			fmt.Println(err)
+			fmt.Fprintln(Log, err)
 		}
 		return
 	}
 
-	lines := strings.Split(string(data), "\n")
+	text := util.ConvertCp473(data)
+	lines := strings.Split(text, "\n")
 	w.Name_ = lines[0]
 	w.Altname = lines[1]
 
-	fmt.Println("found world history", path)
+	fmt.Fprintln(Log, "found world history", path)
 	leaderRegEx := regexp.MustCompile(`  \[\*\] (.+?) \(.*?Reign Began: (-?\d+)\)`)
-	results := regexp.MustCompile(`\n([^ ].*?), [^\n]+(?:\n [^\n]+)*`).FindAllStringSubmatch(util.ConvertCp473(data), -1)
+	results := regexp.MustCompile(`\n([^ \n].*?), [^\n]+(?:\n [^\n]+)*`).FindAllStringSubmatch(text, -1)
+
+	type civLeaders struct {
+		civ     *Entity
+		leaders [][]string
+	}
+	var civs []civLeaders
+	hfs := make(map[string]*HistoricalFigure)
 	for _, result := range results {
 		if _, civ, ok := util.FindInMap(w.Entities, nameMatches[*Entity](result[1])); ok {
 			leaders := leaderRegEx.FindAllStringSubmatch(result[0], -1)
-			var last *EntityLeader
 			for _, leader := range leaders {
-				year, _ := strconv.Atoi(leader[2])
-				l := &EntityLeader{StartYear: year, EndYear: -1}
-				if _, hf, ok := util.FindInMap(w.HistoricalFigures, nameMatches[*HistoricalFigure](leader[1])); ok {
-					hf.Leader = true
-					l.Hf = hf
-					civ.Leaders = append(civ.Leaders, l)
-				}
-				if last != nil {
-					last.EndYear = year
-				}
-				last = l
+				hfs[strings.ToLower(leader[1])] = nil
+			}
+			civs = append(civs, civLeaders{civ, leaders})
+		}
+	}
+	// the figures might be in a store, they are looked up in one pass
+	w.EachHf(func(hf *HistoricalFigure) {
+		if found, ok := hfs[hf.Name()]; ok && found == nil {
+			hf.Leader = true
+			hfs[hf.Name()] = hf
+		}
+	})
+
+	for _, c := range civs {
+		var last *EntityLeader
+		for _, leader := range c.leaders {
+			year, _ := strconv.Atoi(leader[2])
+			l := &EntityLeader{StartYear: year, EndYear: -1}
+			if hf := hfs[strings.ToLower(leader[1])]; hf != nil {
+				l.Hf = hf
+				c.civ.Leaders = append(c.civ.Leaders, l)
+			}
+			if last != nil {
+				last.EndYear = year
 			}
+			last = l
 		}
 	}
 }
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"sort"
+
+	"golang.org/x/exp/slices"
+)
+
+// processIdentities links identities to the figures using them. Identities
+// assumed in events are already resolved by processEvents, this adds the
+// ones only known from the current and used identities of a figure.
+func (w *DfWorld) processIdentities() {
+	w.EachHf(func(hf *HistoricalFigure) {
+		for _, id := range append([]int{hf.CurrentIdentityId}, hf.UsedIdentityId...) {
+			if i, ok := w.Identities[id]; ok && i.HistfigId == -1 {
+				i.HistfigId = hf.Id_
+			}
+		}
+	})
+}
+
+// IdentityUse is one identity in the life of a historical figure.
+type IdentityUse struct {
+	IdentityId int
+	// Year is the year the identity was assumed, -1 if unknown
+	Year    int
+	EventId int
+	Current bool
+}
+
+// IdentityTimeline lists the identities used by a figure in the order they were assumed.
+func (w *DfWorld) IdentityTimeline(hf *HistoricalFigure) []*IdentityUse {
+	var list []*IdentityUse
+	use := func(id int) *IdentityUse {
+		for _, u := range list {
+			if u.IdentityId == id {
+				return u
+			}
+		}
+		u := &IdentityUse{IdentityId: id, Year: -1, EventId: -1}
+		list = append(list, u)
+		return u
+	}
+
+	events := w.EventsRelatedTo(hf.Id_, func(d HistoricalEventDetails) bool {
+		x, ok := d.(*HistoricalEventAssumeIdentity)
+		return ok && x.TricksterHfid == hf.Id_
+	})
+	sort.Slice(events, func(i, j int) bool { return events[i].Id_ < events[j].Id_ })
+	for _, e := range events {
+		u := use(e.Details.(*HistoricalEventAssumeIdentity).IdentityId)
+		if u.EventId == -1 {
+			u.Year, u.EventId = e.Year, e.Id_
+		}
+	}
+	for _, id := range hf.UsedIdentityId {
+		use(id)
+	}
+	if hf.CurrentIdentityId != -1 {
+		use(hf.CurrentIdentityId).Current = true
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if (a.Year == -1) != (b.Year == -1) {
+			return b.Year == -1
+		}
+		return a.Year < b.Year
+	})
+	return list
+}
+
+// DoubleLives lists the identities posing as members of an entity whose real
+// historical figure is known.
+func (w *DfWorld) DoubleLives(entityId int) []*Identity {
+	var list []*Identity
+	for _, i := range w.Identities {
+		if i.EntityId == entityId && i.HistfigId != -1 {
+			list = append(list, i)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
+	return list
+}
+
+// RealMember reports if the figure behind an identity is also a member of
+// the entity under its real name.
+func (w *DfWorld) RealMember(i *Identity) bool {
+	if hf, ok := w.Hf(i.HistfigId); ok {
+		return slices.IndexFunc(hf.EntityLink, func(l *HistoricalFigureEntityLink) bool { return l.EntityId == i.EntityId }) != -1
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// loading is shared by the parsers of one world: the table of its repeated
+// strings and the arenas of its events. Worlds loaded at the same time do not
+// share them, and a world does not keep the strings of another alive.
+type loading struct {
+	interned *util.Interner
+	arenas
+}
+
+func newLoading() *loading {
+	return &loading{interned: util.NewInterner()}
+}
+
+// parser reads a legends file into the world being loaded.
+type parser struct {
+	*util.XMLParser
+	*loading
+}
+
+func (l *loading) parser(p *util.XMLParser) *parser {
+	return &parser{XMLParser: p, loading: l}
+}
+
+func (l *loading) itxt(b []byte) string {
+	s, _ := l.interned.Intern(b)
+	return s
+}
+
+// coordinates returns the coordinates parsed while loading, or parses the
+// text of objects that were not, like the ones read back from a store. The
+// result is shared and must not be modified.
+func coordinates(parsed []Coord, s string) []Coord {
+	if parsed == nil && s != "" {
+		return parseCoords(s)
+	}
+	return parsed
+}
+
+func parseCoords(s string) []Coord {
+	var coords []Coord
+	for _, c := range strings.Split(s, "|") {
+		if c == "" {
+			continue
+		}
+		d := strings.Split(c, ",")
+		if len(d) < 2 {
+			continue
+		}
+		x, _ := strconv.Atoi(d[0])
+		y, _ := strconv.Atoi(d[1])
+		coords = append(coords, Coord{X: x, Y: y})
+	}
+	return coords
+}
+
+// blocks grow up to this number of objects
+const maxArenaBlock = 1024
+
+// arena allocates objects in blocks instead of one by one, which saves the
+// garbage collector from tracking millions of small events. Objects of a
+// block are freed together once none of them is used anymore.
+type arena[T any] struct {
+	lock  sync.Mutex
+	size  int
+	block []T
+}
+
+func (a *arena[T]) new() *T {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if len(a.block) == 0 {
+		if a.size < maxArenaBlock {
+			a.size = a.size*2 + 16
+		}
+		a.block = make([]T, a.size)
+	}
+	x := &a.block[0]
+	a.block = a.block[1:]
+	return x
+}
@@ -0,0 +1,222 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+	"golang.org/x/exp/slices"
+)
+
+// number of entries in the ranked lists of the library
+const libraryTop = 100
+
+func (w *DfWorld) processWrittenContents() {
+	for _, wc := range w.WrittenContents {
+		for _, r := range wc.Reference {
+			if r.Type_ != ReferenceType_WRITTENCONTENT {
+				continue
+			}
+			if cited, ok := w.WrittenContents[r.Id_]; ok && !slices.Contains(cited.CitedBy, wc.Id_) {
+				cited.CitedBy = append(cited.CitedBy, wc.Id_)
+			}
+		}
+	}
+
+	for _, a := range w.Artifacts {
+		ids := []int{a.Writing}
+		if a.Item != nil {
+			ids = append(ids, a.Item.PageWrittenContentId, a.Item.WritingWrittenContentId)
+		}
+		for _, id := range ids {
+			if wc, ok := w.WrittenContents[id]; ok && !slices.Contains(wc.Copies, a.Id_) {
+				wc.Copies = append(wc.Copies, a.Id_)
+			}
+		}
+	}
+
+	for _, wc := range w.WrittenContents {
+		sort.Ints(wc.CitedBy)
+		sort.Ints(wc.Copies)
+	}
+}
+
+type Library struct {
+	Works   []*WrittenContent
+	Figures []*LibraryCitation
+	Events  []*LibraryCitation
+	Authors []*LibraryAuthor
+}
+
+type LibraryCitation struct {
+	Id    int
+	Works []int
+}
+
+type LibraryAuthor struct {
+	Hfid  int
+	Works []int
+}
+
+func NewLibrary(w *DfWorld) *Library {
+	l := &Library{}
+
+	figures := make(map[int][]int)
+	events := make(map[int][]int)
+	authors := make(map[int][]int)
+	for _, wc := range w.WrittenContents {
+		if len(wc.CitedBy) > 0 {
+			l.Works = append(l.Works, wc)
+		}
+		if wc.AuthorHfid != -1 {
+			authors[wc.AuthorHfid] = append(authors[wc.AuthorHfid], wc.Id_)
+		}
+		for _, r := range wc.Reference {
+			switch r.Type_ {
+			case ReferenceType_HISTORICALFIGURE:
+				figures[r.Id_] = append(figures[r.Id_], wc.Id_)
+			case ReferenceType_HISTORICALEVENT:
+				events[r.Id_] = append(events[r.Id_], wc.Id_)
+			}
+		}
+	}
+
+	sort.Slice(l.Works, func(i, j int) bool {
+		a, b := l.Works[i], l.Works[j]
+		if len(a.CitedBy) != len(b.CitedBy) {
+			return len(a.CitedBy) > len(b.CitedBy)
+		}
+		return a.Id_ < b.Id_
+	})
+	if len(l.Works) > libraryTop {
+		l.Works = l.Works[:libraryTop]
+	}
+	l.Figures = rankCitations(figures)
+	l.Events = rankCitations(events)
+
+	for id, works := range authors {
+		sort.Ints(works)
+		l.Authors = append(l.Authors, &LibraryAuthor{Hfid: id, Works: works})
+	}
+	sort.Slice(l.Authors, func(i, j int) bool {
+		a, b := l.Authors[i], l.Authors[j]
+		if len(a.Works) != len(b.Works) {
+			return len(a.Works) > len(b.Works)
+		}
+		return a.Hfid < b.Hfid
+	})
+
+	return l
+}
+
+func rankCitations(citations map[int][]int) []*LibraryCitation {
+	var list []*LibraryCitation
+	for id, works := range citations {
+		sort.Ints(works)
+		list = append(list, &LibraryCitation{Id: id, Works: works})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if len(list[i].Works) != len(list[j].Works) {
+			return len(list[i].Works) > len(list[j].Works)
+		}
+		return list[i].Id < list[j].Id
+	})
+	if len(list) > libraryTop {
+		list = list[:libraryTop]
+	}
+	return list
+}
+
+type CitationGraph struct {
+	Nodes []*CitationNode `json:"nodes"`
+	Edges []*CitationEdge `json:"edges"`
+}
+
+type CitationNode struct {
+	Id    string `json:"id"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+type CitationEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"`
+}
+
+var citationNodeTypes = map[ReferenceType]string{
+	ReferenceType_ARTIFACT:         "artifact",
+	ReferenceType_DANCEFORM:        "danceform",
+	ReferenceType_ENTITY:           "entity",
+	ReferenceType_HISTORICALEVENT:  "event",
+	ReferenceType_HISTORICALFIGURE: "hf",
+	ReferenceType_MUSICALFORM:      "musicalform",
+	ReferenceType_POETICFORM:       "poeticform",
+	ReferenceType_SITE:             "site",
+	ReferenceType_SUBREGION:        "region",
+	ReferenceType_WRITTENCONTENT:   "writtencontent",
+}
+
+// NewCitationGraph links every written content to the objects it references.
+// Authors are included as nodes with an edge to each of their works.
+func NewCitationGraph(w *DfWorld) *CitationGraph {
+	g := &CitationGraph{}
+	c := &Context{World: w, HfId: -1}
+	nodes := make(map[string]bool)
+	node := func(t string, id int, label func() string) string {
+		key := fmt.Sprintf("%s-%d", t, id)
+		if !nodes[key] {
+			nodes[key] = true
+			g.Nodes = append(g.Nodes, &CitationNode{Id: key, Type: t, Label: label()})
+		}
+		return key
+	}
+	wcLabel := func(id int) func() string {
+		return func() string {
+			if wc, ok := w.WrittenContents[id]; ok {
+				return util.Title(wc.Name())
+			}
+			return ""
+		}
+	}
+
+	ids := util.Keys(w.WrittenContents)
+	sort.Ints(ids)
+	for _, id := range ids {
+		wc := w.WrittenContents[id]
+		source := node("writtencontent", id, wcLabel(id))
+		if wc.AuthorHfid != -1 {
+			author := node("hf", wc.AuthorHfid, func() string { return util.PlainText(c.hf(wc.AuthorHfid)) })
+			g.Edges = append(g.Edges, &CitationEdge{Source: author, Target: source, Type: "wrote"})
+		}
+		for _, r := range wc.Reference {
+			t, ok := citationNodeTypes[r.Type_]
+			if !ok {
+				continue
+			}
+			var target string
+			if t == "writtencontent" {
+				target = node(t, r.Id_, wcLabel(r.Id_))
+			} else {
+				target = node(t, r.Id_, func() string { return util.PlainText(string(r.Html(c))) })
+			}
+			g.Edges = append(g.Edges, &CitationEdge{Source: source, Target: target, Type: "cites"})
+		}
+	}
+	return g
+}
+
+// Dot renders the graph in the graphviz format.
+func (g *CitationGraph) Dot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph citations {\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&sb, "  %q [label=%q, group=%q];\n", n.Id, n.Label, n.Type)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&sb, "  %q -> %q [label=%q];\n", e.Source, e.Target, e.Type)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
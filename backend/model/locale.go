@@ -0,0 +1,193 @@
+package model
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// Locale is a language of the interface and of the sentences of events and
+// collections. A locale is a json file in locales with the messages,
+// translations keyed by their english text, and the sentences as a text pack:
+//
+//	{
+//		"name": "de",
+//		"title": "Deutsch",
+//		"messages": {
+//			"Sites": "Orte",
+//			"%s of %d": "%s %d"
+//		},
+//		"events": {
+//			"hf died": "{{ hf .Hfid }} starb"
+//		},
+//		"collections": {}
+//	}
+//
+// Messages without translation and event types without sentence fall back to
+// english.
+type Locale struct {
+	Name     string
+	Title    string
+	Texts    *TextPack
+	messages map[string]string
+}
+
+type localeFile struct {
+	Name        string            `json:"name"`
+	Title       string            `json:"title"`
+	Messages    map[string]string `json:"messages"`
+	Events      map[string]string `json:"events"`
+	Collections map[string]string `json:"collections"`
+}
+
+// Locales are the shipped locales, english first.
+var Locales []*Locale
+
+// DefaultLocale is english, with the built-in sentences.
+var DefaultLocale *Locale
+
+func init() {
+	files, err := localeFS.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	for _, f := range files {
+		data, err := localeFS.ReadFile("locales/" + f.Name())
+		if err != nil {
+			panic(err)
+		}
+		l, err := ParseLocale(data)
+		if err != nil {
+			panic(fmt.Errorf("%s: %w", f.Name(), err))
+		}
+		Locales = append(Locales, l)
+	}
+	sort.Slice(Locales, func(i, j int) bool {
+		if Locales[i].Name == "en" || Locales[j].Name == "en" {
+			return Locales[i].Name == "en"
+		}
+		return Locales[i].Name < Locales[j].Name
+	})
+	DefaultLocale = Locales[0]
+}
+
+// ParseLocale parses a locale and its sentences.
+func ParseLocale(data []byte) (*Locale, error) {
+	var f localeFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("locale: %w", err)
+	}
+	l := &Locale{
+		Name:     f.Name,
+		Title:    f.Title,
+		messages: f.Messages,
+	}
+	if len(f.Events) > 0 || len(f.Collections) > 0 {
+		p, err := ParseTextPack(data)
+		if err != nil {
+			return nil, err
+		}
+		l.Texts = p
+	}
+	return l, nil
+}
+
+// FindLocale returns the shipped locale with the name, nil if there is none.
+func FindLocale(name string) *Locale {
+	for _, l := range Locales {
+		if l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// MatchLocale chooses the shipped locale preferred by an Accept-Language
+// header, like "de-DE,de;q=0.9,en;q=0.8", or english if none matches.
+func MatchLocale(header string) *Locale {
+	best, quality := DefaultLocale, 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if p := strings.TrimSpace(params); strings.HasPrefix(p, "q=") {
+			if v, err := strconv.ParseFloat(strings.TrimPrefix(p, "q="), 64); err == nil {
+				q = v
+			}
+		}
+		lang, _, _ := strings.Cut(tag, "-")
+		if l := FindLocale(strings.ToLower(lang)); l != nil && q > quality {
+			best, quality = l, q
+		}
+	}
+	return best
+}
+
+// T translates a message and formats it with the arguments, if there are
+// any. A nil locale is english.
+func (l *Locale) T(key string, args ...any) string {
+	s := key
+	if l != nil {
+		if m, ok := l.messages[key]; ok {
+			s = m
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(s, args...)
+	}
+	return s
+}
+
+// Has reports whether the locale translates a message.
+func (l *Locale) Has(key string) bool {
+	if l == nil {
+		return false
+	}
+	_, ok := l.messages[key]
+	return ok
+}
+
+// Time is the localized Time.
+func (l *Locale) Time(year, seconds int) string {
+	if year == -1 {
+		return l.T("a time before time")
+	}
+	if seconds == -1 {
+		return strconv.Itoa(year)
+	}
+	return l.T("%s of %d", l.Season(seconds), year)
+}
+
+// Season is the localized Season.
+func (l *Locale) Season(seconds int) string {
+	return l.T(Season(seconds))
+}
+
+// Ord is the ordinal in front of the name of a collection, empty for the
+// first one.
+func (l *Locale) Ord(ordinal int) string {
+	switch {
+	case ordinal == 1:
+		return ""
+	case ordinal == 2 || ordinal == 3:
+		return l.T(ord(ordinal))
+	case l.Has("%dth "):
+		return l.T("%dth ", ordinal)
+	}
+	return humanize.Ordinal(ordinal) + " "
+}
+
+// AndList joins a list like "a, b and c".
+func (l *Locale) AndList(list []string) string {
+	if len(list) > 1 {
+		return strings.Join(list[:len(list)-1], ", ") + " " + l.T("and") + " " + list[len(list)-1]
+	}
+	return strings.Join(list, ", ")
+}
@@ -0,0 +1,259 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// localeTestWorld is a small world for the objects referenced with the ids 0
+// and 1 by the details filled by fillDetails.
+func localeTestWorld() *DfWorld {
+	w := NewDfWorld()
+	for id, caste := range []string{"FEMALE", "MALE"} {
+		hf := NewHistoricalFigure()
+		hf.Id_ = id
+		hf.Name_ = fmt.Sprintf("figure %d", id)
+		hf.Race = "dwarf"
+		hf.Caste = caste
+		hf.Sex = id
+		hf.HfLink = append(hf.HfLink, &HfLink{Hfid: 1 - id, LinkType: HfLinkLinkType_Spouse})
+		w.HistoricalFigures[id] = hf
+
+		e := NewEntity()
+		e.Id_ = id
+		e.Name_ = fmt.Sprintf("entity %d", id)
+		e.Race = "dwarf"
+		p := NewEntityPosition()
+		p.Id_, p.Name_, p.NameFemale, p.NameMale = 0, "monarch", "queen", "king"
+		e.EntityPosition = append(e.EntityPosition, p)
+		h := NewHonor()
+		h.Id_, h.Name_ = 0, "hammer"
+		e.Honor = append(e.Honor, h)
+		s := NewSchedule()
+		s.Type_ = ScheduleType_Procession
+		s.Reference, s.Reference2 = 0, 1
+		f := NewFeature()
+		f.Type_ = FeatureType_Banners
+		s.Feature = append(s.Feature, f)
+		o := NewOccasion()
+		o.Id_, o.Name_, o.Schedule = 0, "the festival", []*Schedule{s, s}
+		e.Occasion = append(e.Occasion, o)
+		w.Entities[id] = e
+
+		site := NewSite()
+		site.Id_ = id
+		site.Name_ = fmt.Sprintf("site %d", id)
+		for sid := 0; sid < 2; sid++ {
+			st := NewStructure()
+			st.Id_, st.SiteId, st.Name_ = sid, id, fmt.Sprintf("structure %d", sid)
+			site.Structures[sid] = st
+		}
+		w.Sites[id] = site
+
+		r := NewRegion()
+		r.Id_, r.Name_ = id, fmt.Sprintf("region %d", id)
+		w.Regions[id] = r
+
+		a := NewArtifact()
+		a.Id_, a.Name_ = id, fmt.Sprintf("artifact %d", id)
+		w.Artifacts[id] = a
+	}
+	return w
+}
+
+// fillDetails sets every id to 0, every list to the ids 0 and 1, every enum to
+// its value v and every flag by the parity of v, so that the variants of a
+// type take the different branches of its sentence.
+func fillDetails(v reflect.Value, variant int) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if !f.CanSet() {
+			continue
+		}
+		switch f.Kind() {
+		case reflect.Int:
+			if f.Type().Name() == "int" {
+				f.SetInt(0)
+			} else {
+				f.SetInt(int64(variant))
+			}
+		case reflect.Bool:
+			f.SetBool(variant%2 == 1)
+		case reflect.String:
+			f.SetString("standard")
+		case reflect.Slice:
+			if f.Type().Elem().Kind() == reflect.Int {
+				f.Set(reflect.MakeSlice(f.Type(), 2, 2))
+				if f.Type().Elem().Name() == "int" {
+					f.Index(1).SetInt(1)
+				} else {
+					f.Index(0).SetInt(int64(variant))
+					f.Index(1).SetInt(int64(variant))
+				}
+			}
+		case reflect.Ptr:
+			if f.Type().Elem().Kind() == reflect.Struct {
+				f.Set(reflect.New(f.Type().Elem()))
+				fillDetails(f.Elem(), variant)
+			}
+		}
+	}
+}
+
+const localeTestVariants = 40
+
+func TestLocalesCoverAllTypes(t *testing.T) {
+	for _, l := range Locales {
+		if l == DefaultLocale {
+			continue
+		}
+		if l.Texts == nil {
+			t.Errorf("locale %s has no sentences", l.Name)
+			continue
+		}
+		for _, typ := range util.Keys(historicalEventTypes) {
+			if _, ok := l.Texts.events[typ]; !ok {
+				t.Errorf("locale %s has no sentence for event %q", l.Name, typ)
+			}
+		}
+		for _, typ := range util.Keys(historicalEventCollectionTypes) {
+			if _, ok := l.Texts.collections[typ]; !ok {
+				t.Errorf("locale %s has no sentence for collection %q", l.Name, typ)
+			}
+		}
+	}
+}
+
+func renderEvent(c *Context, d HistoricalEventDetails) (s string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	if c.Locale.Texts == nil {
+		return d.Html(c), nil
+	}
+	s, ok, err := c.Locale.Texts.event(c, d)
+	if !ok {
+		return "", fmt.Errorf("no sentence")
+	}
+	return s, err
+}
+
+func renderCollection(c *Context, e *HistoricalEventCollection) (s string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	if c.Locale.Texts == nil {
+		return e.Details.Html(e, c), nil
+	}
+	s, ok, err := c.Locale.Texts.collection(c, e)
+	if !ok {
+		return "", fmt.Errorf("no sentence")
+	}
+	return s, err
+}
+
+func TestLocalesRenderAllTypes(t *testing.T) {
+	w := localeTestWorld()
+	for _, l := range Locales {
+		c := &Context{World: w, HfId: -1, Locale: l}
+		for _, typ := range util.Keys(historicalEventTypes) {
+			for v := 0; v < localeTestVariants; v++ {
+				d := historicalEventTypes[typ]()
+				fillDetails(reflect.ValueOf(d).Elem(), v)
+				s, err := renderEvent(c, d)
+				if err != nil {
+					t.Errorf("locale %s, event %q, variant %d: %v", l.Name, typ, v, err)
+					break
+				}
+				if strings.TrimSpace(s) == "" {
+					t.Errorf("locale %s, event %q, variant %d: empty sentence", l.Name, typ, v)
+					break
+				}
+			}
+		}
+		for _, typ := range util.Keys(historicalEventCollectionTypes) {
+			for v := 0; v < localeTestVariants; v++ {
+				e := NewHistoricalEventCollection()
+				e.Details = historicalEventCollectionTypes[typ]()
+				fillDetails(reflect.ValueOf(e.Details).Elem(), v)
+				s, err := renderCollection(c, e)
+				if err != nil {
+					t.Errorf("locale %s, collection %q, variant %d: %v", l.Name, typ, v, err)
+					break
+				}
+				if strings.TrimSpace(s) == "" {
+					t.Errorf("locale %s, collection %q, variant %d: empty sentence", l.Name, typ, v)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestGenderedPositions(t *testing.T) {
+	w := localeTestWorld()
+	for _, l := range Locales {
+		c := &Context{World: w, HfId: -1, Locale: l}
+		for id, want := range []string{"queen", "king"} {
+			d := NewHistoricalEventAddHfEntityLink()
+			d.Hfid, d.CivId, d.PositionId = id, 0, 0
+			d.Link = HistoricalEventAddHfEntityLinkLink_Position
+			if s := c.EventHtml(d); !strings.Contains(s, want) {
+				t.Errorf("locale %s: %q does not contain %q", l.Name, s, want)
+			}
+		}
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	tests := []struct {
+		header, want string
+	}{
+		{"", "en"},
+		{"de", "de"},
+		{"de-DE,de;q=0.9,en;q=0.8", "de"},
+		{"en-US,en;q=0.9,de;q=0.8", "en"},
+		{"fr-FR,fr;q=0.9,de;q=0.5", "de"},
+		{"fr", "en"},
+		{"EN;q=0.2, DE;q=0.7", "de"},
+	}
+	for _, tt := range tests {
+		if got := MatchLocale(tt.header).Name; got != tt.want {
+			t.Errorf("MatchLocale(%q) = %s, want %s", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestLocaleT(t *testing.T) {
+	de := FindLocale("de")
+	if de == nil {
+		t.Fatal("no locale de")
+	}
+	if got := de.T("Year %d", 125); got != "Jahr 125" {
+		t.Errorf("T = %q", got)
+	}
+	if got := de.T("not translated %d", 1); got != "not translated 1" {
+		t.Errorf("T without message = %q", got)
+	}
+	var en *Locale
+	if got := en.T("Year %d", 125); got != "Year 125" {
+		t.Errorf("nil locale T = %q", got)
+	}
+	if got := de.Time(125, 0); got != "Frühlingsanfang 125" {
+		t.Errorf("Time = %q", got)
+	}
+	if got := de.Ord(5); got != "5. " {
+		t.Errorf("Ord = %q", got)
+	}
+	if got := de.AndList([]string{"a", "b", "c"}); got != "a, b und c" {
+		t.Errorf("AndList = %q", got)
+	}
+}
@@ -18,7 +18,7 @@ import (
 func (w *DfWorld) LoadMap() {
 	w.LoadDimensions()
 
-	fmt.Println("")
+	fmt.Fprintln(Log, "")
 
 	path := ""
 	files, err := filepath.Glob(strings.ReplaceAll(w.FilePath, "-legends.xml", "-world_map.*"))
@@ -31,27 +31,27 @@ func (w *DfWorld) LoadMap() {
 	}
 
 	if path == "" {
-		fmt.Println("no world map found")
+		fmt.Fprintln(Log, "no world map found")
 		return
 	}
 
 	mapImage, err := os.Open(path)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 		return
 	}
 
-	fmt.Println("found world map", path)
+	fmt.Fprintln(Log, "found world map", path)
 	img, format, err := image.Decode(mapImage)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 		return
 	}
-	fmt.Println("loaded world map imgage as", format)
+	fmt.Fprintln(Log, "loaded world map imgage as", format)
 	buf := new(bytes.Buffer)
 	err = png.Encode(buf, img)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 		return
 	}
 	w.MapData = buf.Bytes()
@@ -59,11 +59,11 @@ func (w *DfWorld) LoadMap() {
 }
 
 func (w *DfWorld) LoadDimensions() {
-	fmt.Println("")
+	fmt.Fprintln(Log, "")
 
 	files, err := filepath.Glob(filepath.Join(filepath.Dir(w.FilePath), "*-world_gen_param.txt"))
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 		return
 	}
 	path := ""
@@ -75,21 +75,25 @@ func (w *DfWorld) LoadDimensions() {
 		}
 	}
 	if path == "" {
-		fmt.Println("no worldgen params found")
+		fmt.Fprintln(Log, "no worldgen params found")
 		return
 	}
 
-	fmt.Println("found worldgen params", path)
+	fmt.Fprintln(Log, "found worldgen params", path)
 	content, err := ioutil.ReadFile(path)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 		return
 	}
 
+	if m := regexp.MustCompile(`\[END_YEAR:(\d+)\]`).FindStringSubmatch(string(content)); m != nil {
+		w.EndYear, _ = strconv.Atoi(m[1])
+	}
+
 	r := regexp.MustCompile(`\[DIM:(\d+):(\d+)\]`)
 	result := r.FindAllStringSubmatch(string(content), 1)
 	if result == nil {
-		fmt.Println("no world dimensions found")
+		fmt.Fprintln(Log, "no world dimensions found")
 		return
 	}
 	w.Width, _ = strconv.Atoi(result[0][2])
@@ -100,20 +104,6 @@ type Coord struct {
 	X, Y int
 }
 
-func Coords(s string) []Coord {
-	var coords []Coord
-	for _, c := range strings.Split(s, "|") {
-		if c == "" {
-			continue
-		}
-		d := strings.Split(c, ",")
-		x, _ := strconv.Atoi(d[0])
-		y, _ := strconv.Atoi(d[1])
-		coords = append(coords, Coord{X: x, Y: y})
-	}
-	return coords
-}
-
 func maxCoords(coords []Coord) Coord {
 	var max Coord
 	for _, c := range coords {
@@ -137,7 +127,7 @@ func (r *Region) Outline() []Coord {
 	// 	return cacheOutline;
 
 	/* draw the region in a matrix */
-	coords := Coords(r.Coords)
+	coords := r.Coordinates()
 	max := maxCoords(coords)
 
 	var region = make([][]bool, max.X+3)
@@ -209,5 +199,5 @@ Loop:
 }
 
 func (x *WorldConstruction) Line() []Coord {
-	return Coords(x.Coords)
+	return x.Coordinates()
 }
@@ -1166,46 +1166,46 @@ func (x *Artifact) Name() string { return x.Name_ }
 
 func (x *Artifact) CheckFields() {
 	if x.PageCount != x.AbsTileX {
-		sameFields["Artifact"]["PageCount"]["AbsTileX"] = false
+		fieldsDiffer("Artifact", "PageCount", "AbsTileX")
 	}
 	if x.PageCount != x.AbsTileY {
-		sameFields["Artifact"]["PageCount"]["AbsTileY"] = false
+		fieldsDiffer("Artifact", "PageCount", "AbsTileY")
 	}
 	if x.PageCount != x.AbsTileZ {
-		sameFields["Artifact"]["PageCount"]["AbsTileZ"] = false
+		fieldsDiffer("Artifact", "PageCount", "AbsTileZ")
 	}
 	if x.PageCount != x.HolderHfid {
-		sameFields["Artifact"]["PageCount"]["HolderHfid"] = false
+		fieldsDiffer("Artifact", "PageCount", "HolderHfid")
 	}
 	if x.PageCount != x.SiteId {
-		sameFields["Artifact"]["PageCount"]["SiteId"] = false
+		fieldsDiffer("Artifact", "PageCount", "SiteId")
 	}
 	if x.PageCount != x.StructureLocalId {
-		sameFields["Artifact"]["PageCount"]["StructureLocalId"] = false
+		fieldsDiffer("Artifact", "PageCount", "StructureLocalId")
 	}
 	if x.PageCount != x.SubregionId {
-		sameFields["Artifact"]["PageCount"]["SubregionId"] = false
+		fieldsDiffer("Artifact", "PageCount", "SubregionId")
 	}
 	if x.Writing != x.AbsTileX {
-		sameFields["Artifact"]["Writing"]["AbsTileX"] = false
+		fieldsDiffer("Artifact", "Writing", "AbsTileX")
 	}
 	if x.Writing != x.AbsTileY {
-		sameFields["Artifact"]["Writing"]["AbsTileY"] = false
+		fieldsDiffer("Artifact", "Writing", "AbsTileY")
 	}
 	if x.Writing != x.AbsTileZ {
-		sameFields["Artifact"]["Writing"]["AbsTileZ"] = false
+		fieldsDiffer("Artifact", "Writing", "AbsTileZ")
 	}
 	if x.Writing != x.HolderHfid {
-		sameFields["Artifact"]["Writing"]["HolderHfid"] = false
+		fieldsDiffer("Artifact", "Writing", "HolderHfid")
 	}
 	if x.Writing != x.SiteId {
-		sameFields["Artifact"]["Writing"]["SiteId"] = false
+		fieldsDiffer("Artifact", "Writing", "SiteId")
 	}
 	if x.Writing != x.StructureLocalId {
-		sameFields["Artifact"]["Writing"]["StructureLocalId"] = false
+		fieldsDiffer("Artifact", "Writing", "StructureLocalId")
 	}
 	if x.Writing != x.SubregionId {
-		sameFields["Artifact"]["Writing"]["SubregionId"] = false
+		fieldsDiffer("Artifact", "Writing", "SubregionId")
 	}
 }
 
@@ -1559,10 +1559,15 @@ type DfWorld struct {
 	EndYear                                int                                      `json:"endYear" legend:"add" related:""`                                 // EndYear
 	FilePath                               string                                   `json:"filePath" legend:"add" related:""`                                // FilePath
 	Height                                 int                                      `json:"height" legend:"add" related:""`                                  // Height
+	HfNames                                map[string]int                           `json:"hfNames" legend:"add" related:""`                                 // HfNames
+	HfValues                               *HfValues                                `json:"hfValues" legend:"add" related:""`                                // HfValues
+	InternedStrings                        int                                      `json:"internedStrings" legend:"add" related:""`                         // InternedStrings
 	MapData                                []byte                                   `json:"mapData" legend:"add" related:""`                                 // MapData
 	MapReady                               bool                                     `json:"mapReady" legend:"add" related:""`                                // MapReady
 	Plus                                   bool                                     `json:"plus" legend:"add" related:""`                                    // Plus
 	PlusFilePath                           string                                   `json:"plusFilePath" legend:"add" related:""`                            // PlusFilePath
+	Statistics                             *Statistics                              `json:"statistics" legend:"add" related:""`                              // Statistics
+	Store                                  *Store                                   `json:"store" legend:"add" related:""`                                   // Store
 	Width                                  int                                      `json:"width" legend:"add" related:""`                                   // Width
 }
 
@@ -1587,6 +1592,8 @@ func NewDfWorld() *DfWorld {
 		WrittenContents:            make(map[int]*WrittenContent),
 		EndYear:                    -1,
 		Height:                     -1,
+		HfNames:                    make(map[string]int),
+		InternedStrings:            -1,
 		Width:                      -1,
 	}
 }
@@ -1628,10 +1635,12 @@ func (x *DfWorld) MarshalJSON() ([]byte, error) {
 	if x.Height != -1 {
 		d["height"] = x.Height
 	}
+	d["hfValues"] = x.HfValues
 	d["mapData"] = x.MapData
 	d["mapReady"] = x.MapReady
 	d["plus"] = x.Plus
 	d["plusFilePath"] = x.PlusFilePath
+	d["statistics"] = x.Statistics
 	if x.Width != -1 {
 		d["width"] = x.Width
 	}
@@ -2771,6 +2780,17 @@ func NewHistoricalEvent() *HistoricalEvent {
 		Collection: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEvent() *HistoricalEvent {
+	x := a.historicalEvent.new()
+	*x = HistoricalEvent{
+		Id_:        -1,
+		Seconds72:  -1,
+		Year:       -1,
+		Collection: -1,
+	}
+	return x
+}
 func (x *HistoricalEvent) Id() int      { return x.Id_ }
 func (x *HistoricalEvent) setId(id int) { x.Id_ = id }
 
@@ -2808,6 +2828,16 @@ func NewHistoricalEventAddHfEntityHonor() *HistoricalEventAddHfEntityHonor {
 		HonorId:  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAddHfEntityHonor() *HistoricalEventAddHfEntityHonor {
+	x := a.historicalEventAddHfEntityHonor.new()
+	*x = HistoricalEventAddHfEntityHonor{
+		EntityId: -1,
+		Hfid:     -1,
+		HonorId:  -1,
+	}
+	return x
+}
 func (x *HistoricalEventAddHfEntityHonor) Type() string                           { return "add hf entity honor" }
 func (x *HistoricalEventAddHfEntityHonor) RelatedToEntity(id int) bool            { return x.EntityId == id }
 func (x *HistoricalEventAddHfEntityHonor) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -2963,6 +2993,18 @@ func NewHistoricalEventAddHfEntityLink() *HistoricalEventAddHfEntityLink {
 		PromiseToHfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAddHfEntityLink() *HistoricalEventAddHfEntityLink {
+	x := a.historicalEventAddHfEntityLink.new()
+	*x = HistoricalEventAddHfEntityLink{
+		AppointerHfid: -1,
+		CivId:         -1,
+		Hfid:          -1,
+		PositionId:    -1,
+		PromiseToHfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventAddHfEntityLink) Type() string                { return "add hf entity link" }
 func (x *HistoricalEventAddHfEntityLink) RelatedToEntity(id int) bool { return x.CivId == id }
 func (x *HistoricalEventAddHfEntityLink) RelatedToHf(id int) bool {
@@ -3082,6 +3124,15 @@ func NewHistoricalEventAddHfHfLink() *HistoricalEventAddHfHfLink {
 		HfidTarget: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAddHfHfLink() *HistoricalEventAddHfHfLink {
+	x := a.historicalEventAddHfHfLink.new()
+	*x = HistoricalEventAddHfHfLink{
+		Hfid:       -1,
+		HfidTarget: -1,
+	}
+	return x
+}
 func (x *HistoricalEventAddHfHfLink) Type() string                { return "add hf hf link" }
 func (x *HistoricalEventAddHfHfLink) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventAddHfHfLink) RelatedToHf(id int) bool {
@@ -3180,6 +3231,17 @@ func NewHistoricalEventAddHfSiteLink() *HistoricalEventAddHfSiteLink {
 		Structure: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAddHfSiteLink() *HistoricalEventAddHfSiteLink {
+	x := a.historicalEventAddHfSiteLink.new()
+	*x = HistoricalEventAddHfSiteLink{
+		Civ:       -1,
+		Histfig:   -1,
+		SiteId:    -1,
+		Structure: -1,
+	}
+	return x
+}
 func (x *HistoricalEventAddHfSiteLink) Type() string                  { return "add hf site link" }
 func (x *HistoricalEventAddHfSiteLink) RelatedToEntity(id int) bool   { return x.Civ == id }
 func (x *HistoricalEventAddHfSiteLink) RelatedToHf(id int) bool       { return x.Histfig == id }
@@ -3199,13 +3261,13 @@ func (x *HistoricalEventAddHfSiteLink) RelatedToIdentity(id int) bool          {
 
 func (x *HistoricalEventAddHfSiteLink) CheckFields() {
 	if x.Civ != x.SiteId {
-		sameFields["HistoricalEventAddHfSiteLink"]["Civ"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAddHfSiteLink", "Civ", "SiteId")
 	}
 	if x.Histfig != x.SiteId {
-		sameFields["HistoricalEventAddHfSiteLink"]["Histfig"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAddHfSiteLink", "Histfig", "SiteId")
 	}
 	if x.Structure != x.SiteId {
-		sameFields["HistoricalEventAddHfSiteLink"]["Structure"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAddHfSiteLink", "Structure", "SiteId")
 	}
 }
 
@@ -3272,6 +3334,17 @@ func NewHistoricalEventAgreementConcluded() *HistoricalEventAgreementConcluded {
 		Source:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAgreementConcluded() *HistoricalEventAgreementConcluded {
+	x := a.historicalEventAgreementConcluded.new()
+	*x = HistoricalEventAgreementConcluded{
+		Destination: -1,
+		Result:      -1,
+		Site:        -1,
+		Source:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventAgreementConcluded) Type() string { return "agreement concluded" }
 func (x *HistoricalEventAgreementConcluded) RelatedToEntity(id int) bool {
 	return x.Destination == id || x.Source == id
@@ -3648,6 +3721,27 @@ func NewHistoricalEventAgreementFormed() *HistoricalEventAgreementFormed {
 		TopValueRating:            -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAgreementFormed() *HistoricalEventAgreementFormed {
+	x := a.historicalEventAgreementFormed.new()
+	*x = HistoricalEventAgreementFormed{
+		AgreementId:               -1,
+		AgreementSubjectId:        -1,
+		AllyDefenseBonus:          -1,
+		CoconspiratorBonus:        -1,
+		ConcluderHfid:             -1,
+		RelevantEntityId:          -1,
+		RelevantIdForMethod:       -1,
+		RelevantPositionProfileId: -1,
+		TopFacetModifier:          -1,
+		TopFacetRating:            -1,
+		TopRelationshipModifier:   -1,
+		TopRelationshipRating:     -1,
+		TopValueModifier:          -1,
+		TopValueRating:            -1,
+	}
+	return x
+}
 func (x *HistoricalEventAgreementFormed) Type() string { return "agreement formed" }
 func (x *HistoricalEventAgreementFormed) RelatedToEntity(id int) bool {
 	return x.RelevantEntityId == id
@@ -3787,6 +3881,16 @@ func NewHistoricalEventAgreementMade() *HistoricalEventAgreementMade {
 		Source:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAgreementMade() *HistoricalEventAgreementMade {
+	x := a.historicalEventAgreementMade.new()
+	*x = HistoricalEventAgreementMade{
+		Destination: -1,
+		SiteId:      -1,
+		Source:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventAgreementMade) Type() string { return "agreement made" }
 func (x *HistoricalEventAgreementMade) RelatedToEntity(id int) bool {
 	return x.Destination == id || x.Source == id
@@ -3806,10 +3910,10 @@ func (x *HistoricalEventAgreementMade) RelatedToIdentity(id int) bool          {
 
 func (x *HistoricalEventAgreementMade) CheckFields() {
 	if x.Destination != x.SiteId {
-		sameFields["HistoricalEventAgreementMade"]["Destination"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAgreementMade", "Destination", "SiteId")
 	}
 	if x.Source != x.SiteId {
-		sameFields["HistoricalEventAgreementMade"]["Source"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAgreementMade", "Source", "SiteId")
 	}
 }
 
@@ -3886,6 +3990,16 @@ func NewHistoricalEventAgreementRejected() *HistoricalEventAgreementRejected {
 		Source:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAgreementRejected() *HistoricalEventAgreementRejected {
+	x := a.historicalEventAgreementRejected.new()
+	*x = HistoricalEventAgreementRejected{
+		Destination: -1,
+		SiteId:      -1,
+		Source:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventAgreementRejected) Type() string { return "agreement rejected" }
 func (x *HistoricalEventAgreementRejected) RelatedToEntity(id int) bool {
 	return x.Destination == id || x.Source == id
@@ -3905,10 +4019,10 @@ func (x *HistoricalEventAgreementRejected) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventAgreementRejected) CheckFields() {
 	if x.Destination != x.SiteId {
-		sameFields["HistoricalEventAgreementRejected"]["Destination"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAgreementRejected", "Destination", "SiteId")
 	}
 	if x.Source != x.SiteId {
-		sameFields["HistoricalEventAgreementRejected"]["Source"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventAgreementRejected", "Source", "SiteId")
 	}
 }
 
@@ -4015,6 +4129,17 @@ func NewHistoricalEventArtifactClaimFormed() *HistoricalEventArtifactClaimFormed
 		PositionProfileId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactClaimFormed() *HistoricalEventArtifactClaimFormed {
+	x := a.historicalEventArtifactClaimFormed.new()
+	*x = HistoricalEventArtifactClaimFormed{
+		ArtifactId:        -1,
+		EntityId:          -1,
+		HistFigureId:      -1,
+		PositionProfileId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactClaimFormed) Type() string                { return "artifact claim formed" }
 func (x *HistoricalEventArtifactClaimFormed) RelatedToEntity(id int) bool { return x.EntityId == id }
 func (x *HistoricalEventArtifactClaimFormed) RelatedToHf(id int) bool     { return x.HistFigureId == id }
@@ -4080,6 +4205,20 @@ func NewHistoricalEventArtifactCopied() *HistoricalEventArtifactCopied {
 		SourceStructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactCopied() *HistoricalEventArtifactCopied {
+	x := a.historicalEventArtifactCopied.new()
+	*x = HistoricalEventArtifactCopied{
+		ArtifactId:        -1,
+		DestEntityId:      -1,
+		DestSiteId:        -1,
+		DestStructureId:   -1,
+		SourceEntityId:    -1,
+		SourceSiteId:      -1,
+		SourceStructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactCopied) Type() string { return "artifact copied" }
 func (x *HistoricalEventArtifactCopied) RelatedToEntity(id int) bool {
 	return x.DestEntityId == id || x.SourceEntityId == id
@@ -4295,6 +4434,19 @@ func NewHistoricalEventArtifactCreated() *HistoricalEventArtifactCreated {
 		UnitId:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactCreated() *HistoricalEventArtifactCreated {
+	x := a.historicalEventArtifactCreated.new()
+	*x = HistoricalEventArtifactCreated{
+		ArtifactId:   -1,
+		EntityId:     -1,
+		HistFigureId: -1,
+		SanctifyHf:   -1,
+		SiteId:       -1,
+		UnitId:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactCreated) Type() string                { return "artifact created" }
 func (x *HistoricalEventArtifactCreated) RelatedToEntity(id int) bool { return x.EntityId == id }
 func (x *HistoricalEventArtifactCreated) RelatedToHf(id int) bool {
@@ -4314,16 +4466,16 @@ func (x *HistoricalEventArtifactCreated) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventArtifactCreated) CheckFields() {
 	if x.SanctifyHf != x.EntityId {
-		sameFields["HistoricalEventArtifactCreated"]["SanctifyHf"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventArtifactCreated", "SanctifyHf", "EntityId")
 	}
 	if x.SanctifyHf != x.HistFigureId {
-		sameFields["HistoricalEventArtifactCreated"]["SanctifyHf"]["HistFigureId"] = false
+		fieldsDiffer("HistoricalEventArtifactCreated", "SanctifyHf", "HistFigureId")
 	}
 	if x.SanctifyHf != x.SiteId {
-		sameFields["HistoricalEventArtifactCreated"]["SanctifyHf"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventArtifactCreated", "SanctifyHf", "SiteId")
 	}
 	if x.SanctifyHf != x.UnitId {
-		sameFields["HistoricalEventArtifactCreated"]["SanctifyHf"]["UnitId"] = false
+		fieldsDiffer("HistoricalEventArtifactCreated", "SanctifyHf", "UnitId")
 	}
 }
 
@@ -4413,6 +4565,14 @@ func NewHistoricalEventArtifactCreatedCircumstance() *HistoricalEventArtifactCre
 	}
 }
 
+func (a *arenas) newHistoricalEventArtifactCreatedCircumstance() *HistoricalEventArtifactCreatedCircumstance {
+	x := a.historicalEventArtifactCreatedCircumstance.new()
+	*x = HistoricalEventArtifactCreatedCircumstance{
+		Defeated: -1,
+	}
+	return x
+}
+
 func (x *HistoricalEventArtifactCreatedCircumstance) CheckFields() {
 }
 
@@ -4440,6 +4600,16 @@ func NewHistoricalEventArtifactDestroyed() *HistoricalEventArtifactDestroyed {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactDestroyed() *HistoricalEventArtifactDestroyed {
+	x := a.historicalEventArtifactDestroyed.new()
+	*x = HistoricalEventArtifactDestroyed{
+		ArtifactId:    -1,
+		DestroyerEnid: -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactDestroyed) Type() string                           { return "artifact destroyed" }
 func (x *HistoricalEventArtifactDestroyed) RelatedToEntity(id int) bool            { return x.DestroyerEnid == id }
 func (x *HistoricalEventArtifactDestroyed) RelatedToHf(id int) bool                { return false }
@@ -4489,6 +4659,18 @@ func NewHistoricalEventArtifactFound() *HistoricalEventArtifactFound {
 		UnitId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactFound() *HistoricalEventArtifactFound {
+	x := a.historicalEventArtifactFound.new()
+	*x = HistoricalEventArtifactFound{
+		ArtifactId:     -1,
+		HistFigureId:   -1,
+		SiteId:         -1,
+		SitePropertyId: -1,
+		UnitId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactFound) Type() string                           { return "artifact found" }
 func (x *HistoricalEventArtifactFound) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventArtifactFound) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -4578,6 +4760,18 @@ func NewHistoricalEventArtifactGiven() *HistoricalEventArtifactGiven {
 		ReceiverHistFigureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactGiven() *HistoricalEventArtifactGiven {
+	x := a.historicalEventArtifactGiven.new()
+	*x = HistoricalEventArtifactGiven{
+		ArtifactId:           -1,
+		GiverEntityId:        -1,
+		GiverHistFigureId:    -1,
+		ReceiverEntityId:     -1,
+		ReceiverHistFigureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactGiven) Type() string { return "artifact given" }
 func (x *HistoricalEventArtifactGiven) RelatedToEntity(id int) bool {
 	return x.GiverEntityId == id || x.ReceiverEntityId == id
@@ -4641,6 +4835,18 @@ func NewHistoricalEventArtifactLost() *HistoricalEventArtifactLost {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactLost() *HistoricalEventArtifactLost {
+	x := a.historicalEventArtifactLost.new()
+	*x = HistoricalEventArtifactLost{
+		ArtifactId:     -1,
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		SitePropertyId: -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactLost) Type() string                           { return "artifact lost" }
 func (x *HistoricalEventArtifactLost) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventArtifactLost) RelatedToHf(id int) bool                { return false }
@@ -4763,6 +4969,21 @@ func NewHistoricalEventArtifactPossessed() *HistoricalEventArtifactPossessed {
 		UnitId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactPossessed() *HistoricalEventArtifactPossessed {
+	x := a.historicalEventArtifactPossessed.new()
+	*x = HistoricalEventArtifactPossessed{
+		ArtifactId:     -1,
+		CircumstanceId: -1,
+		FeatureLayerId: -1,
+		HistFigureId:   -1,
+		ReasonId:       -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+		UnitId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactPossessed) Type() string                           { return "artifact possessed" }
 func (x *HistoricalEventArtifactPossessed) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventArtifactPossessed) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -4837,6 +5058,20 @@ func NewHistoricalEventArtifactRecovered() *HistoricalEventArtifactRecovered {
 		UnitId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactRecovered() *HistoricalEventArtifactRecovered {
+	x := a.historicalEventArtifactRecovered.new()
+	*x = HistoricalEventArtifactRecovered{
+		ArtifactId:     -1,
+		FeatureLayerId: -1,
+		HistFigureId:   -1,
+		SiteId:         -1,
+		StructureId:    -1,
+		SubregionId:    -1,
+		UnitId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactRecovered) Type() string                  { return "artifact recovered" }
 func (x *HistoricalEventArtifactRecovered) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventArtifactRecovered) RelatedToHf(id int) bool       { return x.HistFigureId == id }
@@ -4898,6 +5133,17 @@ func NewHistoricalEventArtifactStored() *HistoricalEventArtifactStored {
 		UnitId:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactStored() *HistoricalEventArtifactStored {
+	x := a.historicalEventArtifactStored.new()
+	*x = HistoricalEventArtifactStored{
+		ArtifactId:   -1,
+		HistFigureId: -1,
+		SiteId:       -1,
+		UnitId:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactStored) Type() string                           { return "artifact stored" }
 func (x *HistoricalEventArtifactStored) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventArtifactStored) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -4950,6 +5196,18 @@ func NewHistoricalEventArtifactTransformed() *HistoricalEventArtifactTransformed
 		UnitId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventArtifactTransformed() *HistoricalEventArtifactTransformed {
+	x := a.historicalEventArtifactTransformed.new()
+	*x = HistoricalEventArtifactTransformed{
+		HistFigureId:  -1,
+		NewArtifactId: -1,
+		OldArtifactId: -1,
+		SiteId:        -1,
+		UnitId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventArtifactTransformed) Type() string                { return "artifact transformed" }
 func (x *HistoricalEventArtifactTransformed) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventArtifactTransformed) RelatedToHf(id int) bool     { return x.HistFigureId == id }
@@ -5008,6 +5266,17 @@ func NewHistoricalEventAssumeIdentity() *HistoricalEventAssumeIdentity {
 		TricksterHfid:     -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAssumeIdentity() *HistoricalEventAssumeIdentity {
+	x := a.historicalEventAssumeIdentity.new()
+	*x = HistoricalEventAssumeIdentity{
+		IdentityId:        -1,
+		IdentityNemesisId: -1,
+		TargetEnid:        -1,
+		TricksterHfid:     -1,
+	}
+	return x
+}
 func (x *HistoricalEventAssumeIdentity) Type() string                           { return "assume identity" }
 func (x *HistoricalEventAssumeIdentity) RelatedToEntity(id int) bool            { return x.TargetEnid == id }
 func (x *HistoricalEventAssumeIdentity) RelatedToHf(id int) bool                { return x.TricksterHfid == id }
@@ -5027,13 +5296,13 @@ func (x *HistoricalEventAssumeIdentity) RelatedToIdentity(id int) bool {
 
 func (x *HistoricalEventAssumeIdentity) CheckFields() {
 	if x.IdentityNemesisId != x.IdentityId {
-		sameFields["HistoricalEventAssumeIdentity"]["IdentityNemesisId"]["IdentityId"] = false
+		fieldsDiffer("HistoricalEventAssumeIdentity", "IdentityNemesisId", "IdentityId")
 	}
 	if x.IdentityNemesisId != x.TargetEnid {
-		sameFields["HistoricalEventAssumeIdentity"]["IdentityNemesisId"]["TargetEnid"] = false
+		fieldsDiffer("HistoricalEventAssumeIdentity", "IdentityNemesisId", "TargetEnid")
 	}
 	if x.IdentityNemesisId != x.TricksterHfid {
-		sameFields["HistoricalEventAssumeIdentity"]["IdentityNemesisId"]["TricksterHfid"] = false
+		fieldsDiffer("HistoricalEventAssumeIdentity", "IdentityNemesisId", "TricksterHfid")
 	}
 }
 
@@ -5084,6 +5353,23 @@ func NewHistoricalEventAttackedSite() *HistoricalEventAttackedSite {
 		SiteId:              -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventAttackedSite() *HistoricalEventAttackedSite {
+	x := a.historicalEventAttackedSite.new()
+	*x = HistoricalEventAttackedSite{
+		ASupportMercEnid:    -1,
+		AttackerCivId:       -1,
+		AttackerGeneralHfid: -1,
+		AttackerMercEnid:    -1,
+		DSupportMercEnid:    -1,
+		DefenderCivId:       -1,
+		DefenderGeneralHfid: -1,
+		DefenderMercEnid:    -1,
+		SiteCivId:           -1,
+		SiteId:              -1,
+	}
+	return x
+}
 func (x *HistoricalEventAttackedSite) Type() string { return "attacked site" }
 func (x *HistoricalEventAttackedSite) RelatedToEntity(id int) bool {
 	return x.ASupportMercEnid == id || x.AttackerCivId == id || x.AttackerMercEnid == id || x.DSupportMercEnid == id || x.DefenderCivId == id || x.DefenderMercEnid == id || x.SiteCivId == id
@@ -5290,10 +5576,11 @@ func (s HistoricalEventBodyAbusedPileType) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventBodyAbused struct {
-	AbuseType      HistoricalEventBodyAbusedAbuseType   `json:"abuseType" legend:"plus" related:""`      // abuse_type
-	Bodies         []int                                `json:"bodies" legend:"plus" related:""`         // bodies
-	Civ            int                                  `json:"civ" legend:"plus" related:""`            // civ
-	Coords         string                               `json:"coords" legend:"base" related:""`         // coords
+	AbuseType      HistoricalEventBodyAbusedAbuseType `json:"abuseType" legend:"plus" related:""` // abuse_type
+	Bodies         []int                              `json:"bodies" legend:"plus" related:""`    // bodies
+	Civ            int                                `json:"civ" legend:"plus" related:""`       // civ
+	Coords         string                             `json:"coords" legend:"base" related:""`    // coords
+	coords         []Coord
 	FeatureLayerId int                                  `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
 	Histfig        int                                  `json:"histfig" legend:"plus" related:""`        // histfig
 	Interaction    int                                  `json:"interaction" legend:"plus" related:""`    // interaction
@@ -5321,7 +5608,24 @@ func NewHistoricalEventBodyAbused() *HistoricalEventBodyAbused {
 		VictimEntity:   -1,
 	}
 }
-func (x *HistoricalEventBodyAbused) Type() string { return "body abused" }
+
+func (a *arenas) newHistoricalEventBodyAbused() *HistoricalEventBodyAbused {
+	x := a.historicalEventBodyAbused.new()
+	*x = HistoricalEventBodyAbused{
+		Civ:            -1,
+		FeatureLayerId: -1,
+		Histfig:        -1,
+		Interaction:    -1,
+		SiteId:         -1,
+		Structure:      -1,
+		SubregionId:    -1,
+		Tree:           -1,
+		VictimEntity:   -1,
+	}
+	return x
+}
+func (x *HistoricalEventBodyAbused) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
+func (x *HistoricalEventBodyAbused) Type() string         { return "body abused" }
 func (x *HistoricalEventBodyAbused) RelatedToEntity(id int) bool {
 	return x.Civ == id || x.VictimEntity == id
 }
@@ -5344,61 +5648,61 @@ func (x *HistoricalEventBodyAbused) RelatedToIdentity(id int) bool          { re
 
 func (x *HistoricalEventBodyAbused) CheckFields() {
 	if x.Civ != x.FeatureLayerId {
-		sameFields["HistoricalEventBodyAbused"]["Civ"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Civ", "FeatureLayerId")
 	}
 	if x.Civ != x.SiteId {
-		sameFields["HistoricalEventBodyAbused"]["Civ"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Civ", "SiteId")
 	}
 	if x.Civ != x.SubregionId {
-		sameFields["HistoricalEventBodyAbused"]["Civ"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Civ", "SubregionId")
 	}
 	if x.Histfig != x.FeatureLayerId {
-		sameFields["HistoricalEventBodyAbused"]["Histfig"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Histfig", "FeatureLayerId")
 	}
 	if x.Histfig != x.SiteId {
-		sameFields["HistoricalEventBodyAbused"]["Histfig"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Histfig", "SiteId")
 	}
 	if x.Histfig != x.SubregionId {
-		sameFields["HistoricalEventBodyAbused"]["Histfig"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Histfig", "SubregionId")
 	}
 	if x.Interaction != x.FeatureLayerId {
-		sameFields["HistoricalEventBodyAbused"]["Interaction"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Interaction", "FeatureLayerId")
 	}
 	if x.Interaction != x.SiteId {
-		sameFields["HistoricalEventBodyAbused"]["Interaction"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Interaction", "SiteId")
 	}
 	if x.Interaction != x.SubregionId {
-		sameFields["HistoricalEventBodyAbused"]["Interaction"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Interaction", "SubregionId")
 	}
 	if x.ItemMat != x.Coords && x.ItemMat != "" && x.Coords != "" {
-		sameFields["HistoricalEventBodyAbused"]["ItemMat"]["Coords"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "ItemMat", "Coords")
 	}
 	if x.Structure != x.FeatureLayerId {
-		sameFields["HistoricalEventBodyAbused"]["Structure"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Structure", "FeatureLayerId")
 	}
 	if x.Structure != x.SiteId {
-		sameFields["HistoricalEventBodyAbused"]["Structure"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Structure", "SiteId")
 	}
 	if x.Structure != x.SubregionId {
-		sameFields["HistoricalEventBodyAbused"]["Structure"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Structure", "SubregionId")
 	}
 	if x.Tree != x.FeatureLayerId {
-		sameFields["HistoricalEventBodyAbused"]["Tree"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Tree", "FeatureLayerId")
 	}
 	if x.Tree != x.SiteId {
-		sameFields["HistoricalEventBodyAbused"]["Tree"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Tree", "SiteId")
 	}
 	if x.Tree != x.SubregionId {
-		sameFields["HistoricalEventBodyAbused"]["Tree"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "Tree", "SubregionId")
 	}
 	if x.VictimEntity != x.FeatureLayerId {
-		sameFields["HistoricalEventBodyAbused"]["VictimEntity"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "VictimEntity", "FeatureLayerId")
 	}
 	if x.VictimEntity != x.SiteId {
-		sameFields["HistoricalEventBodyAbused"]["VictimEntity"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "VictimEntity", "SiteId")
 	}
 	if x.VictimEntity != x.SubregionId {
-		sameFields["HistoricalEventBodyAbused"]["VictimEntity"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventBodyAbused", "VictimEntity", "SubregionId")
 	}
 }
 
@@ -5471,6 +5775,19 @@ func NewHistoricalEventBuildingProfileAcquired() *HistoricalEventBuildingProfile
 		StructureId:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventBuildingProfileAcquired() *HistoricalEventBuildingProfileAcquired {
+	x := a.historicalEventBuildingProfileAcquired.new()
+	*x = HistoricalEventBuildingProfileAcquired{
+		AcquirerEnid:      -1,
+		AcquirerHfid:      -1,
+		BuildingProfileId: -1,
+		LastOwnerHfid:     -1,
+		SiteId:            -1,
+		StructureId:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventBuildingProfileAcquired) Type() string { return "building profile acquired" }
 func (x *HistoricalEventBuildingProfileAcquired) RelatedToEntity(id int) bool {
 	return x.AcquirerEnid == id
@@ -5542,6 +5859,19 @@ func NewHistoricalEventCeremony() *HistoricalEventCeremony {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCeremony() *HistoricalEventCeremony {
+	x := a.historicalEventCeremony.new()
+	*x = HistoricalEventCeremony{
+		CivId:          -1,
+		FeatureLayerId: -1,
+		OccasionId:     -1,
+		ScheduleId:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventCeremony) Type() string                           { return "ceremony" }
 func (x *HistoricalEventCeremony) RelatedToEntity(id int) bool            { return x.CivId == id }
 func (x *HistoricalEventCeremony) RelatedToHf(id int) bool                { return false }
@@ -5611,13 +5941,14 @@ func (s HistoricalEventChangeHfBodyStateBodyState) MarshalJSON() ([]byte, error)
 }
 
 type HistoricalEventChangeHfBodyState struct {
-	BodyState      HistoricalEventChangeHfBodyStateBodyState `json:"bodyState" legend:"base" related:""`      // body_state
-	Coords         string                                    `json:"coords" legend:"base" related:""`         // coords
-	FeatureLayerId int                                       `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	Hfid           int                                       `json:"hfid" legend:"base" related:""`           // hfid
-	SiteId         int                                       `json:"siteId" legend:"base" related:""`         // site_id
-	StructureId    int                                       `json:"structureId" legend:"base" related:""`    // structure_id
-	SubregionId    int                                       `json:"subregionId" legend:"base" related:""`    // subregion_id
+	BodyState      HistoricalEventChangeHfBodyStateBodyState `json:"bodyState" legend:"base" related:""` // body_state
+	Coords         string                                    `json:"coords" legend:"base" related:""`    // coords
+	coords         []Coord
+	FeatureLayerId int `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	Hfid           int `json:"hfid" legend:"base" related:""`           // hfid
+	SiteId         int `json:"siteId" legend:"base" related:""`         // site_id
+	StructureId    int `json:"structureId" legend:"base" related:""`    // structure_id
+	SubregionId    int `json:"subregionId" legend:"base" related:""`    // subregion_id
 }
 
 func NewHistoricalEventChangeHfBodyState() *HistoricalEventChangeHfBodyState {
@@ -5629,6 +5960,21 @@ func NewHistoricalEventChangeHfBodyState() *HistoricalEventChangeHfBodyState {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventChangeHfBodyState() *HistoricalEventChangeHfBodyState {
+	x := a.historicalEventChangeHfBodyState.new()
+	*x = HistoricalEventChangeHfBodyState{
+		FeatureLayerId: -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		StructureId:    -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventChangeHfBodyState) Coordinates() []Coord {
+	return coordinates(x.coords, x.Coords)
+}
 func (x *HistoricalEventChangeHfBodyState) Type() string                  { return "change hf body state" }
 func (x *HistoricalEventChangeHfBodyState) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventChangeHfBodyState) RelatedToHf(id int) bool       { return x.Hfid == id }
@@ -5690,6 +6036,17 @@ func NewHistoricalEventChangeHfJob() *HistoricalEventChangeHfJob {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventChangeHfJob() *HistoricalEventChangeHfJob {
+	x := a.historicalEventChangeHfJob.new()
+	*x = HistoricalEventChangeHfJob{
+		FeatureLayerId: -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventChangeHfJob) Type() string                           { return "change hf job" }
 func (x *HistoricalEventChangeHfJob) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventChangeHfJob) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -5940,7 +6297,8 @@ func (s HistoricalEventChangeHfStateState) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventChangeHfState struct {
-	Coords         string                             `json:"coords" legend:"base" related:""`         // coords
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
 	FeatureLayerId int                                `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
 	Hfid           int                                `json:"hfid" legend:"both" related:""`           // hfid
 	Mood           HistoricalEventChangeHfStateMood   `json:"mood" legend:"base" related:""`           // mood
@@ -5958,6 +6316,18 @@ func NewHistoricalEventChangeHfState() *HistoricalEventChangeHfState {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventChangeHfState() *HistoricalEventChangeHfState {
+	x := a.historicalEventChangeHfState.new()
+	*x = HistoricalEventChangeHfState{
+		FeatureLayerId: -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventChangeHfState) Coordinates() []Coord                   { return coordinates(x.coords, x.Coords) }
 func (x *HistoricalEventChangeHfState) Type() string                           { return "change hf state" }
 func (x *HistoricalEventChangeHfState) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventChangeHfState) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -6018,6 +6388,15 @@ func NewHistoricalEventChangedCreatureType() *HistoricalEventChangedCreatureType
 		ChangerHfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventChangedCreatureType() *HistoricalEventChangedCreatureType {
+	x := a.historicalEventChangedCreatureType.new()
+	*x = HistoricalEventChangedCreatureType{
+		ChangeeHfid: -1,
+		ChangerHfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventChangedCreatureType) Type() string                { return "changed creature type" }
 func (x *HistoricalEventChangedCreatureType) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventChangedCreatureType) RelatedToHf(id int) bool {
@@ -6185,6 +6564,18 @@ func NewHistoricalEventCollection() *HistoricalEventCollection {
 		StartYear:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollection() *HistoricalEventCollection {
+	x := a.historicalEventCollection.new()
+	*x = HistoricalEventCollection{
+		EndSeconds72:   -1,
+		EndYear:        -1,
+		Id_:            -1,
+		StartSeconds72: -1,
+		StartYear:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollection) Id() int      { return x.Id_ }
 func (x *HistoricalEventCollection) setId(id int) { x.Id_ = id }
 
@@ -6215,15 +6606,16 @@ func (x *HistoricalEventCollection) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventCollectionAbduction struct {
-	AttackingEnid  int    `json:"attackingEnid" legend:"base" related:""`  // attacking_enid
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
-	DefendingEnid  int    `json:"defendingEnid" legend:"base" related:""`  // defending_enid
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	Ordinal        int    `json:"ordinal" legend:"base" related:""`        // ordinal
-	ParentEventcol int    `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
-	SiteId         int    `json:"siteId" legend:"base" related:""`         // site_id
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`    // subregion_id
-	TargetHfids    []int  `json:"targetHfids" legend:"add" related:""`     // TargetHfids
+	AttackingEnid  int    `json:"attackingEnid" legend:"base" related:""` // attacking_enid
+	Coords         string `json:"coords" legend:"base" related:""`        // coords
+	coords         []Coord
+	DefendingEnid  int   `json:"defendingEnid" legend:"base" related:""`  // defending_enid
+	FeatureLayerId int   `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	Ordinal        int   `json:"ordinal" legend:"base" related:""`        // ordinal
+	ParentEventcol int   `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
+	SiteId         int   `json:"siteId" legend:"base" related:""`         // site_id
+	SubregionId    int   `json:"subregionId" legend:"base" related:""`    // subregion_id
+	TargetHfids    []int `json:"targetHfids" legend:"add" related:""`     // TargetHfids
 }
 
 func NewHistoricalEventCollectionAbduction() *HistoricalEventCollectionAbduction {
@@ -6237,6 +6629,23 @@ func NewHistoricalEventCollectionAbduction() *HistoricalEventCollectionAbduction
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionAbduction() *HistoricalEventCollectionAbduction {
+	x := a.historicalEventCollectionAbduction.new()
+	*x = HistoricalEventCollectionAbduction{
+		AttackingEnid:  -1,
+		DefendingEnid:  -1,
+		FeatureLayerId: -1,
+		Ordinal:        -1,
+		ParentEventcol: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventCollectionAbduction) Coordinates() []Coord {
+	return coordinates(x.coords, x.Coords)
+}
 func (x *HistoricalEventCollectionAbduction) Type() string { return "abduction" }
 func (x *HistoricalEventCollectionAbduction) RelatedToEntity(id int) bool {
 	return x.AttackingEnid == id || x.DefendingEnid == id
@@ -6318,18 +6727,19 @@ func (s HistoricalEventCollectionBattleOutcome) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventCollectionBattle struct {
-	ASupportMercEnid        int                                    `json:"aSupportMercEnid" legend:"base" related:""`        // a_support_merc_enid
-	ASupportMercHfid        []int                                  `json:"aSupportMercHfid" legend:"base" related:""`        // a_support_merc_hfid
-	AttackingHfid           []int                                  `json:"attackingHfid" legend:"base" related:""`           // attacking_hfid
-	AttackingMercEnid       int                                    `json:"attackingMercEnid" legend:"base" related:""`       // attacking_merc_enid
-	AttackingSquadAnimated  []bool                                 `json:"attackingSquadAnimated" legend:"base" related:""`  // attacking_squad_animated
-	AttackingSquadDeaths    []int                                  `json:"attackingSquadDeaths" legend:"base" related:""`    // attacking_squad_deaths
-	AttackingSquadEntityPop []int                                  `json:"attackingSquadEntityPop" legend:"base" related:""` // attacking_squad_entity_pop
-	AttackingSquadNumber    []int                                  `json:"attackingSquadNumber" legend:"base" related:""`    // attacking_squad_number
-	AttackingSquadRace      []string                               `json:"attackingSquadRace" legend:"base" related:""`      // attacking_squad_race
-	AttackingSquadSite      []int                                  `json:"attackingSquadSite" legend:"base" related:""`      // attacking_squad_site
-	CompanyMerc             []bool                                 `json:"companyMerc" legend:"base" related:""`             // company_merc
-	Coords                  string                                 `json:"coords" legend:"base" related:""`                  // coords
+	ASupportMercEnid        int      `json:"aSupportMercEnid" legend:"base" related:""`        // a_support_merc_enid
+	ASupportMercHfid        []int    `json:"aSupportMercHfid" legend:"base" related:""`        // a_support_merc_hfid
+	AttackingHfid           []int    `json:"attackingHfid" legend:"base" related:""`           // attacking_hfid
+	AttackingMercEnid       int      `json:"attackingMercEnid" legend:"base" related:""`       // attacking_merc_enid
+	AttackingSquadAnimated  []bool   `json:"attackingSquadAnimated" legend:"base" related:""`  // attacking_squad_animated
+	AttackingSquadDeaths    []int    `json:"attackingSquadDeaths" legend:"base" related:""`    // attacking_squad_deaths
+	AttackingSquadEntityPop []int    `json:"attackingSquadEntityPop" legend:"base" related:""` // attacking_squad_entity_pop
+	AttackingSquadNumber    []int    `json:"attackingSquadNumber" legend:"base" related:""`    // attacking_squad_number
+	AttackingSquadRace      []string `json:"attackingSquadRace" legend:"base" related:""`      // attacking_squad_race
+	AttackingSquadSite      []int    `json:"attackingSquadSite" legend:"base" related:""`      // attacking_squad_site
+	CompanyMerc             []bool   `json:"companyMerc" legend:"base" related:""`             // company_merc
+	Coords                  string   `json:"coords" legend:"base" related:""`                  // coords
+	coords                  []Coord
 	DSupportMercEnid        int                                    `json:"dSupportMercEnid" legend:"base" related:""`        // d_support_merc_enid
 	DSupportMercHfid        []int                                  `json:"dSupportMercHfid" legend:"base" related:""`        // d_support_merc_hfid
 	DefendingHfid           []int                                  `json:"defendingHfid" legend:"base" related:""`           // defending_hfid
@@ -6362,7 +6772,25 @@ func NewHistoricalEventCollectionBattle() *HistoricalEventCollectionBattle {
 		WarEventcol:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionBattle() *HistoricalEventCollectionBattle {
+	x := a.historicalEventCollectionBattle.new()
+	*x = HistoricalEventCollectionBattle{
+		ASupportMercEnid:  -1,
+		AttackingMercEnid: -1,
+		DSupportMercEnid:  -1,
+		DefendingMercEnid: -1,
+		FeatureLayerId:    -1,
+		SiteId:            -1,
+		SubregionId:       -1,
+		WarEventcol:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionBattle) Name() string { return x.Name_ }
+func (x *HistoricalEventCollectionBattle) Coordinates() []Coord {
+	return coordinates(x.coords, x.Coords)
+}
 func (x *HistoricalEventCollectionBattle) Type() string { return "battle" }
 func (x *HistoricalEventCollectionBattle) RelatedToEntity(id int) bool {
 	return x.ASupportMercEnid == id || x.AttackingMercEnid == id || x.DSupportMercEnid == id || x.DefendingMercEnid == id
@@ -6441,14 +6869,15 @@ func (x *HistoricalEventCollectionBattle) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventCollectionBeastAttack struct {
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
-	DefendingEnid  int    `json:"defendingEnid" legend:"base" related:""`  // defending_enid
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	Ordinal        int    `json:"ordinal" legend:"base" related:""`        // ordinal
-	ParentEventcol int    `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
-	SiteId         int    `json:"siteId" legend:"base" related:""`         // site_id
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`    // subregion_id
-	AttackerHfIds  []int  `json:"attackerHfIds" legend:"add" related:""`   // AttackerHfIds
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
+	DefendingEnid  int   `json:"defendingEnid" legend:"base" related:""`  // defending_enid
+	FeatureLayerId int   `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	Ordinal        int   `json:"ordinal" legend:"base" related:""`        // ordinal
+	ParentEventcol int   `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
+	SiteId         int   `json:"siteId" legend:"base" related:""`         // site_id
+	SubregionId    int   `json:"subregionId" legend:"base" related:""`    // subregion_id
+	AttackerHfIds  []int `json:"attackerHfIds" legend:"add" related:""`   // AttackerHfIds
 }
 
 func NewHistoricalEventCollectionBeastAttack() *HistoricalEventCollectionBeastAttack {
@@ -6461,6 +6890,22 @@ func NewHistoricalEventCollectionBeastAttack() *HistoricalEventCollectionBeastAt
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionBeastAttack() *HistoricalEventCollectionBeastAttack {
+	x := a.historicalEventCollectionBeastAttack.new()
+	*x = HistoricalEventCollectionBeastAttack{
+		DefendingEnid:  -1,
+		FeatureLayerId: -1,
+		Ordinal:        -1,
+		ParentEventcol: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventCollectionBeastAttack) Coordinates() []Coord {
+	return coordinates(x.coords, x.Coords)
+}
 func (x *HistoricalEventCollectionBeastAttack) Type() string { return "beast attack" }
 func (x *HistoricalEventCollectionBeastAttack) RelatedToEntity(id int) bool {
 	return x.DefendingEnid == id
@@ -6519,6 +6964,15 @@ func NewHistoricalEventCollectionCeremony() *HistoricalEventCollectionCeremony {
 		OccasionEventcol: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionCeremony() *HistoricalEventCollectionCeremony {
+	x := a.historicalEventCollectionCeremony.new()
+	*x = HistoricalEventCollectionCeremony{
+		Ordinal:          -1,
+		OccasionEventcol: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionCeremony) Type() string                           { return "ceremony" }
 func (x *HistoricalEventCollectionCeremony) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventCollectionCeremony) RelatedToHf(id int) bool                { return false }
@@ -6559,6 +7013,15 @@ func NewHistoricalEventCollectionCompetition() *HistoricalEventCollectionCompeti
 		OccasionEventcol: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionCompetition() *HistoricalEventCollectionCompetition {
+	x := a.historicalEventCollectionCompetition.new()
+	*x = HistoricalEventCollectionCompetition{
+		Ordinal:          -1,
+		OccasionEventcol: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionCompetition) Type() string                           { return "competition" }
 func (x *HistoricalEventCollectionCompetition) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventCollectionCompetition) RelatedToHf(id int) bool                { return false }
@@ -6589,14 +7052,15 @@ func (x *HistoricalEventCollectionCompetition) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventCollectionDuel struct {
-	AttackingHfid  int    `json:"attackingHfid" legend:"base" related:""`  // attacking_hfid
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
-	DefendingHfid  int    `json:"defendingHfid" legend:"base" related:""`  // defending_hfid
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	Ordinal        int    `json:"ordinal" legend:"base" related:""`        // ordinal
-	ParentEventcol int    `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
-	SiteId         int    `json:"siteId" legend:"base" related:""`         // site_id
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`    // subregion_id
+	AttackingHfid  int    `json:"attackingHfid" legend:"base" related:""` // attacking_hfid
+	Coords         string `json:"coords" legend:"base" related:""`        // coords
+	coords         []Coord
+	DefendingHfid  int `json:"defendingHfid" legend:"base" related:""`  // defending_hfid
+	FeatureLayerId int `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	Ordinal        int `json:"ordinal" legend:"base" related:""`        // ordinal
+	ParentEventcol int `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
+	SiteId         int `json:"siteId" legend:"base" related:""`         // site_id
+	SubregionId    int `json:"subregionId" legend:"base" related:""`    // subregion_id
 }
 
 func NewHistoricalEventCollectionDuel() *HistoricalEventCollectionDuel {
@@ -6610,6 +7074,21 @@ func NewHistoricalEventCollectionDuel() *HistoricalEventCollectionDuel {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionDuel() *HistoricalEventCollectionDuel {
+	x := a.historicalEventCollectionDuel.new()
+	*x = HistoricalEventCollectionDuel{
+		AttackingHfid:  -1,
+		DefendingHfid:  -1,
+		FeatureLayerId: -1,
+		Ordinal:        -1,
+		ParentEventcol: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventCollectionDuel) Coordinates() []Coord        { return coordinates(x.coords, x.Coords) }
 func (x *HistoricalEventCollectionDuel) Type() string                { return "duel" }
 func (x *HistoricalEventCollectionDuel) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventCollectionDuel) RelatedToHf(id int) bool {
@@ -6670,6 +7149,16 @@ func NewHistoricalEventCollectionEntityOverthrown() *HistoricalEventCollectionEn
 		TargetEntityId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionEntityOverthrown() *HistoricalEventCollectionEntityOverthrown {
+	x := a.historicalEventCollectionEntityOverthrown.new()
+	*x = HistoricalEventCollectionEntityOverthrown{
+		Ordinal:        -1,
+		SiteId:         -1,
+		TargetEntityId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionEntityOverthrown) Type() string { return "entity overthrown" }
 func (x *HistoricalEventCollectionEntityOverthrown) RelatedToEntity(id int) bool {
 	return x.TargetEntityId == id
@@ -6723,6 +7212,16 @@ func NewHistoricalEventCollectionInsurrection() *HistoricalEventCollectionInsurr
 		TargetEnid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionInsurrection() *HistoricalEventCollectionInsurrection {
+	x := a.historicalEventCollectionInsurrection.new()
+	*x = HistoricalEventCollectionInsurrection{
+		Ordinal:    -1,
+		SiteId:     -1,
+		TargetEnid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionInsurrection) Type() string { return "insurrection" }
 func (x *HistoricalEventCollectionInsurrection) RelatedToEntity(id int) bool {
 	return x.TargetEnid == id
@@ -6767,6 +7266,14 @@ func NewHistoricalEventCollectionJourney() *HistoricalEventCollectionJourney {
 		Ordinal: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionJourney() *HistoricalEventCollectionJourney {
+	x := a.historicalEventCollectionJourney.new()
+	*x = HistoricalEventCollectionJourney{
+		Ordinal: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionJourney) Type() string                           { return "journey" }
 func (x *HistoricalEventCollectionJourney) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventCollectionJourney) RelatedToHf(id int) bool                { return false }
@@ -6807,6 +7314,16 @@ func NewHistoricalEventCollectionOccasion() *HistoricalEventCollectionOccasion {
 		Ordinal:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionOccasion() *HistoricalEventCollectionOccasion {
+	x := a.historicalEventCollectionOccasion.new()
+	*x = HistoricalEventCollectionOccasion{
+		CivId:      -1,
+		OccasionId: -1,
+		Ordinal:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionOccasion) Type() string                           { return "occasion" }
 func (x *HistoricalEventCollectionOccasion) RelatedToEntity(id int) bool            { return x.CivId == id }
 func (x *HistoricalEventCollectionOccasion) RelatedToHf(id int) bool                { return false }
@@ -6850,6 +7367,15 @@ func NewHistoricalEventCollectionPerformance() *HistoricalEventCollectionPerform
 		OccasionEventcol: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionPerformance() *HistoricalEventCollectionPerformance {
+	x := a.historicalEventCollectionPerformance.new()
+	*x = HistoricalEventCollectionPerformance{
+		Ordinal:          -1,
+		OccasionEventcol: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionPerformance) Type() string                           { return "performance" }
 func (x *HistoricalEventCollectionPerformance) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventCollectionPerformance) RelatedToHf(id int) bool                { return false }
@@ -6892,6 +7418,16 @@ func NewHistoricalEventCollectionPersecution() *HistoricalEventCollectionPersecu
 		TargetEntityId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionPersecution() *HistoricalEventCollectionPersecution {
+	x := a.historicalEventCollectionPersecution.new()
+	*x = HistoricalEventCollectionPersecution{
+		Ordinal:        -1,
+		SiteId:         -1,
+		TargetEntityId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionPersecution) Type() string { return "persecution" }
 func (x *HistoricalEventCollectionPersecution) RelatedToEntity(id int) bool {
 	return x.TargetEntityId == id
@@ -6937,6 +7473,15 @@ func NewHistoricalEventCollectionProcession() *HistoricalEventCollectionProcessi
 		OccasionEventcol: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionProcession() *HistoricalEventCollectionProcession {
+	x := a.historicalEventCollectionProcession.new()
+	*x = HistoricalEventCollectionProcession{
+		Ordinal:          -1,
+		OccasionEventcol: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionProcession) Type() string                           { return "procession" }
 func (x *HistoricalEventCollectionProcession) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventCollectionProcession) RelatedToHf(id int) bool                { return false }
@@ -7005,6 +7550,15 @@ func NewHistoricalEventCollectionPurge() *HistoricalEventCollectionPurge {
 		SiteId:  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionPurge() *HistoricalEventCollectionPurge {
+	x := a.historicalEventCollectionPurge.new()
+	*x = HistoricalEventCollectionPurge{
+		Ordinal: -1,
+		SiteId:  -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionPurge) Type() string                           { return "purge" }
 func (x *HistoricalEventCollectionPurge) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventCollectionPurge) RelatedToHf(id int) bool                { return false }
@@ -7038,14 +7592,15 @@ func (x *HistoricalEventCollectionPurge) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventCollectionRaid struct {
-	AttackingEnid  int    `json:"attackingEnid" legend:"base" related:""`  // attacking_enid
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
-	DefendingEnid  int    `json:"defendingEnid" legend:"base" related:""`  // defending_enid
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	Ordinal        int    `json:"ordinal" legend:"base" related:""`        // ordinal
-	ParentEventcol int    `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
-	SiteId         int    `json:"siteId" legend:"base" related:""`         // site_id
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`    // subregion_id
+	AttackingEnid  int    `json:"attackingEnid" legend:"base" related:""` // attacking_enid
+	Coords         string `json:"coords" legend:"base" related:""`        // coords
+	coords         []Coord
+	DefendingEnid  int `json:"defendingEnid" legend:"base" related:""`  // defending_enid
+	FeatureLayerId int `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	Ordinal        int `json:"ordinal" legend:"base" related:""`        // ordinal
+	ParentEventcol int `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
+	SiteId         int `json:"siteId" legend:"base" related:""`         // site_id
+	SubregionId    int `json:"subregionId" legend:"base" related:""`    // subregion_id
 }
 
 func NewHistoricalEventCollectionRaid() *HistoricalEventCollectionRaid {
@@ -7059,7 +7614,22 @@ func NewHistoricalEventCollectionRaid() *HistoricalEventCollectionRaid {
 		SubregionId:    -1,
 	}
 }
-func (x *HistoricalEventCollectionRaid) Type() string { return "raid" }
+
+func (a *arenas) newHistoricalEventCollectionRaid() *HistoricalEventCollectionRaid {
+	x := a.historicalEventCollectionRaid.new()
+	*x = HistoricalEventCollectionRaid{
+		AttackingEnid:  -1,
+		DefendingEnid:  -1,
+		FeatureLayerId: -1,
+		Ordinal:        -1,
+		ParentEventcol: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventCollectionRaid) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
+func (x *HistoricalEventCollectionRaid) Type() string         { return "raid" }
 func (x *HistoricalEventCollectionRaid) RelatedToEntity(id int) bool {
 	return x.AttackingEnid == id || x.DefendingEnid == id
 }
@@ -7123,6 +7693,18 @@ func NewHistoricalEventCollectionSiteConquered() *HistoricalEventCollectionSiteC
 		WarEventcol:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionSiteConquered() *HistoricalEventCollectionSiteConquered {
+	x := a.historicalEventCollectionSiteConquered.new()
+	*x = HistoricalEventCollectionSiteConquered{
+		AttackingEnid: -1,
+		DefendingEnid: -1,
+		Ordinal:       -1,
+		SiteId:        -1,
+		WarEventcol:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionSiteConquered) Type() string { return "site conquered" }
 func (x *HistoricalEventCollectionSiteConquered) RelatedToEntity(id int) bool {
 	return x.AttackingEnid == id || x.DefendingEnid == id
@@ -7168,14 +7750,15 @@ func (x *HistoricalEventCollectionSiteConquered) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventCollectionTheft struct {
-	AttackingEnid  int    `json:"attackingEnid" legend:"base" related:""`  // attacking_enid
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
-	DefendingEnid  int    `json:"defendingEnid" legend:"base" related:""`  // defending_enid
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	Ordinal        int    `json:"ordinal" legend:"base" related:""`        // ordinal
-	ParentEventcol int    `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
-	SiteId         int    `json:"siteId" legend:"base" related:""`         // site_id
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`    // subregion_id
+	AttackingEnid  int    `json:"attackingEnid" legend:"base" related:""` // attacking_enid
+	Coords         string `json:"coords" legend:"base" related:""`        // coords
+	coords         []Coord
+	DefendingEnid  int `json:"defendingEnid" legend:"base" related:""`  // defending_enid
+	FeatureLayerId int `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	Ordinal        int `json:"ordinal" legend:"base" related:""`        // ordinal
+	ParentEventcol int `json:"parentEventcol" legend:"base" related:""` // parent_eventcol
+	SiteId         int `json:"siteId" legend:"base" related:""`         // site_id
+	SubregionId    int `json:"subregionId" legend:"base" related:""`    // subregion_id
 }
 
 func NewHistoricalEventCollectionTheft() *HistoricalEventCollectionTheft {
@@ -7189,6 +7772,23 @@ func NewHistoricalEventCollectionTheft() *HistoricalEventCollectionTheft {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionTheft() *HistoricalEventCollectionTheft {
+	x := a.historicalEventCollectionTheft.new()
+	*x = HistoricalEventCollectionTheft{
+		AttackingEnid:  -1,
+		DefendingEnid:  -1,
+		FeatureLayerId: -1,
+		Ordinal:        -1,
+		ParentEventcol: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventCollectionTheft) Coordinates() []Coord {
+	return coordinates(x.coords, x.Coords)
+}
 func (x *HistoricalEventCollectionTheft) Type() string { return "theft" }
 func (x *HistoricalEventCollectionTheft) RelatedToEntity(id int) bool {
 	return x.AttackingEnid == id || x.DefendingEnid == id
@@ -7248,6 +7848,15 @@ func NewHistoricalEventCollectionWar() *HistoricalEventCollectionWar {
 		DefenderEntId:  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCollectionWar() *HistoricalEventCollectionWar {
+	x := a.historicalEventCollectionWar.new()
+	*x = HistoricalEventCollectionWar{
+		AggressorEntId: -1,
+		DefenderEntId:  -1,
+	}
+	return x
+}
 func (x *HistoricalEventCollectionWar) Name() string                           { return x.Name_ }
 func (x *HistoricalEventCollectionWar) Type() string                           { return "war" }
 func (x *HistoricalEventCollectionWar) RelatedToEntity(id int) bool            { return false }
@@ -7301,6 +7910,20 @@ func NewHistoricalEventCompetition() *HistoricalEventCompetition {
 		WinnerHfid:     -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCompetition() *HistoricalEventCompetition {
+	x := a.historicalEventCompetition.new()
+	*x = HistoricalEventCompetition{
+		CivId:          -1,
+		FeatureLayerId: -1,
+		OccasionId:     -1,
+		ScheduleId:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+		WinnerHfid:     -1,
+	}
+	return x
+}
 func (x *HistoricalEventCompetition) Type() string                { return "competition" }
 func (x *HistoricalEventCompetition) RelatedToEntity(id int) bool { return x.CivId == id }
 func (x *HistoricalEventCompetition) RelatedToHf(id int) bool {
@@ -7410,6 +8033,16 @@ func NewHistoricalEventCreateEntityPosition() *HistoricalEventCreateEntityPositi
 		SiteCiv: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCreateEntityPosition() *HistoricalEventCreateEntityPosition {
+	x := a.historicalEventCreateEntityPosition.new()
+	*x = HistoricalEventCreateEntityPosition{
+		Civ:     -1,
+		Histfig: -1,
+		SiteCiv: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCreateEntityPosition) Type() string { return "create entity position" }
 func (x *HistoricalEventCreateEntityPosition) RelatedToEntity(id int) bool {
 	return x.Civ == id || x.SiteCiv == id
@@ -7465,6 +8098,18 @@ func NewHistoricalEventCreatedSite() *HistoricalEventCreatedSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCreatedSite() *HistoricalEventCreatedSite {
+	x := a.historicalEventCreatedSite.new()
+	*x = HistoricalEventCreatedSite{
+		BuilderHfid:   -1,
+		CivId:         -1,
+		ResidentCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventCreatedSite) Type() string { return "created site" }
 func (x *HistoricalEventCreatedSite) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.ResidentCivId == id || x.SiteCivId == id
@@ -7556,6 +8201,18 @@ func NewHistoricalEventCreatedStructure() *HistoricalEventCreatedStructure {
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCreatedStructure() *HistoricalEventCreatedStructure {
+	x := a.historicalEventCreatedStructure.new()
+	*x = HistoricalEventCreatedStructure{
+		BuilderHfid: -1,
+		CivId:       -1,
+		SiteCivId:   -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventCreatedStructure) Type() string { return "created structure" }
 func (x *HistoricalEventCreatedStructure) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.SiteCivId == id
@@ -7621,6 +8278,19 @@ func NewHistoricalEventCreatedWorldConstruction() *HistoricalEventCreatedWorldCo
 		Wcid:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCreatedWorldConstruction() *HistoricalEventCreatedWorldConstruction {
+	x := a.historicalEventCreatedWorldConstruction.new()
+	*x = HistoricalEventCreatedWorldConstruction{
+		CivId:      -1,
+		MasterWcid: -1,
+		SiteCivId:  -1,
+		SiteId1:    -1,
+		SiteId2:    -1,
+		Wcid:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventCreatedWorldConstruction) Type() string { return "created world construction" }
 func (x *HistoricalEventCreatedWorldConstruction) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.SiteCivId == id
@@ -7691,6 +8361,19 @@ func NewHistoricalEventCreatureDevoured() *HistoricalEventCreatureDevoured {
 		Victim:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventCreatureDevoured() *HistoricalEventCreatureDevoured {
+	x := a.historicalEventCreatureDevoured.new()
+	*x = HistoricalEventCreatureDevoured{
+		Eater:          -1,
+		Entity:         -1,
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+		Victim:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventCreatureDevoured) Type() string                           { return "creature devoured" }
 func (x *HistoricalEventCreatureDevoured) RelatedToEntity(id int) bool            { return x.Entity == id }
 func (x *HistoricalEventCreatureDevoured) RelatedToHf(id int) bool                { return false }
@@ -7708,31 +8391,31 @@ func (x *HistoricalEventCreatureDevoured) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventCreatureDevoured) CheckFields() {
 	if x.Eater != x.FeatureLayerId {
-		sameFields["HistoricalEventCreatureDevoured"]["Eater"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Eater", "FeatureLayerId")
 	}
 	if x.Eater != x.SiteId {
-		sameFields["HistoricalEventCreatureDevoured"]["Eater"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Eater", "SiteId")
 	}
 	if x.Eater != x.SubregionId {
-		sameFields["HistoricalEventCreatureDevoured"]["Eater"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Eater", "SubregionId")
 	}
 	if x.Entity != x.FeatureLayerId {
-		sameFields["HistoricalEventCreatureDevoured"]["Entity"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Entity", "FeatureLayerId")
 	}
 	if x.Entity != x.SiteId {
-		sameFields["HistoricalEventCreatureDevoured"]["Entity"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Entity", "SiteId")
 	}
 	if x.Entity != x.SubregionId {
-		sameFields["HistoricalEventCreatureDevoured"]["Entity"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Entity", "SubregionId")
 	}
 	if x.Victim != x.FeatureLayerId {
-		sameFields["HistoricalEventCreatureDevoured"]["Victim"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Victim", "FeatureLayerId")
 	}
 	if x.Victim != x.SiteId {
-		sameFields["HistoricalEventCreatureDevoured"]["Victim"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Victim", "SiteId")
 	}
 	if x.Victim != x.SubregionId {
-		sameFields["HistoricalEventCreatureDevoured"]["Victim"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventCreatureDevoured", "Victim", "SubregionId")
 	}
 }
 
@@ -7851,6 +8534,19 @@ func NewHistoricalEventDanceFormCreated() *HistoricalEventDanceFormCreated {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventDanceFormCreated() *HistoricalEventDanceFormCreated {
+	x := a.historicalEventDanceFormCreated.new()
+	*x = HistoricalEventDanceFormCreated{
+		CircumstanceId: -1,
+		FormId:         -1,
+		HistFigureId:   -1,
+		ReasonId:       -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventDanceFormCreated) Type() string                           { return "dance form created" }
 func (x *HistoricalEventDanceFormCreated) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventDanceFormCreated) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -7914,6 +8610,17 @@ func NewHistoricalEventDestroyedSite() *HistoricalEventDestroyedSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventDestroyedSite() *HistoricalEventDestroyedSite {
+	x := a.historicalEventDestroyedSite.new()
+	*x = HistoricalEventDestroyedSite{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventDestroyedSite) Type() string { return "destroyed site" }
 func (x *HistoricalEventDestroyedSite) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.SiteCivId == id
@@ -7965,6 +8672,16 @@ func NewHistoricalEventDiplomatLost() *HistoricalEventDiplomatLost {
 		SiteId:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventDiplomatLost() *HistoricalEventDiplomatLost {
+	x := a.historicalEventDiplomatLost.new()
+	*x = HistoricalEventDiplomatLost{
+		Entity:   -1,
+		Involved: -1,
+		SiteId:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventDiplomatLost) Type() string { return "diplomat lost" }
 func (x *HistoricalEventDiplomatLost) RelatedToEntity(id int) bool {
 	return x.Entity == id || x.Involved == id
@@ -7984,10 +8701,10 @@ func (x *HistoricalEventDiplomatLost) RelatedToIdentity(id int) bool          {
 
 func (x *HistoricalEventDiplomatLost) CheckFields() {
 	if x.Entity != x.SiteId {
-		sameFields["HistoricalEventDiplomatLost"]["Entity"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventDiplomatLost", "Entity", "SiteId")
 	}
 	if x.Involved != x.SiteId {
-		sameFields["HistoricalEventDiplomatLost"]["Involved"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventDiplomatLost", "Involved", "SiteId")
 	}
 }
 
@@ -8015,6 +8732,14 @@ func NewHistoricalEventEntityAllianceFormed() *HistoricalEventEntityAllianceForm
 		InitiatingEnid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityAllianceFormed() *HistoricalEventEntityAllianceFormed {
+	x := a.historicalEventEntityAllianceFormed.new()
+	*x = HistoricalEventEntityAllianceFormed{
+		InitiatingEnid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityAllianceFormed) Type() string { return "entity alliance formed" }
 func (x *HistoricalEventEntityAllianceFormed) RelatedToEntity(id int) bool {
 	return containsInt(x.JoiningEnid, id) || x.InitiatingEnid == id
@@ -8059,6 +8784,17 @@ func NewHistoricalEventEntityBreachFeatureLayer() *HistoricalEventEntityBreachFe
 		SiteId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityBreachFeatureLayer() *HistoricalEventEntityBreachFeatureLayer {
+	x := a.historicalEventEntityBreachFeatureLayer.new()
+	*x = HistoricalEventEntityBreachFeatureLayer{
+		CivEntityId:    -1,
+		FeatureLayerId: -1,
+		SiteEntityId:   -1,
+		SiteId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityBreachFeatureLayer) Type() string { return "entity breach feature layer" }
 func (x *HistoricalEventEntityBreachFeatureLayer) RelatedToEntity(id int) bool {
 	return x.CivEntityId == id || x.SiteEntityId == id
@@ -8115,6 +8851,17 @@ func NewHistoricalEventEntityCreated() *HistoricalEventEntityCreated {
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityCreated() *HistoricalEventEntityCreated {
+	x := a.historicalEventEntityCreated.new()
+	*x = HistoricalEventEntityCreated{
+		CreatorHfid: -1,
+		EntityId:    -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityCreated) Type() string                  { return "entity created" }
 func (x *HistoricalEventEntityCreated) RelatedToEntity(id int) bool   { return x.EntityId == id }
 func (x *HistoricalEventEntityCreated) RelatedToHf(id int) bool       { return x.CreatorHfid == id }
@@ -8194,6 +8941,14 @@ func NewHistoricalEventEntityDissolved() *HistoricalEventEntityDissolved {
 		EntityId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityDissolved() *HistoricalEventEntityDissolved {
+	x := a.historicalEventEntityDissolved.new()
+	*x = HistoricalEventEntityDissolved{
+		EntityId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityDissolved) Type() string                           { return "entity dissolved" }
 func (x *HistoricalEventEntityDissolved) RelatedToEntity(id int) bool            { return x.EntityId == id }
 func (x *HistoricalEventEntityDissolved) RelatedToHf(id int) bool                { return false }
@@ -8235,6 +8990,15 @@ func NewHistoricalEventEntityEquipmentPurchase() *HistoricalEventEntityEquipment
 		NewEquipmentLevel: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityEquipmentPurchase() *HistoricalEventEntityEquipmentPurchase {
+	x := a.historicalEventEntityEquipmentPurchase.new()
+	*x = HistoricalEventEntityEquipmentPurchase{
+		EntityId:          -1,
+		NewEquipmentLevel: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityEquipmentPurchase) Type() string { return "entity equipment purchase" }
 func (x *HistoricalEventEntityEquipmentPurchase) RelatedToEntity(id int) bool {
 	return x.EntityId == id
@@ -8286,6 +9050,16 @@ func NewHistoricalEventEntityExpelsHf() *HistoricalEventEntityExpelsHf {
 		SiteId:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityExpelsHf() *HistoricalEventEntityExpelsHf {
+	x := a.historicalEventEntityExpelsHf.new()
+	*x = HistoricalEventEntityExpelsHf{
+		EntityId: -1,
+		Hfid:     -1,
+		SiteId:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityExpelsHf) Type() string                           { return "entity expels hf" }
 func (x *HistoricalEventEntityExpelsHf) RelatedToEntity(id int) bool            { return x.EntityId == id }
 func (x *HistoricalEventEntityExpelsHf) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -8329,6 +9103,15 @@ func NewHistoricalEventEntityFledSite() *HistoricalEventEntityFledSite {
 		SiteId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityFledSite() *HistoricalEventEntityFledSite {
+	x := a.historicalEventEntityFledSite.new()
+	*x = HistoricalEventEntityFledSite{
+		FledCivId: -1,
+		SiteId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityFledSite) Type() string                           { return "entity fled site" }
 func (x *HistoricalEventEntityFledSite) RelatedToEntity(id int) bool            { return x.FledCivId == id }
 func (x *HistoricalEventEntityFledSite) RelatedToHf(id int) bool                { return false }
@@ -8374,6 +9157,17 @@ func NewHistoricalEventEntityIncorporated() *HistoricalEventEntityIncorporated {
 		SiteId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityIncorporated() *HistoricalEventEntityIncorporated {
+	x := a.historicalEventEntityIncorporated.new()
+	*x = HistoricalEventEntityIncorporated{
+		JoinedEntityId: -1,
+		JoinerEntityId: -1,
+		LeaderHfid:     -1,
+		SiteId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityIncorporated) Type() string { return "entity incorporated" }
 func (x *HistoricalEventEntityIncorporated) RelatedToEntity(id int) bool {
 	return x.JoinedEntityId == id || x.JoinerEntityId == id
@@ -8479,6 +9273,15 @@ func NewHistoricalEventEntityLaw() *HistoricalEventEntityLaw {
 		HistFigureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityLaw() *HistoricalEventEntityLaw {
+	x := a.historicalEventEntityLaw.new()
+	*x = HistoricalEventEntityLaw{
+		EntityId:     -1,
+		HistFigureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityLaw) Type() string                           { return "entity law" }
 func (x *HistoricalEventEntityLaw) RelatedToEntity(id int) bool            { return x.EntityId == id }
 func (x *HistoricalEventEntityLaw) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -8534,6 +9337,19 @@ func NewHistoricalEventEntityOverthrown() *HistoricalEventEntityOverthrown {
 		SiteId:            -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityOverthrown() *HistoricalEventEntityOverthrown {
+	x := a.historicalEventEntityOverthrown.new()
+	*x = HistoricalEventEntityOverthrown{
+		EntityId:          -1,
+		InstigatorHfid:    -1,
+		OverthrownHfid:    -1,
+		PosTakerHfid:      -1,
+		PositionProfileId: -1,
+		SiteId:            -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityOverthrown) Type() string                { return "entity overthrown" }
 func (x *HistoricalEventEntityOverthrown) RelatedToEntity(id int) bool { return x.EntityId == id }
 func (x *HistoricalEventEntityOverthrown) RelatedToHf(id int) bool {
@@ -8602,6 +9418,19 @@ func NewHistoricalEventEntityPersecuted() *HistoricalEventEntityPersecuted {
 		TargetEnid:            -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityPersecuted() *HistoricalEventEntityPersecuted {
+	x := a.historicalEventEntityPersecuted.new()
+	*x = HistoricalEventEntityPersecuted{
+		DestroyedStructureId:  -1,
+		PersecutorEnid:        -1,
+		PersecutorHfid:        -1,
+		ShrineAmountDestroyed: -1,
+		SiteId:                -1,
+		TargetEnid:            -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityPersecuted) Type() string { return "entity persecuted" }
 func (x *HistoricalEventEntityPersecuted) RelatedToEntity(id int) bool {
 	return x.PersecutorEnid == id || x.TargetEnid == id
@@ -8695,6 +9524,16 @@ func NewHistoricalEventEntityPrimaryCriminals() *HistoricalEventEntityPrimaryCri
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityPrimaryCriminals() *HistoricalEventEntityPrimaryCriminals {
+	x := a.historicalEventEntityPrimaryCriminals.new()
+	*x = HistoricalEventEntityPrimaryCriminals{
+		EntityId:    -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityPrimaryCriminals) Type() string                  { return "entity primary criminals" }
 func (x *HistoricalEventEntityPrimaryCriminals) RelatedToEntity(id int) bool   { return x.EntityId == id }
 func (x *HistoricalEventEntityPrimaryCriminals) RelatedToHf(id int) bool       { return false }
@@ -8743,6 +9582,15 @@ func NewHistoricalEventEntityRampagedInSite() *HistoricalEventEntityRampagedInSi
 		SiteId:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityRampagedInSite() *HistoricalEventEntityRampagedInSite {
+	x := a.historicalEventEntityRampagedInSite.new()
+	*x = HistoricalEventEntityRampagedInSite{
+		RampageCivId: -1,
+		SiteId:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityRampagedInSite) Type() string { return "entity rampaged in site" }
 func (x *HistoricalEventEntityRampagedInSite) RelatedToEntity(id int) bool {
 	return x.RampageCivId == id
@@ -8815,6 +9663,16 @@ func NewHistoricalEventEntityRelocate() *HistoricalEventEntityRelocate {
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntityRelocate() *HistoricalEventEntityRelocate {
+	x := a.historicalEventEntityRelocate.new()
+	*x = HistoricalEventEntityRelocate{
+		EntityId:    -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntityRelocate) Type() string                  { return "entity relocate" }
 func (x *HistoricalEventEntityRelocate) RelatedToEntity(id int) bool   { return x.EntityId == id }
 func (x *HistoricalEventEntityRelocate) RelatedToHf(id int) bool       { return false }
@@ -8891,6 +9749,15 @@ func NewHistoricalEventEntitySearchedSite() *HistoricalEventEntitySearchedSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventEntitySearchedSite() *HistoricalEventEntitySearchedSite {
+	x := a.historicalEventEntitySearchedSite.new()
+	*x = HistoricalEventEntitySearchedSite{
+		SearcherCivId: -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventEntitySearchedSite) Type() string { return "entity searched site" }
 func (x *HistoricalEventEntitySearchedSite) RelatedToEntity(id int) bool {
 	return x.SearcherCivId == id
@@ -8970,6 +9837,18 @@ func NewHistoricalEventFailedFrameAttempt() *HistoricalEventFailedFrameAttempt {
 		TargetHfid:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventFailedFrameAttempt() *HistoricalEventFailedFrameAttempt {
+	x := a.historicalEventFailedFrameAttempt.new()
+	*x = HistoricalEventFailedFrameAttempt{
+		ConvicterEnid: -1,
+		FooledHfid:    -1,
+		FramerHfid:    -1,
+		PlotterHfid:   -1,
+		TargetHfid:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventFailedFrameAttempt) Type() string { return "failed frame attempt" }
 func (x *HistoricalEventFailedFrameAttempt) RelatedToEntity(id int) bool {
 	return x.ConvicterEnid == id
@@ -9331,6 +10210,32 @@ func NewHistoricalEventFailedIntrigueCorruption() *HistoricalEventFailedIntrigue
 		TopValueRating:            -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventFailedIntrigueCorruption() *HistoricalEventFailedIntrigueCorruption {
+	x := a.historicalEventFailedIntrigueCorruption.new()
+	*x = HistoricalEventFailedIntrigueCorruption{
+		AllyDefenseBonus:          -1,
+		CoconspiratorBonus:        -1,
+		CorruptorHfid:             -1,
+		CorruptorIdentity:         -1,
+		FeatureLayerId:            -1,
+		LureHfid:                  -1,
+		RelevantEntityId:          -1,
+		RelevantIdForMethod:       -1,
+		RelevantPositionProfileId: -1,
+		SiteId:                    -1,
+		SubregionId:               -1,
+		TargetHfid:                -1,
+		TargetIdentity:            -1,
+		TopFacetModifier:          -1,
+		TopFacetRating:            -1,
+		TopRelationshipModifier:   -1,
+		TopRelationshipRating:     -1,
+		TopValueModifier:          -1,
+		TopValueRating:            -1,
+	}
+	return x
+}
 func (x *HistoricalEventFailedIntrigueCorruption) Type() string { return "failed intrigue corruption" }
 func (x *HistoricalEventFailedIntrigueCorruption) RelatedToEntity(id int) bool {
 	return x.RelevantEntityId == id
@@ -9445,12 +10350,13 @@ type HistoricalEventFieldBattle struct {
 	AttackerGeneralHfid int    `json:"attackerGeneralHfid" legend:"base" related:""` // attacker_general_hfid
 	AttackerMercEnid    int    `json:"attackerMercEnid" legend:"base" related:""`    // attacker_merc_enid
 	Coords              string `json:"coords" legend:"base" related:""`              // coords
-	DSupportMercEnid    int    `json:"dSupportMercEnid" legend:"base" related:""`    // d_support_merc_enid
-	DefenderCivId       int    `json:"defenderCivId" legend:"base" related:""`       // defender_civ_id
-	DefenderGeneralHfid int    `json:"defenderGeneralHfid" legend:"base" related:""` // defender_general_hfid
-	DefenderMercEnid    int    `json:"defenderMercEnid" legend:"base" related:""`    // defender_merc_enid
-	FeatureLayerId      int    `json:"featureLayerId" legend:"base" related:""`      // feature_layer_id
-	SubregionId         int    `json:"subregionId" legend:"base" related:""`         // subregion_id
+	coords              []Coord
+	DSupportMercEnid    int `json:"dSupportMercEnid" legend:"base" related:""`    // d_support_merc_enid
+	DefenderCivId       int `json:"defenderCivId" legend:"base" related:""`       // defender_civ_id
+	DefenderGeneralHfid int `json:"defenderGeneralHfid" legend:"base" related:""` // defender_general_hfid
+	DefenderMercEnid    int `json:"defenderMercEnid" legend:"base" related:""`    // defender_merc_enid
+	FeatureLayerId      int `json:"featureLayerId" legend:"base" related:""`      // feature_layer_id
+	SubregionId         int `json:"subregionId" legend:"base" related:""`         // subregion_id
 }
 
 func NewHistoricalEventFieldBattle() *HistoricalEventFieldBattle {
@@ -9467,7 +10373,25 @@ func NewHistoricalEventFieldBattle() *HistoricalEventFieldBattle {
 		SubregionId:         -1,
 	}
 }
-func (x *HistoricalEventFieldBattle) Type() string { return "field battle" }
+
+func (a *arenas) newHistoricalEventFieldBattle() *HistoricalEventFieldBattle {
+	x := a.historicalEventFieldBattle.new()
+	*x = HistoricalEventFieldBattle{
+		ASupportMercEnid:    -1,
+		AttackerCivId:       -1,
+		AttackerGeneralHfid: -1,
+		AttackerMercEnid:    -1,
+		DSupportMercEnid:    -1,
+		DefenderCivId:       -1,
+		DefenderGeneralHfid: -1,
+		DefenderMercEnid:    -1,
+		FeatureLayerId:      -1,
+		SubregionId:         -1,
+	}
+	return x
+}
+func (x *HistoricalEventFieldBattle) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
+func (x *HistoricalEventFieldBattle) Type() string         { return "field battle" }
 func (x *HistoricalEventFieldBattle) RelatedToEntity(id int) bool {
 	return x.ASupportMercEnid == id || x.AttackerCivId == id || x.AttackerMercEnid == id || x.DSupportMercEnid == id || x.DefenderCivId == id || x.DefenderMercEnid == id
 }
@@ -9538,6 +10462,16 @@ func NewHistoricalEventFirstContact() *HistoricalEventFirstContact {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventFirstContact() *HistoricalEventFirstContact {
+	x := a.historicalEventFirstContact.new()
+	*x = HistoricalEventFirstContact{
+		ContactedEnid: -1,
+		ContactorEnid: -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventFirstContact) Type() string { return "first contact" }
 func (x *HistoricalEventFirstContact) RelatedToEntity(id int) bool {
 	return x.ContactedEnid == id || x.ContactorEnid == id
@@ -9585,6 +10519,16 @@ func NewHistoricalEventFirstContactFailed() *HistoricalEventFirstContactFailed {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventFirstContactFailed() *HistoricalEventFirstContactFailed {
+	x := a.historicalEventFirstContactFailed.new()
+	*x = HistoricalEventFirstContactFailed{
+		ContactorEnid: -1,
+		RejectorEnid:  -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventFirstContactFailed) Type() string { return "first contact failed" }
 func (x *HistoricalEventFirstContactFailed) RelatedToEntity(id int) bool {
 	return x.ContactorEnid == id || x.RejectorEnid == id
@@ -9636,6 +10580,18 @@ func NewHistoricalEventGamble() *HistoricalEventGamble {
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventGamble() *HistoricalEventGamble {
+	x := a.historicalEventGamble.new()
+	*x = HistoricalEventGamble{
+		GamblerHfid: -1,
+		NewAccount:  -1,
+		OldAccount:  -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventGamble) Type() string                  { return "gamble" }
 func (x *HistoricalEventGamble) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventGamble) RelatedToHf(id int) bool       { return x.GamblerHfid == id }
@@ -9693,6 +10649,18 @@ func NewHistoricalEventHfAbducted() *HistoricalEventHfAbducted {
 		TargetHfid:     -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfAbducted() *HistoricalEventHfAbducted {
+	x := a.historicalEventHfAbducted.new()
+	*x = HistoricalEventHfAbducted{
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		SnatcherHfid:   -1,
+		SubregionId:    -1,
+		TargetHfid:     -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfAbducted) Type() string                { return "hf abducted" }
 func (x *HistoricalEventHfAbducted) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfAbducted) RelatedToHf(id int) bool {
@@ -9748,6 +10716,17 @@ func NewHistoricalEventHfAskedAboutArtifact() *HistoricalEventHfAskedAboutArtifa
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfAskedAboutArtifact() *HistoricalEventHfAskedAboutArtifact {
+	x := a.historicalEventHfAskedAboutArtifact.new()
+	*x = HistoricalEventHfAskedAboutArtifact{
+		ArtifactId:  -1,
+		HistFigId:   -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfAskedAboutArtifact) Type() string                { return "hf asked about artifact" }
 func (x *HistoricalEventHfAskedAboutArtifact) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfAskedAboutArtifact) RelatedToHf(id int) bool     { return false }
@@ -9802,6 +10781,17 @@ func NewHistoricalEventHfAttackedSite() *HistoricalEventHfAttackedSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfAttackedSite() *HistoricalEventHfAttackedSite {
+	x := a.historicalEventHfAttackedSite.new()
+	*x = HistoricalEventHfAttackedSite{
+		AttackerHfid:  -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfAttackedSite) Type() string { return "hf attacked site" }
 func (x *HistoricalEventHfAttackedSite) RelatedToEntity(id int) bool {
 	return x.DefenderCivId == id || x.SiteCivId == id
@@ -9855,6 +10845,17 @@ func NewHistoricalEventHfCarouse() *HistoricalEventHfCarouse {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfCarouse() *HistoricalEventHfCarouse {
+	x := a.historicalEventHfCarouse.new()
+	*x = HistoricalEventHfCarouse{
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		StructureId:    -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfCarouse) Type() string                  { return "hf carouse" }
 func (x *HistoricalEventHfCarouse) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventHfCarouse) RelatedToHf(id int) bool       { return containsInt(x.GroupHfid, id) }
@@ -9953,7 +10954,8 @@ func (s HistoricalEventHfConfrontedSituation) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventHfConfronted struct {
-	Coords         string                               `json:"coords" legend:"base" related:""`         // coords
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
 	FeatureLayerId int                                  `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
 	Hfid           int                                  `json:"hfid" legend:"base" related:""`           // hfid
 	Reason         []HistoricalEventHfConfrontedReason  `json:"reason" legend:"base" related:""`         // reason
@@ -9970,6 +10972,18 @@ func NewHistoricalEventHfConfronted() *HistoricalEventHfConfronted {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfConfronted() *HistoricalEventHfConfronted {
+	x := a.historicalEventHfConfronted.new()
+	*x = HistoricalEventHfConfronted{
+		FeatureLayerId: -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventHfConfronted) Coordinates() []Coord                   { return coordinates(x.coords, x.Coords) }
 func (x *HistoricalEventHfConfronted) Type() string                           { return "hf confronted" }
 func (x *HistoricalEventHfConfronted) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHfConfronted) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -10144,6 +11158,26 @@ func NewHistoricalEventHfConvicted() *HistoricalEventHfConvicted {
 		TargetHfid:                  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfConvicted() *HistoricalEventHfConvicted {
+	x := a.historicalEventHfConvicted.new()
+	*x = HistoricalEventHfConvicted{
+		CoconspiratorHfid:           -1,
+		ConfessedAfterApbArrestEnid: -1,
+		ContactHfid:                 -1,
+		ConvictedHfid:               -1,
+		ConvicterEnid:               -1,
+		CorruptConvicterHfid:        -1,
+		FooledHfid:                  -1,
+		FramerHfid:                  -1,
+		Hammerstrokes:               -1,
+		InterrogatorHfid:            -1,
+		PlotterHfid:                 -1,
+		PrisonMonths:                -1,
+		TargetHfid:                  -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfConvicted) Type() string { return "hf convicted" }
 func (x *HistoricalEventHfConvicted) RelatedToEntity(id int) bool {
 	return x.ConfessedAfterApbArrestEnid == id || x.ConvicterEnid == id
@@ -10241,6 +11275,17 @@ func NewHistoricalEventHfDestroyedSite() *HistoricalEventHfDestroyedSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfDestroyedSite() *HistoricalEventHfDestroyedSite {
+	x := a.historicalEventHfDestroyedSite.new()
+	*x = HistoricalEventHfDestroyedSite{
+		AttackerHfid:  -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfDestroyedSite) Type() string { return "hf destroyed site" }
 func (x *HistoricalEventHfDestroyedSite) RelatedToEntity(id int) bool {
 	return x.DefenderCivId == id || x.SiteCivId == id
@@ -10927,6 +11972,22 @@ func NewHistoricalEventHfDied() *HistoricalEventHfDied {
 		SubregionId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfDied() *HistoricalEventHfDied {
+	x := a.historicalEventHfDied.new()
+	*x = HistoricalEventHfDied{
+		FeatureLayerId:      -1,
+		Hfid:                -1,
+		ShooterArtifactId:   -1,
+		ShooterItem:         -1,
+		SiteId:              -1,
+		SlayerHfid:          -1,
+		SlayerItemId:        -1,
+		SlayerShooterItemId: -1,
+		SubregionId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfDied) Type() string                { return "hf died" }
 func (x *HistoricalEventHfDied) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfDied) RelatedToHf(id int) bool     { return x.Hfid == id || x.SlayerHfid == id }
@@ -10946,46 +12007,46 @@ func (x *HistoricalEventHfDied) RelatedToIdentity(id int) bool          { return
 
 func (x *HistoricalEventHfDied) CheckFields() {
 	if x.ShooterArtifactId != x.FeatureLayerId {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "FeatureLayerId")
 	}
 	if x.ShooterArtifactId != x.Hfid {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "Hfid")
 	}
 	if x.ShooterArtifactId != x.SiteId {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "SiteId")
 	}
 	if x.ShooterArtifactId != x.SlayerHfid {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["SlayerHfid"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "SlayerHfid")
 	}
 	if x.ShooterArtifactId != x.SlayerItemId {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["SlayerItemId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "SlayerItemId")
 	}
 	if x.ShooterArtifactId != x.SlayerShooterItemId {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["SlayerShooterItemId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "SlayerShooterItemId")
 	}
 	if x.ShooterArtifactId != x.SubregionId {
-		sameFields["HistoricalEventHfDied"]["ShooterArtifactId"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterArtifactId", "SubregionId")
 	}
 	if x.ShooterItem != x.FeatureLayerId {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "FeatureLayerId")
 	}
 	if x.ShooterItem != x.Hfid {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "Hfid")
 	}
 	if x.ShooterItem != x.SiteId {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "SiteId")
 	}
 	if x.ShooterItem != x.SlayerHfid {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["SlayerHfid"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "SlayerHfid")
 	}
 	if x.ShooterItem != x.SlayerItemId {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["SlayerItemId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "SlayerItemId")
 	}
 	if x.ShooterItem != x.SlayerShooterItemId {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["SlayerShooterItemId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "SlayerShooterItemId")
 	}
 	if x.ShooterItem != x.SubregionId {
-		sameFields["HistoricalEventHfDied"]["ShooterItem"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventHfDied", "ShooterItem", "SubregionId")
 	}
 }
 
@@ -11077,6 +12138,16 @@ func NewHistoricalEventHfDisturbedStructure() *HistoricalEventHfDisturbedStructu
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfDisturbedStructure() *HistoricalEventHfDisturbedStructure {
+	x := a.historicalEventHfDisturbedStructure.new()
+	*x = HistoricalEventHfDisturbedStructure{
+		HistFigId:   -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfDisturbedStructure) Type() string                  { return "hf disturbed structure" }
 func (x *HistoricalEventHfDisturbedStructure) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventHfDisturbedStructure) RelatedToHf(id int) bool       { return false }
@@ -11133,6 +12204,18 @@ func NewHistoricalEventHfDoesInteraction() *HistoricalEventHfDoesInteraction {
 		TargetHfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfDoesInteraction() *HistoricalEventHfDoesInteraction {
+	x := a.historicalEventHfDoesInteraction.new()
+	*x = HistoricalEventHfDoesInteraction{
+		DoerHfid:   -1,
+		Region:     -1,
+		Site:       -1,
+		Source:     -1,
+		TargetHfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfDoesInteraction) Type() string                { return "hf does interaction" }
 func (x *HistoricalEventHfDoesInteraction) RelatedToEntity(id int) bool { return x.Source == id }
 func (x *HistoricalEventHfDoesInteraction) RelatedToHf(id int) bool {
@@ -11152,25 +12235,25 @@ func (x *HistoricalEventHfDoesInteraction) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventHfDoesInteraction) CheckFields() {
 	if x.InteractionAction != x.Interaction && x.InteractionAction != "" && x.Interaction != "" {
-		sameFields["HistoricalEventHfDoesInteraction"]["InteractionAction"]["Interaction"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "InteractionAction", "Interaction")
 	}
 	if x.Region != x.DoerHfid {
-		sameFields["HistoricalEventHfDoesInteraction"]["Region"]["DoerHfid"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "Region", "DoerHfid")
 	}
 	if x.Region != x.TargetHfid {
-		sameFields["HistoricalEventHfDoesInteraction"]["Region"]["TargetHfid"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "Region", "TargetHfid")
 	}
 	if x.Site != x.DoerHfid {
-		sameFields["HistoricalEventHfDoesInteraction"]["Site"]["DoerHfid"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "Site", "DoerHfid")
 	}
 	if x.Site != x.TargetHfid {
-		sameFields["HistoricalEventHfDoesInteraction"]["Site"]["TargetHfid"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "Site", "TargetHfid")
 	}
 	if x.Source != x.DoerHfid {
-		sameFields["HistoricalEventHfDoesInteraction"]["Source"]["DoerHfid"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "Source", "DoerHfid")
 	}
 	if x.Source != x.TargetHfid {
-		sameFields["HistoricalEventHfDoesInteraction"]["Source"]["TargetHfid"] = false
+		fieldsDiffer("HistoricalEventHfDoesInteraction", "Source", "TargetHfid")
 	}
 }
 
@@ -11211,6 +12294,17 @@ func NewHistoricalEventHfEnslaved() *HistoricalEventHfEnslaved {
 		SellerHfid:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfEnslaved() *HistoricalEventHfEnslaved {
+	x := a.historicalEventHfEnslaved.new()
+	*x = HistoricalEventHfEnslaved{
+		EnslavedHfid:  -1,
+		MovedToSiteId: -1,
+		PayerEntityId: -1,
+		SellerHfid:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfEnslaved) Type() string                { return "hf enslaved" }
 func (x *HistoricalEventHfEnslaved) RelatedToEntity(id int) bool { return x.PayerEntityId == id }
 func (x *HistoricalEventHfEnslaved) RelatedToHf(id int) bool {
@@ -11267,6 +12361,19 @@ func NewHistoricalEventHfEquipmentPurchase() *HistoricalEventHfEquipmentPurchase
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfEquipmentPurchase() *HistoricalEventHfEquipmentPurchase {
+	x := a.historicalEventHfEquipmentPurchase.new()
+	*x = HistoricalEventHfEquipmentPurchase{
+		FeatureLayerId: -1,
+		GroupHfid:      -1,
+		Quality:        -1,
+		SiteId:         -1,
+		StructureId:    -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfEquipmentPurchase) Type() string                  { return "hf equipment purchase" }
 func (x *HistoricalEventHfEquipmentPurchase) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventHfEquipmentPurchase) RelatedToHf(id int) bool       { return x.GroupHfid == id }
@@ -11328,6 +12435,18 @@ func NewHistoricalEventHfFreed() *HistoricalEventHfFreed {
 		SiteId:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfFreed() *HistoricalEventHfFreed {
+	x := a.historicalEventHfFreed.new()
+	*x = HistoricalEventHfFreed{
+		FreeingCivId: -1,
+		FreeingHfid:  -1,
+		HoldingCivId: -1,
+		SiteCivId:    -1,
+		SiteId:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfFreed) Type() string { return "hf freed" }
 func (x *HistoricalEventHfFreed) RelatedToEntity(id int) bool {
 	return x.FreeingCivId == id || x.HoldingCivId == id || x.SiteCivId == id
@@ -11408,6 +12527,14 @@ func NewHistoricalEventHfGainsSecretGoal() *HistoricalEventHfGainsSecretGoal {
 		Hfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfGainsSecretGoal() *HistoricalEventHfGainsSecretGoal {
+	x := a.historicalEventHfGainsSecretGoal.new()
+	*x = HistoricalEventHfGainsSecretGoal{
+		Hfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfGainsSecretGoal) Type() string                           { return "hf gains secret goal" }
 func (x *HistoricalEventHfGainsSecretGoal) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHfGainsSecretGoal) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -11454,6 +12581,17 @@ func NewHistoricalEventHfInterrogated() *HistoricalEventHfInterrogated {
 		TargetHfid:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfInterrogated() *HistoricalEventHfInterrogated {
+	x := a.historicalEventHfInterrogated.new()
+	*x = HistoricalEventHfInterrogated{
+		ArrestingEnid:    -1,
+		ImplicatedHfid:   -1,
+		InterrogatorHfid: -1,
+		TargetHfid:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfInterrogated) Type() string                { return "hf interrogated" }
 func (x *HistoricalEventHfInterrogated) RelatedToEntity(id int) bool { return x.ArrestingEnid == id }
 func (x *HistoricalEventHfInterrogated) RelatedToHf(id int) bool {
@@ -11537,6 +12675,17 @@ func NewHistoricalEventHfLearnsSecret() *HistoricalEventHfLearnsSecret {
 		Unk1:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfLearnsSecret() *HistoricalEventHfLearnsSecret {
+	x := a.historicalEventHfLearnsSecret.new()
+	*x = HistoricalEventHfLearnsSecret{
+		ArtifactId:  -1,
+		StudentHfid: -1,
+		TeacherHfid: -1,
+		Unk1:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfLearnsSecret) Type() string                { return "hf learns secret" }
 func (x *HistoricalEventHfLearnsSecret) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfLearnsSecret) RelatedToHf(id int) bool {
@@ -11556,13 +12705,13 @@ func (x *HistoricalEventHfLearnsSecret) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventHfLearnsSecret) CheckFields() {
 	if x.Unk1 != x.ArtifactId {
-		sameFields["HistoricalEventHfLearnsSecret"]["Unk1"]["ArtifactId"] = false
+		fieldsDiffer("HistoricalEventHfLearnsSecret", "Unk1", "ArtifactId")
 	}
 	if x.Unk1 != x.StudentHfid {
-		sameFields["HistoricalEventHfLearnsSecret"]["Unk1"]["StudentHfid"] = false
+		fieldsDiffer("HistoricalEventHfLearnsSecret", "Unk1", "StudentHfid")
 	}
 	if x.Unk1 != x.TeacherHfid {
-		sameFields["HistoricalEventHfLearnsSecret"]["Unk1"]["TeacherHfid"] = false
+		fieldsDiffer("HistoricalEventHfLearnsSecret", "Unk1", "TeacherHfid")
 	}
 }
 
@@ -11588,7 +12737,8 @@ func (x *HistoricalEventHfLearnsSecret) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventHfNewPet struct {
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
 	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
 	GroupHfid      int    `json:"groupHfid" legend:"base" related:""`      // group_hfid
 	Pets           string `json:"pets" legend:"plus" related:""`           // pets
@@ -11604,6 +12754,18 @@ func NewHistoricalEventHfNewPet() *HistoricalEventHfNewPet {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfNewPet() *HistoricalEventHfNewPet {
+	x := a.historicalEventHfNewPet.new()
+	*x = HistoricalEventHfNewPet{
+		FeatureLayerId: -1,
+		GroupHfid:      -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventHfNewPet) Coordinates() []Coord                   { return coordinates(x.coords, x.Coords) }
 func (x *HistoricalEventHfNewPet) Type() string                           { return "hf new pet" }
 func (x *HistoricalEventHfNewPet) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHfNewPet) RelatedToHf(id int) bool                { return x.GroupHfid == id }
@@ -11621,7 +12783,7 @@ func (x *HistoricalEventHfNewPet) RelatedToIdentity(id int) bool          { retu
 
 func (x *HistoricalEventHfNewPet) CheckFields() {
 	if x.Pets != x.Coords && x.Pets != "" && x.Coords != "" {
-		sameFields["HistoricalEventHfNewPet"]["Pets"]["Coords"] = false
+		fieldsDiffer("HistoricalEventHfNewPet", "Pets", "Coords")
 	}
 }
 
@@ -11661,6 +12823,18 @@ func NewHistoricalEventHfPerformedHorribleExperiments() *HistoricalEventHfPerfor
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfPerformedHorribleExperiments() *HistoricalEventHfPerformedHorribleExperiments {
+	x := a.historicalEventHfPerformedHorribleExperiments.new()
+	*x = HistoricalEventHfPerformedHorribleExperiments{
+		FeatureLayerId: -1,
+		GroupHfid:      -1,
+		SiteId:         -1,
+		StructureId:    -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfPerformedHorribleExperiments) Type() string {
 	return "hf performed horrible experiments"
 }
@@ -11758,6 +12932,16 @@ func NewHistoricalEventHfPrayedInsideStructure() *HistoricalEventHfPrayedInsideS
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfPrayedInsideStructure() *HistoricalEventHfPrayedInsideStructure {
+	x := a.historicalEventHfPrayedInsideStructure.new()
+	*x = HistoricalEventHfPrayedInsideStructure{
+		HistFigId:   -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfPrayedInsideStructure) Type() string                  { return "hf prayed inside structure" }
 func (x *HistoricalEventHfPrayedInsideStructure) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventHfPrayedInsideStructure) RelatedToHf(id int) bool       { return false }
@@ -11845,6 +13029,17 @@ func NewHistoricalEventHfPreach() *HistoricalEventHfPreach {
 		SpeakerHfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfPreach() *HistoricalEventHfPreach {
+	x := a.historicalEventHfPreach.new()
+	*x = HistoricalEventHfPreach{
+		Entity1:     -1,
+		Entity2:     -1,
+		SiteHfid:    -1,
+		SpeakerHfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfPreach) Type() string { return "hf preach" }
 func (x *HistoricalEventHfPreach) RelatedToEntity(id int) bool {
 	return x.Entity1 == id || x.Entity2 == id
@@ -11926,6 +13121,16 @@ func NewHistoricalEventHfProfanedStructure() *HistoricalEventHfProfanedStructure
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfProfanedStructure() *HistoricalEventHfProfanedStructure {
+	x := a.historicalEventHfProfanedStructure.new()
+	*x = HistoricalEventHfProfanedStructure{
+		HistFigId:   -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfProfanedStructure) Type() string                  { return "hf profaned structure" }
 func (x *HistoricalEventHfProfanedStructure) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventHfProfanedStructure) RelatedToHf(id int) bool       { return false }
@@ -11980,6 +13185,18 @@ func NewHistoricalEventHfRansomed() *HistoricalEventHfRansomed {
 		RansomerHfid:  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfRansomed() *HistoricalEventHfRansomed {
+	x := a.historicalEventHfRansomed.new()
+	*x = HistoricalEventHfRansomed{
+		MovedToSiteId: -1,
+		PayerEntityId: -1,
+		PayerHfid:     -1,
+		RansomedHfid:  -1,
+		RansomerHfid:  -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfRansomed) Type() string                { return "hf ransomed" }
 func (x *HistoricalEventHfRansomed) RelatedToEntity(id int) bool { return x.PayerEntityId == id }
 func (x *HistoricalEventHfRansomed) RelatedToHf(id int) bool {
@@ -12021,12 +13238,13 @@ func (x *HistoricalEventHfRansomed) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventHfReachSummit struct {
-	Coords         string `json:"coords" legend:"base" related:""`                // coords
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""`        // feature_layer_id
-	Group          []int  `json:"group" legend:"plus" related:""`                 // group
-	GroupHfid      []int  `json:"groupHfid" legend:"base" related:""`             // group_hfid
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`           // subregion_id
-	MountainPeakId int    `json:"mountainPeakId" legend:"add" related:"mountain"` // MountainPeakId
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
+	FeatureLayerId int   `json:"featureLayerId" legend:"base" related:""`        // feature_layer_id
+	Group          []int `json:"group" legend:"plus" related:""`                 // group
+	GroupHfid      []int `json:"groupHfid" legend:"base" related:""`             // group_hfid
+	SubregionId    int   `json:"subregionId" legend:"base" related:""`           // subregion_id
+	MountainPeakId int   `json:"mountainPeakId" legend:"add" related:"mountain"` // MountainPeakId
 }
 
 func NewHistoricalEventHfReachSummit() *HistoricalEventHfReachSummit {
@@ -12036,6 +13254,17 @@ func NewHistoricalEventHfReachSummit() *HistoricalEventHfReachSummit {
 		MountainPeakId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfReachSummit() *HistoricalEventHfReachSummit {
+	x := a.historicalEventHfReachSummit.new()
+	*x = HistoricalEventHfReachSummit{
+		FeatureLayerId: -1,
+		SubregionId:    -1,
+		MountainPeakId: -1,
+	}
+	return x
+}
+func (x *HistoricalEventHfReachSummit) Coordinates() []Coord                   { return coordinates(x.coords, x.Coords) }
 func (x *HistoricalEventHfReachSummit) Type() string                           { return "hf reach summit" }
 func (x *HistoricalEventHfReachSummit) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHfReachSummit) RelatedToHf(id int) bool                { return containsInt(x.GroupHfid, id) }
@@ -12116,6 +13345,18 @@ func NewHistoricalEventHfRecruitedUnitTypeForEntity() *HistoricalEventHfRecruite
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfRecruitedUnitTypeForEntity() *HistoricalEventHfRecruitedUnitTypeForEntity {
+	x := a.historicalEventHfRecruitedUnitTypeForEntity.new()
+	*x = HistoricalEventHfRecruitedUnitTypeForEntity{
+		EntityId:       -1,
+		FeatureLayerId: -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfRecruitedUnitTypeForEntity) Type() string {
 	return "hf recruited unit type for entity"
 }
@@ -12251,6 +13492,19 @@ func NewHistoricalEventHfRelationshipDenied() *HistoricalEventHfRelationshipDeni
 		TargetHfid:     -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfRelationshipDenied() *HistoricalEventHfRelationshipDenied {
+	x := a.historicalEventHfRelationshipDenied.new()
+	*x = HistoricalEventHfRelationshipDenied{
+		FeatureLayerId: -1,
+		ReasonId:       -1,
+		SeekerHfid:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+		TargetHfid:     -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfRelationshipDenied) Type() string                { return "hf relationship denied" }
 func (x *HistoricalEventHfRelationshipDenied) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfRelationshipDenied) RelatedToHf(id int) bool {
@@ -12318,6 +13572,17 @@ func NewHistoricalEventHfReunion() *HistoricalEventHfReunion {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfReunion() *HistoricalEventHfReunion {
+	x := a.historicalEventHfReunion.new()
+	*x = HistoricalEventHfReunion{
+		FeatureLayerId: -1,
+		Group1Hfid:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfReunion) Type() string                { return "hf reunion" }
 func (x *HistoricalEventHfReunion) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfReunion) RelatedToHf(id int) bool {
@@ -12453,6 +13718,18 @@ func NewHistoricalEventHfRevived() *HistoricalEventHfRevived {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfRevived() *HistoricalEventHfRevived {
+	x := a.historicalEventHfRevived.new()
+	*x = HistoricalEventHfRevived{
+		ActorHfid:      -1,
+		FeatureLayerId: -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfRevived) Type() string                           { return "hf revived" }
 func (x *HistoricalEventHfRevived) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHfRevived) RelatedToHf(id int) bool                { return x.ActorHfid == id || x.Hfid == id }
@@ -12596,6 +13873,18 @@ func NewHistoricalEventHfSimpleBattleEvent() *HistoricalEventHfSimpleBattleEvent
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfSimpleBattleEvent() *HistoricalEventHfSimpleBattleEvent {
+	x := a.historicalEventHfSimpleBattleEvent.new()
+	*x = HistoricalEventHfSimpleBattleEvent{
+		FeatureLayerId: -1,
+		Group1Hfid:     -1,
+		Group2Hfid:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfSimpleBattleEvent) Type() string                { return "hf simple battle event" }
 func (x *HistoricalEventHfSimpleBattleEvent) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfSimpleBattleEvent) RelatedToHf(id int) bool {
@@ -12640,12 +13929,13 @@ func (x *HistoricalEventHfSimpleBattleEvent) MarshalJSON() ([]byte, error) {
 }
 
 type HistoricalEventHfTravel struct {
-	Coords         string `json:"coords" legend:"base" related:""`         // coords
-	FeatureLayerId int    `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
-	GroupHfid      []int  `json:"groupHfid" legend:"base" related:""`      // group_hfid
-	Return         bool   `json:"return" legend:"base" related:""`         // return
-	SiteId         int    `json:"siteId" legend:"base" related:""`         // site_id
-	SubregionId    int    `json:"subregionId" legend:"base" related:""`    // subregion_id
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
+	FeatureLayerId int   `json:"featureLayerId" legend:"base" related:""` // feature_layer_id
+	GroupHfid      []int `json:"groupHfid" legend:"base" related:""`      // group_hfid
+	Return         bool  `json:"return" legend:"base" related:""`         // return
+	SiteId         int   `json:"siteId" legend:"base" related:""`         // site_id
+	SubregionId    int   `json:"subregionId" legend:"base" related:""`    // subregion_id
 }
 
 func NewHistoricalEventHfTravel() *HistoricalEventHfTravel {
@@ -12655,6 +13945,17 @@ func NewHistoricalEventHfTravel() *HistoricalEventHfTravel {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfTravel() *HistoricalEventHfTravel {
+	x := a.historicalEventHfTravel.new()
+	*x = HistoricalEventHfTravel{
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
+func (x *HistoricalEventHfTravel) Coordinates() []Coord                   { return coordinates(x.coords, x.Coords) }
 func (x *HistoricalEventHfTravel) Type() string                           { return "hf travel" }
 func (x *HistoricalEventHfTravel) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHfTravel) RelatedToHf(id int) bool                { return containsInt(x.GroupHfid, id) }
@@ -12705,6 +14006,17 @@ func NewHistoricalEventHfViewedArtifact() *HistoricalEventHfViewedArtifact {
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfViewedArtifact() *HistoricalEventHfViewedArtifact {
+	x := a.historicalEventHfViewedArtifact.new()
+	*x = HistoricalEventHfViewedArtifact{
+		ArtifactId:  -1,
+		HistFigId:   -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfViewedArtifact) Type() string                  { return "hf viewed artifact" }
 func (x *HistoricalEventHfViewedArtifact) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventHfViewedArtifact) RelatedToHf(id int) bool       { return false }
@@ -12847,6 +14159,21 @@ func NewHistoricalEventHfWounded() *HistoricalEventHfWounded {
 		WounderHfid:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfWounded() *HistoricalEventHfWounded {
+	x := a.historicalEventHfWounded.new()
+	*x = HistoricalEventHfWounded{
+		BodyPart:       -1,
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+		WoundeeCaste:   -1,
+		WoundeeHfid:    -1,
+		WoundeeRace:    -1,
+		WounderHfid:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfWounded) Type() string                { return "hf wounded" }
 func (x *HistoricalEventHfWounded) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventHfWounded) RelatedToHf(id int) bool {
@@ -12866,49 +14193,49 @@ func (x *HistoricalEventHfWounded) RelatedToIdentity(id int) bool          { ret
 
 func (x *HistoricalEventHfWounded) CheckFields() {
 	if x.BodyPart != x.FeatureLayerId {
-		sameFields["HistoricalEventHfWounded"]["BodyPart"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "BodyPart", "FeatureLayerId")
 	}
 	if x.BodyPart != x.SiteId {
-		sameFields["HistoricalEventHfWounded"]["BodyPart"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "BodyPart", "SiteId")
 	}
 	if x.BodyPart != x.SubregionId {
-		sameFields["HistoricalEventHfWounded"]["BodyPart"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "BodyPart", "SubregionId")
 	}
 	if x.BodyPart != x.WoundeeHfid {
-		sameFields["HistoricalEventHfWounded"]["BodyPart"]["WoundeeHfid"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "BodyPart", "WoundeeHfid")
 	}
 	if x.BodyPart != x.WounderHfid {
-		sameFields["HistoricalEventHfWounded"]["BodyPart"]["WounderHfid"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "BodyPart", "WounderHfid")
 	}
 	if x.WoundeeCaste != x.FeatureLayerId {
-		sameFields["HistoricalEventHfWounded"]["WoundeeCaste"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeCaste", "FeatureLayerId")
 	}
 	if x.WoundeeCaste != x.SiteId {
-		sameFields["HistoricalEventHfWounded"]["WoundeeCaste"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeCaste", "SiteId")
 	}
 	if x.WoundeeCaste != x.SubregionId {
-		sameFields["HistoricalEventHfWounded"]["WoundeeCaste"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeCaste", "SubregionId")
 	}
 	if x.WoundeeCaste != x.WoundeeHfid {
-		sameFields["HistoricalEventHfWounded"]["WoundeeCaste"]["WoundeeHfid"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeCaste", "WoundeeHfid")
 	}
 	if x.WoundeeCaste != x.WounderHfid {
-		sameFields["HistoricalEventHfWounded"]["WoundeeCaste"]["WounderHfid"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeCaste", "WounderHfid")
 	}
 	if x.WoundeeRace != x.FeatureLayerId {
-		sameFields["HistoricalEventHfWounded"]["WoundeeRace"]["FeatureLayerId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeRace", "FeatureLayerId")
 	}
 	if x.WoundeeRace != x.SiteId {
-		sameFields["HistoricalEventHfWounded"]["WoundeeRace"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeRace", "SiteId")
 	}
 	if x.WoundeeRace != x.SubregionId {
-		sameFields["HistoricalEventHfWounded"]["WoundeeRace"]["SubregionId"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeRace", "SubregionId")
 	}
 	if x.WoundeeRace != x.WoundeeHfid {
-		sameFields["HistoricalEventHfWounded"]["WoundeeRace"]["WoundeeHfid"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeRace", "WoundeeHfid")
 	}
 	if x.WoundeeRace != x.WounderHfid {
-		sameFields["HistoricalEventHfWounded"]["WoundeeRace"]["WounderHfid"] = false
+		fieldsDiffer("HistoricalEventHfWounded", "WoundeeRace", "WounderHfid")
 	}
 }
 
@@ -13351,6 +14678,33 @@ func NewHistoricalEventHfsFormedIntrigueRelationship() *HistoricalEventHfsFormed
 		TopValueRating:            -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfsFormedIntrigueRelationship() *HistoricalEventHfsFormedIntrigueRelationship {
+	x := a.historicalEventHfsFormedIntrigueRelationship.new()
+	*x = HistoricalEventHfsFormedIntrigueRelationship{
+		AllyDefenseBonus:          -1,
+		CircumstanceId:            -1,
+		CoconspiratorBonus:        -1,
+		CorruptorHfid:             -1,
+		CorruptorIdentity:         -1,
+		FeatureLayerId:            -1,
+		LureHfid:                  -1,
+		RelevantEntityId:          -1,
+		RelevantIdForMethod:       -1,
+		RelevantPositionProfileId: -1,
+		SiteId:                    -1,
+		SubregionId:               -1,
+		TargetHfid:                -1,
+		TargetIdentity:            -1,
+		TopFacetModifier:          -1,
+		TopFacetRating:            -1,
+		TopRelationshipModifier:   -1,
+		TopRelationshipRating:     -1,
+		TopValueModifier:          -1,
+		TopValueRating:            -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfsFormedIntrigueRelationship) Type() string {
 	return "hfs formed intrigue relationship"
 }
@@ -13562,6 +14916,20 @@ func NewHistoricalEventHfsFormedReputationRelationship() *HistoricalEventHfsForm
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHfsFormedReputationRelationship() *HistoricalEventHfsFormedReputationRelationship {
+	x := a.historicalEventHfsFormedReputationRelationship.new()
+	*x = HistoricalEventHfsFormedReputationRelationship{
+		FeatureLayerId: -1,
+		Hfid1:          -1,
+		Hfid2:          -1,
+		IdentityId1:    -1,
+		IdentityId2:    -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventHfsFormedReputationRelationship) Type() string {
 	return "hfs formed reputation relationship"
 }
@@ -13645,6 +15013,15 @@ func NewHistoricalEventHolyCityDeclaration() *HistoricalEventHolyCityDeclaration
 		SiteId:     -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventHolyCityDeclaration() *HistoricalEventHolyCityDeclaration {
+	x := a.historicalEventHolyCityDeclaration.new()
+	*x = HistoricalEventHolyCityDeclaration{
+		ReligionId: -1,
+		SiteId:     -1,
+	}
+	return x
+}
 func (x *HistoricalEventHolyCityDeclaration) Type() string                           { return "holy city declaration" }
 func (x *HistoricalEventHolyCityDeclaration) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventHolyCityDeclaration) RelatedToHf(id int) bool                { return false }
@@ -13718,6 +15095,15 @@ func NewHistoricalEventInsurrectionStarted() *HistoricalEventInsurrectionStarted
 		TargetCivId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventInsurrectionStarted() *HistoricalEventInsurrectionStarted {
+	x := a.historicalEventInsurrectionStarted.new()
+	*x = HistoricalEventInsurrectionStarted{
+		SiteId:      -1,
+		TargetCivId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventInsurrectionStarted) Type() string                           { return "insurrection started" }
 func (x *HistoricalEventInsurrectionStarted) RelatedToEntity(id int) bool            { return x.TargetCivId == id }
 func (x *HistoricalEventInsurrectionStarted) RelatedToHf(id int) bool                { return false }
@@ -13822,6 +15208,22 @@ func NewHistoricalEventItemStolen() *HistoricalEventItemStolen {
 		Structure:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventItemStolen() *HistoricalEventItemStolen {
+	x := a.historicalEventItemStolen.new()
+	*x = HistoricalEventItemStolen{
+		CircumstanceId: -1,
+		Entity:         -1,
+		Histfig:        -1,
+		Item:           -1,
+		Matindex:       -1,
+		Mattype:        -1,
+		Site:           -1,
+		StashSite:      -1,
+		Structure:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventItemStolen) Type() string                  { return "item stolen" }
 func (x *HistoricalEventItemStolen) RelatedToEntity(id int) bool   { return x.Entity == id }
 func (x *HistoricalEventItemStolen) RelatedToHf(id int) bool       { return x.Histfig == id }
@@ -13843,28 +15245,28 @@ func (x *HistoricalEventItemStolen) RelatedToIdentity(id int) bool          { re
 
 func (x *HistoricalEventItemStolen) CheckFields() {
 	if x.Entity != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Entity"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Entity", "CircumstanceId")
 	}
 	if x.Histfig != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Histfig"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Histfig", "CircumstanceId")
 	}
 	if x.Item != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Item"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Item", "CircumstanceId")
 	}
 	if x.Matindex != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Matindex"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Matindex", "CircumstanceId")
 	}
 	if x.Mattype != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Mattype"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Mattype", "CircumstanceId")
 	}
 	if x.Site != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Site"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Site", "CircumstanceId")
 	}
 	if x.StashSite != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["StashSite"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "StashSite", "CircumstanceId")
 	}
 	if x.Structure != x.CircumstanceId {
-		sameFields["HistoricalEventItemStolen"]["Structure"]["CircumstanceId"] = false
+		fieldsDiffer("HistoricalEventItemStolen", "Structure", "CircumstanceId")
 	}
 }
 
@@ -13964,6 +15366,16 @@ func NewHistoricalEventItemStolenCircumstance() *HistoricalEventItemStolenCircum
 	}
 }
 
+func (a *arenas) newHistoricalEventItemStolenCircumstance() *HistoricalEventItemStolenCircumstance {
+	x := a.historicalEventItemStolenCircumstance.new()
+	*x = HistoricalEventItemStolenCircumstance{
+		Defeated:            -1,
+		HistEventCollection: -1,
+		Murdered:            -1,
+	}
+	return x
+}
+
 func (x *HistoricalEventItemStolenCircumstance) CheckFields() {
 }
 
@@ -15437,6 +16849,14 @@ func NewHistoricalEventKnowledgeDiscovered() *HistoricalEventKnowledgeDiscovered
 		Hfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventKnowledgeDiscovered() *HistoricalEventKnowledgeDiscovered {
+	x := a.historicalEventKnowledgeDiscovered.new()
+	*x = HistoricalEventKnowledgeDiscovered{
+		Hfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventKnowledgeDiscovered) Type() string                           { return "knowledge discovered" }
 func (x *HistoricalEventKnowledgeDiscovered) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventKnowledgeDiscovered) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -15668,6 +17088,18 @@ func NewHistoricalEventMasterpieceArchConstructed() *HistoricalEventMasterpieceA
 		Unk2:           -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceArchConstructed() *HistoricalEventMasterpieceArchConstructed {
+	x := a.historicalEventMasterpieceArchConstructed.new()
+	*x = HistoricalEventMasterpieceArchConstructed{
+		BuildingCustom: -1,
+		EntityId:       -1,
+		Hfid:           -1,
+		SiteId:         -1,
+		Unk2:           -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceArchConstructed) Type() string {
 	return "masterpiece arch constructed"
 }
@@ -15695,22 +17127,22 @@ func (x *HistoricalEventMasterpieceArchConstructed) RelatedToIdentity(id int) bo
 
 func (x *HistoricalEventMasterpieceArchConstructed) CheckFields() {
 	if x.BuildingCustom != x.EntityId {
-		sameFields["HistoricalEventMasterpieceArchConstructed"]["BuildingCustom"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceArchConstructed", "BuildingCustom", "EntityId")
 	}
 	if x.BuildingCustom != x.Hfid {
-		sameFields["HistoricalEventMasterpieceArchConstructed"]["BuildingCustom"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceArchConstructed", "BuildingCustom", "Hfid")
 	}
 	if x.BuildingCustom != x.SiteId {
-		sameFields["HistoricalEventMasterpieceArchConstructed"]["BuildingCustom"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceArchConstructed", "BuildingCustom", "SiteId")
 	}
 	if x.Unk2 != x.EntityId {
-		sameFields["HistoricalEventMasterpieceArchConstructed"]["Unk2"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceArchConstructed", "Unk2", "EntityId")
 	}
 	if x.Unk2 != x.Hfid {
-		sameFields["HistoricalEventMasterpieceArchConstructed"]["Unk2"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceArchConstructed", "Unk2", "Hfid")
 	}
 	if x.Unk2 != x.SiteId {
-		sameFields["HistoricalEventMasterpieceArchConstructed"]["Unk2"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceArchConstructed", "Unk2", "SiteId")
 	}
 }
 
@@ -15853,6 +17285,22 @@ func NewHistoricalEventMasterpieceDye() *HistoricalEventMasterpieceDye {
 		Unk2:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceDye() *HistoricalEventMasterpieceDye {
+	x := a.historicalEventMasterpieceDye.new()
+	*x = HistoricalEventMasterpieceDye{
+		DyeMatIndex: -1,
+		EntityId:    -1,
+		Hfid:        -1,
+		Maker:       -1,
+		MakerEntity: -1,
+		MatIndex:    -1,
+		Site:        -1,
+		SiteId:      -1,
+		Unk2:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceDye) Type() string { return "masterpiece dye" }
 func (x *HistoricalEventMasterpieceDye) RelatedToEntity(id int) bool {
 	return x.EntityId == id || x.MakerEntity == id
@@ -15874,58 +17322,58 @@ func (x *HistoricalEventMasterpieceDye) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventMasterpieceDye) CheckFields() {
 	if x.DyeMatIndex != x.EntityId {
-		sameFields["HistoricalEventMasterpieceDye"]["DyeMatIndex"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "DyeMatIndex", "EntityId")
 	}
 	if x.DyeMatIndex != x.Hfid {
-		sameFields["HistoricalEventMasterpieceDye"]["DyeMatIndex"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "DyeMatIndex", "Hfid")
 	}
 	if x.DyeMatIndex != x.SiteId {
-		sameFields["HistoricalEventMasterpieceDye"]["DyeMatIndex"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "DyeMatIndex", "SiteId")
 	}
 	if x.Maker != x.EntityId {
-		sameFields["HistoricalEventMasterpieceDye"]["Maker"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Maker", "EntityId")
 	}
 	if x.Maker != x.Hfid {
-		sameFields["HistoricalEventMasterpieceDye"]["Maker"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Maker", "Hfid")
 	}
 	if x.Maker != x.SiteId {
-		sameFields["HistoricalEventMasterpieceDye"]["Maker"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Maker", "SiteId")
 	}
 	if x.MakerEntity != x.EntityId {
-		sameFields["HistoricalEventMasterpieceDye"]["MakerEntity"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "MakerEntity", "EntityId")
 	}
 	if x.MakerEntity != x.Hfid {
-		sameFields["HistoricalEventMasterpieceDye"]["MakerEntity"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "MakerEntity", "Hfid")
 	}
 	if x.MakerEntity != x.SiteId {
-		sameFields["HistoricalEventMasterpieceDye"]["MakerEntity"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "MakerEntity", "SiteId")
 	}
 	if x.MatIndex != x.EntityId {
-		sameFields["HistoricalEventMasterpieceDye"]["MatIndex"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "MatIndex", "EntityId")
 	}
 	if x.MatIndex != x.Hfid {
-		sameFields["HistoricalEventMasterpieceDye"]["MatIndex"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "MatIndex", "Hfid")
 	}
 	if x.MatIndex != x.SiteId {
-		sameFields["HistoricalEventMasterpieceDye"]["MatIndex"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "MatIndex", "SiteId")
 	}
 	if x.Site != x.EntityId {
-		sameFields["HistoricalEventMasterpieceDye"]["Site"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Site", "EntityId")
 	}
 	if x.Site != x.Hfid {
-		sameFields["HistoricalEventMasterpieceDye"]["Site"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Site", "Hfid")
 	}
 	if x.Site != x.SiteId {
-		sameFields["HistoricalEventMasterpieceDye"]["Site"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Site", "SiteId")
 	}
 	if x.Unk2 != x.EntityId {
-		sameFields["HistoricalEventMasterpieceDye"]["Unk2"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Unk2", "EntityId")
 	}
 	if x.Unk2 != x.Hfid {
-		sameFields["HistoricalEventMasterpieceDye"]["Unk2"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Unk2", "Hfid")
 	}
 	if x.Unk2 != x.SiteId {
-		sameFields["HistoricalEventMasterpieceDye"]["Unk2"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceDye", "Unk2", "SiteId")
 	}
 }
 
@@ -15989,6 +17437,18 @@ func NewHistoricalEventMasterpieceEngraving() *HistoricalEventMasterpieceEngravi
 		SiteId:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceEngraving() *HistoricalEventMasterpieceEngraving {
+	x := a.historicalEventMasterpieceEngraving.new()
+	*x = HistoricalEventMasterpieceEngraving{
+		ArtId:    -1,
+		ArtSubid: -1,
+		EntityId: -1,
+		Hfid:     -1,
+		SiteId:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceEngraving) Type() string                           { return "masterpiece engraving" }
 func (x *HistoricalEventMasterpieceEngraving) RelatedToEntity(id int) bool            { return x.EntityId == id }
 func (x *HistoricalEventMasterpieceEngraving) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -16006,22 +17466,22 @@ func (x *HistoricalEventMasterpieceEngraving) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventMasterpieceEngraving) CheckFields() {
 	if x.ArtId != x.EntityId {
-		sameFields["HistoricalEventMasterpieceEngraving"]["ArtId"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceEngraving", "ArtId", "EntityId")
 	}
 	if x.ArtId != x.Hfid {
-		sameFields["HistoricalEventMasterpieceEngraving"]["ArtId"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceEngraving", "ArtId", "Hfid")
 	}
 	if x.ArtId != x.SiteId {
-		sameFields["HistoricalEventMasterpieceEngraving"]["ArtId"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceEngraving", "ArtId", "SiteId")
 	}
 	if x.ArtSubid != x.EntityId {
-		sameFields["HistoricalEventMasterpieceEngraving"]["ArtSubid"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceEngraving", "ArtSubid", "EntityId")
 	}
 	if x.ArtSubid != x.Hfid {
-		sameFields["HistoricalEventMasterpieceEngraving"]["ArtSubid"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceEngraving", "ArtSubid", "Hfid")
 	}
 	if x.ArtSubid != x.SiteId {
-		sameFields["HistoricalEventMasterpieceEngraving"]["ArtSubid"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceEngraving", "ArtSubid", "SiteId")
 	}
 }
 
@@ -16296,6 +17756,20 @@ func NewHistoricalEventMasterpieceFood() *HistoricalEventMasterpieceFood {
 		SiteId:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceFood() *HistoricalEventMasterpieceFood {
+	x := a.historicalEventMasterpieceFood.new()
+	*x = HistoricalEventMasterpieceFood{
+		EntityId:    -1,
+		Hfid:        -1,
+		ItemId:      -1,
+		Maker:       -1,
+		MakerEntity: -1,
+		Site:        -1,
+		SiteId:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceFood) Type() string { return "masterpiece food" }
 func (x *HistoricalEventMasterpieceFood) RelatedToEntity(id int) bool {
 	return x.EntityId == id || x.MakerEntity == id
@@ -16317,40 +17791,40 @@ func (x *HistoricalEventMasterpieceFood) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventMasterpieceFood) CheckFields() {
 	if x.ItemId != x.EntityId {
-		sameFields["HistoricalEventMasterpieceFood"]["ItemId"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "ItemId", "EntityId")
 	}
 	if x.ItemId != x.Hfid {
-		sameFields["HistoricalEventMasterpieceFood"]["ItemId"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "ItemId", "Hfid")
 	}
 	if x.ItemId != x.SiteId {
-		sameFields["HistoricalEventMasterpieceFood"]["ItemId"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "ItemId", "SiteId")
 	}
 	if x.Maker != x.EntityId {
-		sameFields["HistoricalEventMasterpieceFood"]["Maker"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "Maker", "EntityId")
 	}
 	if x.Maker != x.Hfid {
-		sameFields["HistoricalEventMasterpieceFood"]["Maker"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "Maker", "Hfid")
 	}
 	if x.Maker != x.SiteId {
-		sameFields["HistoricalEventMasterpieceFood"]["Maker"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "Maker", "SiteId")
 	}
 	if x.MakerEntity != x.EntityId {
-		sameFields["HistoricalEventMasterpieceFood"]["MakerEntity"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "MakerEntity", "EntityId")
 	}
 	if x.MakerEntity != x.Hfid {
-		sameFields["HistoricalEventMasterpieceFood"]["MakerEntity"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "MakerEntity", "Hfid")
 	}
 	if x.MakerEntity != x.SiteId {
-		sameFields["HistoricalEventMasterpieceFood"]["MakerEntity"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "MakerEntity", "SiteId")
 	}
 	if x.Site != x.EntityId {
-		sameFields["HistoricalEventMasterpieceFood"]["Site"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "Site", "EntityId")
 	}
 	if x.Site != x.Hfid {
-		sameFields["HistoricalEventMasterpieceFood"]["Site"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "Site", "Hfid")
 	}
 	if x.Site != x.SiteId {
-		sameFields["HistoricalEventMasterpieceFood"]["Site"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceFood", "Site", "SiteId")
 	}
 }
 
@@ -16412,6 +17886,19 @@ func NewHistoricalEventMasterpieceItem() *HistoricalEventMasterpieceItem {
 		SiteId:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceItem() *HistoricalEventMasterpieceItem {
+	x := a.historicalEventMasterpieceItem.new()
+	*x = HistoricalEventMasterpieceItem{
+		EntityId: -1,
+		Hfid:     -1,
+		ItemId:   -1,
+		MatIndex: -1,
+		MatType:  -1,
+		SiteId:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceItem) Type() string                           { return "masterpiece item" }
 func (x *HistoricalEventMasterpieceItem) RelatedToEntity(id int) bool            { return x.EntityId == id }
 func (x *HistoricalEventMasterpieceItem) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -16429,31 +17916,31 @@ func (x *HistoricalEventMasterpieceItem) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventMasterpieceItem) CheckFields() {
 	if x.ItemId != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItem"]["ItemId"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "ItemId", "EntityId")
 	}
 	if x.ItemId != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItem"]["ItemId"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "ItemId", "Hfid")
 	}
 	if x.ItemId != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItem"]["ItemId"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "ItemId", "SiteId")
 	}
 	if x.MatIndex != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItem"]["MatIndex"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "MatIndex", "EntityId")
 	}
 	if x.MatIndex != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItem"]["MatIndex"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "MatIndex", "Hfid")
 	}
 	if x.MatIndex != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItem"]["MatIndex"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "MatIndex", "SiteId")
 	}
 	if x.MatType != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItem"]["MatType"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "MatType", "EntityId")
 	}
 	if x.MatType != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItem"]["MatType"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "MatType", "Hfid")
 	}
 	if x.MatType != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItem"]["MatType"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItem", "MatType", "SiteId")
 	}
 }
 
@@ -16716,6 +18203,23 @@ func NewHistoricalEventMasterpieceItemImprovement() *HistoricalEventMasterpieceI
 		Unk2:               -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceItemImprovement() *HistoricalEventMasterpieceItemImprovement {
+	x := a.historicalEventMasterpieceItemImprovement.new()
+	*x = HistoricalEventMasterpieceItemImprovement{
+		ArtId:              -1,
+		ArtSubid:           -1,
+		EntityId:           -1,
+		Hfid:               -1,
+		ImprovementSubtype: -1,
+		Maker:              -1,
+		MakerEntity:        -1,
+		Site:               -1,
+		SiteId:             -1,
+		Unk2:               -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceItemImprovement) Type() string {
 	return "masterpiece item improvement"
 }
@@ -16745,67 +18249,67 @@ func (x *HistoricalEventMasterpieceItemImprovement) RelatedToIdentity(id int) bo
 
 func (x *HistoricalEventMasterpieceItemImprovement) CheckFields() {
 	if x.ArtId != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ArtId"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ArtId", "EntityId")
 	}
 	if x.ArtId != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ArtId"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ArtId", "Hfid")
 	}
 	if x.ArtId != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ArtId"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ArtId", "SiteId")
 	}
 	if x.ArtSubid != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ArtSubid"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ArtSubid", "EntityId")
 	}
 	if x.ArtSubid != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ArtSubid"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ArtSubid", "Hfid")
 	}
 	if x.ArtSubid != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ArtSubid"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ArtSubid", "SiteId")
 	}
 	if x.ImprovementSubtype != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ImprovementSubtype"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ImprovementSubtype", "EntityId")
 	}
 	if x.ImprovementSubtype != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ImprovementSubtype"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ImprovementSubtype", "Hfid")
 	}
 	if x.ImprovementSubtype != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["ImprovementSubtype"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "ImprovementSubtype", "SiteId")
 	}
 	if x.Maker != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Maker"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Maker", "EntityId")
 	}
 	if x.Maker != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Maker"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Maker", "Hfid")
 	}
 	if x.Maker != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Maker"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Maker", "SiteId")
 	}
 	if x.MakerEntity != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["MakerEntity"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "MakerEntity", "EntityId")
 	}
 	if x.MakerEntity != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["MakerEntity"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "MakerEntity", "Hfid")
 	}
 	if x.MakerEntity != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["MakerEntity"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "MakerEntity", "SiteId")
 	}
 	if x.Site != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Site"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Site", "EntityId")
 	}
 	if x.Site != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Site"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Site", "Hfid")
 	}
 	if x.Site != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Site"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Site", "SiteId")
 	}
 	if x.Unk2 != x.EntityId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Unk2"]["EntityId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Unk2", "EntityId")
 	}
 	if x.Unk2 != x.Hfid {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Unk2"]["Hfid"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Unk2", "Hfid")
 	}
 	if x.Unk2 != x.SiteId {
-		sameFields["HistoricalEventMasterpieceItemImprovement"]["Unk2"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventMasterpieceItemImprovement", "Unk2", "SiteId")
 	}
 }
 
@@ -16868,6 +18372,16 @@ func NewHistoricalEventMasterpieceLost() *HistoricalEventMasterpieceLost {
 		Site:          -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMasterpieceLost() *HistoricalEventMasterpieceLost {
+	x := a.historicalEventMasterpieceLost.new()
+	*x = HistoricalEventMasterpieceLost{
+		CreationEvent: -1,
+		Histfig:       -1,
+		Site:          -1,
+	}
+	return x
+}
 func (x *HistoricalEventMasterpieceLost) Type() string                           { return "masterpiece lost" }
 func (x *HistoricalEventMasterpieceLost) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventMasterpieceLost) RelatedToHf(id int) bool                { return x.Histfig == id }
@@ -16918,6 +18432,16 @@ func NewHistoricalEventMerchant() *HistoricalEventMerchant {
 		TraderEntityId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMerchant() *HistoricalEventMerchant {
+	x := a.historicalEventMerchant.new()
+	*x = HistoricalEventMerchant{
+		DepotEntityId:  -1,
+		SiteId:         -1,
+		TraderEntityId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventMerchant) Type() string { return "merchant" }
 func (x *HistoricalEventMerchant) RelatedToEntity(id int) bool {
 	return x.DepotEntityId == id || x.TraderEntityId == id
@@ -17012,6 +18536,16 @@ func NewHistoricalEventModifiedBuilding() *HistoricalEventModifiedBuilding {
 		StructureId:  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventModifiedBuilding() *HistoricalEventModifiedBuilding {
+	x := a.historicalEventModifiedBuilding.new()
+	*x = HistoricalEventModifiedBuilding{
+		ModifierHfid: -1,
+		SiteId:       -1,
+		StructureId:  -1,
+	}
+	return x
+}
 func (x *HistoricalEventModifiedBuilding) Type() string                  { return "modified building" }
 func (x *HistoricalEventModifiedBuilding) RelatedToEntity(id int) bool   { return false }
 func (x *HistoricalEventModifiedBuilding) RelatedToHf(id int) bool       { return x.ModifierHfid == id }
@@ -17137,6 +18671,18 @@ func NewHistoricalEventMusicalFormCreated() *HistoricalEventMusicalFormCreated {
 		SiteId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventMusicalFormCreated() *HistoricalEventMusicalFormCreated {
+	x := a.historicalEventMusicalFormCreated.new()
+	*x = HistoricalEventMusicalFormCreated{
+		CircumstanceId: -1,
+		FormId:         -1,
+		HistFigureId:   -1,
+		ReasonId:       -1,
+		SiteId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventMusicalFormCreated) Type() string                           { return "musical form created" }
 func (x *HistoricalEventMusicalFormCreated) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventMusicalFormCreated) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -17200,6 +18746,19 @@ func NewHistoricalEventNewSiteLeader() *HistoricalEventNewSiteLeader {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventNewSiteLeader() *HistoricalEventNewSiteLeader {
+	x := a.historicalEventNewSiteLeader.new()
+	*x = HistoricalEventNewSiteLeader{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		NewLeaderHfid: -1,
+		NewSiteCivId:  -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventNewSiteLeader) Type() string { return "new site leader" }
 func (x *HistoricalEventNewSiteLeader) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.NewSiteCivId == id || x.SiteCivId == id
@@ -17284,6 +18843,16 @@ func NewHistoricalEventPeaceAccepted() *HistoricalEventPeaceAccepted {
 		Source:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventPeaceAccepted() *HistoricalEventPeaceAccepted {
+	x := a.historicalEventPeaceAccepted.new()
+	*x = HistoricalEventPeaceAccepted{
+		Destination: -1,
+		SiteId:      -1,
+		Source:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventPeaceAccepted) Type() string { return "peace accepted" }
 func (x *HistoricalEventPeaceAccepted) RelatedToEntity(id int) bool {
 	return x.Destination == id || x.Source == id
@@ -17303,10 +18872,10 @@ func (x *HistoricalEventPeaceAccepted) RelatedToIdentity(id int) bool          {
 
 func (x *HistoricalEventPeaceAccepted) CheckFields() {
 	if x.Destination != x.SiteId {
-		sameFields["HistoricalEventPeaceAccepted"]["Destination"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventPeaceAccepted", "Destination", "SiteId")
 	}
 	if x.Source != x.SiteId {
-		sameFields["HistoricalEventPeaceAccepted"]["Source"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventPeaceAccepted", "Source", "SiteId")
 	}
 }
 
@@ -17368,6 +18937,16 @@ func NewHistoricalEventPeaceRejected() *HistoricalEventPeaceRejected {
 		Source:      -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventPeaceRejected() *HistoricalEventPeaceRejected {
+	x := a.historicalEventPeaceRejected.new()
+	*x = HistoricalEventPeaceRejected{
+		Destination: -1,
+		SiteId:      -1,
+		Source:      -1,
+	}
+	return x
+}
 func (x *HistoricalEventPeaceRejected) Type() string { return "peace rejected" }
 func (x *HistoricalEventPeaceRejected) RelatedToEntity(id int) bool {
 	return x.Destination == id || x.Source == id
@@ -17387,10 +18966,10 @@ func (x *HistoricalEventPeaceRejected) RelatedToIdentity(id int) bool          {
 
 func (x *HistoricalEventPeaceRejected) CheckFields() {
 	if x.Destination != x.SiteId {
-		sameFields["HistoricalEventPeaceRejected"]["Destination"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventPeaceRejected", "Destination", "SiteId")
 	}
 	if x.Source != x.SiteId {
-		sameFields["HistoricalEventPeaceRejected"]["Source"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventPeaceRejected", "Source", "SiteId")
 	}
 }
 
@@ -17430,6 +19009,19 @@ func NewHistoricalEventPerformance() *HistoricalEventPerformance {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventPerformance() *HistoricalEventPerformance {
+	x := a.historicalEventPerformance.new()
+	*x = HistoricalEventPerformance{
+		CivId:          -1,
+		FeatureLayerId: -1,
+		OccasionId:     -1,
+		ScheduleId:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventPerformance) Type() string                           { return "performance" }
 func (x *HistoricalEventPerformance) RelatedToEntity(id int) bool            { return x.CivId == id }
 func (x *HistoricalEventPerformance) RelatedToHf(id int) bool                { return false }
@@ -17491,6 +19083,17 @@ func NewHistoricalEventPlunderedSite() *HistoricalEventPlunderedSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventPlunderedSite() *HistoricalEventPlunderedSite {
+	x := a.historicalEventPlunderedSite.new()
+	*x = HistoricalEventPlunderedSite{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventPlunderedSite) Type() string { return "plundered site" }
 func (x *HistoricalEventPlunderedSite) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.SiteCivId == id
@@ -17581,6 +19184,17 @@ func NewHistoricalEventPoeticFormCreated() *HistoricalEventPoeticFormCreated {
 		SubregionId:  -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventPoeticFormCreated() *HistoricalEventPoeticFormCreated {
+	x := a.historicalEventPoeticFormCreated.new()
+	*x = HistoricalEventPoeticFormCreated{
+		FormId:       -1,
+		HistFigureId: -1,
+		SiteId:       -1,
+		SubregionId:  -1,
+	}
+	return x
+}
 func (x *HistoricalEventPoeticFormCreated) Type() string                           { return "poetic form created" }
 func (x *HistoricalEventPoeticFormCreated) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventPoeticFormCreated) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -17638,6 +19252,19 @@ func NewHistoricalEventProcession() *HistoricalEventProcession {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventProcession() *HistoricalEventProcession {
+	x := a.historicalEventProcession.new()
+	*x = HistoricalEventProcession{
+		CivId:          -1,
+		FeatureLayerId: -1,
+		OccasionId:     -1,
+		ScheduleId:     -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventProcession) Type() string                           { return "procession" }
 func (x *HistoricalEventProcession) RelatedToEntity(id int) bool            { return x.CivId == id }
 func (x *HistoricalEventProcession) RelatedToHf(id int) bool                { return false }
@@ -17692,6 +19319,16 @@ func NewHistoricalEventRazedStructure() *HistoricalEventRazedStructure {
 		StructureId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventRazedStructure() *HistoricalEventRazedStructure {
+	x := a.historicalEventRazedStructure.new()
+	*x = HistoricalEventRazedStructure{
+		CivId:       -1,
+		SiteId:      -1,
+		StructureId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventRazedStructure) Type() string                  { return "razed structure" }
 func (x *HistoricalEventRazedStructure) RelatedToEntity(id int) bool   { return x.CivId == id }
 func (x *HistoricalEventRazedStructure) RelatedToHf(id int) bool       { return false }
@@ -17740,6 +19377,16 @@ func NewHistoricalEventReclaimSite() *HistoricalEventReclaimSite {
 		SiteId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventReclaimSite() *HistoricalEventReclaimSite {
+	x := a.historicalEventReclaimSite.new()
+	*x = HistoricalEventReclaimSite{
+		CivId:     -1,
+		SiteCivId: -1,
+		SiteId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventReclaimSite) Type() string { return "reclaim site" }
 func (x *HistoricalEventReclaimSite) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.SiteCivId == id
@@ -17794,6 +19441,19 @@ func NewHistoricalEventRegionpopIncorporatedIntoEntity() *HistoricalEventRegionp
 		SiteId:         -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventRegionpopIncorporatedIntoEntity() *HistoricalEventRegionpopIncorporatedIntoEntity {
+	x := a.historicalEventRegionpopIncorporatedIntoEntity.new()
+	*x = HistoricalEventRegionpopIncorporatedIntoEntity{
+		JoinEntityId:   -1,
+		PopFlid:        -1,
+		PopNumberMoved: -1,
+		PopRace:        -1,
+		PopSrid:        -1,
+		SiteId:         -1,
+	}
+	return x
+}
 func (x *HistoricalEventRegionpopIncorporatedIntoEntity) Type() string {
 	return "regionpop incorporated into entity"
 }
@@ -17974,6 +19634,17 @@ func NewHistoricalEventRelationship() *HistoricalEventRelationship {
 	}
 }
 
+func (a *arenas) newHistoricalEventRelationship() *HistoricalEventRelationship {
+	x := a.historicalEventRelationship.new()
+	*x = HistoricalEventRelationship{
+		Event:    -1,
+		SourceHf: -1,
+		TargetHf: -1,
+		Year:     -1,
+	}
+	return x
+}
+
 func (x *HistoricalEventRelationship) CheckFields() {
 }
 
@@ -18013,6 +19684,17 @@ func NewHistoricalEventRelationshipSupplement() *HistoricalEventRelationshipSupp
 	}
 }
 
+func (a *arenas) newHistoricalEventRelationshipSupplement() *HistoricalEventRelationshipSupplement {
+	x := a.historicalEventRelationshipSupplement.new()
+	*x = HistoricalEventRelationshipSupplement{
+		Event:        -1,
+		OccasionType: -1,
+		Site:         -1,
+		Unk1:         -1,
+	}
+	return x
+}
+
 func (x *HistoricalEventRelationshipSupplement) CheckFields() {
 }
 
@@ -18132,6 +19814,16 @@ func NewHistoricalEventRemoveHfEntityLink() *HistoricalEventRemoveHfEntityLink {
 		PositionId: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventRemoveHfEntityLink() *HistoricalEventRemoveHfEntityLink {
+	x := a.historicalEventRemoveHfEntityLink.new()
+	*x = HistoricalEventRemoveHfEntityLink{
+		CivId:      -1,
+		Hfid:       -1,
+		PositionId: -1,
+	}
+	return x
+}
 func (x *HistoricalEventRemoveHfEntityLink) Type() string                           { return "remove hf entity link" }
 func (x *HistoricalEventRemoveHfEntityLink) RelatedToEntity(id int) bool            { return x.CivId == id }
 func (x *HistoricalEventRemoveHfEntityLink) RelatedToHf(id int) bool                { return x.Hfid == id }
@@ -18179,6 +19871,15 @@ func NewHistoricalEventRemoveHfHfLink() *HistoricalEventRemoveHfHfLink {
 		HfidTarget: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventRemoveHfHfLink() *HistoricalEventRemoveHfHfLink {
+	x := a.historicalEventRemoveHfHfLink.new()
+	*x = HistoricalEventRemoveHfHfLink{
+		Hfid:       -1,
+		HfidTarget: -1,
+	}
+	return x
+}
 func (x *HistoricalEventRemoveHfHfLink) Type() string                { return "remove hf hf link" }
 func (x *HistoricalEventRemoveHfHfLink) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventRemoveHfHfLink) RelatedToHf(id int) bool {
@@ -18263,6 +19964,17 @@ func NewHistoricalEventRemoveHfSiteLink() *HistoricalEventRemoveHfSiteLink {
 		Structure: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventRemoveHfSiteLink() *HistoricalEventRemoveHfSiteLink {
+	x := a.historicalEventRemoveHfSiteLink.new()
+	*x = HistoricalEventRemoveHfSiteLink{
+		Civ:       -1,
+		Histfig:   -1,
+		SiteId:    -1,
+		Structure: -1,
+	}
+	return x
+}
 func (x *HistoricalEventRemoveHfSiteLink) Type() string                  { return "remove hf site link" }
 func (x *HistoricalEventRemoveHfSiteLink) RelatedToEntity(id int) bool   { return x.Civ == id }
 func (x *HistoricalEventRemoveHfSiteLink) RelatedToHf(id int) bool       { return x.Histfig == id }
@@ -18282,13 +19994,13 @@ func (x *HistoricalEventRemoveHfSiteLink) RelatedToIdentity(id int) bool
 
 func (x *HistoricalEventRemoveHfSiteLink) CheckFields() {
 	if x.Civ != x.SiteId {
-		sameFields["HistoricalEventRemoveHfSiteLink"]["Civ"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventRemoveHfSiteLink", "Civ", "SiteId")
 	}
 	if x.Histfig != x.SiteId {
-		sameFields["HistoricalEventRemoveHfSiteLink"]["Histfig"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventRemoveHfSiteLink", "Histfig", "SiteId")
 	}
 	if x.Structure != x.SiteId {
-		sameFields["HistoricalEventRemoveHfSiteLink"]["Structure"]["SiteId"] = false
+		fieldsDiffer("HistoricalEventRemoveHfSiteLink", "Structure", "SiteId")
 	}
 }
 
@@ -18329,6 +20041,18 @@ func NewHistoricalEventReplacedStructure() *HistoricalEventReplacedStructure {
 		SiteId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventReplacedStructure() *HistoricalEventReplacedStructure {
+	x := a.historicalEventReplacedStructure.new()
+	*x = HistoricalEventReplacedStructure{
+		CivId:     -1,
+		NewAbId:   -1,
+		OldAbId:   -1,
+		SiteCivId: -1,
+		SiteId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventReplacedStructure) Type() string { return "replaced structure" }
 func (x *HistoricalEventReplacedStructure) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.SiteCivId == id
@@ -18382,6 +20106,16 @@ func NewHistoricalEventSabotage() *HistoricalEventSabotage {
 		TargetHfid:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSabotage() *HistoricalEventSabotage {
+	x := a.historicalEventSabotage.new()
+	*x = HistoricalEventSabotage{
+		SaboteurHfid: -1,
+		SiteId:       -1,
+		TargetHfid:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventSabotage) Type() string                { return "sabotage" }
 func (x *HistoricalEventSabotage) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventSabotage) RelatedToHf(id int) bool {
@@ -18430,6 +20164,16 @@ func NewHistoricalEventSiteDied() *HistoricalEventSiteDied {
 		SiteId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSiteDied() *HistoricalEventSiteDied {
+	x := a.historicalEventSiteDied.new()
+	*x = HistoricalEventSiteDied{
+		CivId:     -1,
+		SiteCivId: -1,
+		SiteId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventSiteDied) Type() string { return "site died" }
 func (x *HistoricalEventSiteDied) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.SiteCivId == id
@@ -18533,6 +20277,17 @@ func NewHistoricalEventSiteDispute() *HistoricalEventSiteDispute {
 		SiteId2:   -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSiteDispute() *HistoricalEventSiteDispute {
+	x := a.historicalEventSiteDispute.new()
+	*x = HistoricalEventSiteDispute{
+		EntityId1: -1,
+		EntityId2: -1,
+		SiteId1:   -1,
+		SiteId2:   -1,
+	}
+	return x
+}
 func (x *HistoricalEventSiteDispute) Type() string { return "site dispute" }
 func (x *HistoricalEventSiteDispute) RelatedToEntity(id int) bool {
 	return x.EntityId1 == id || x.EntityId2 == id
@@ -18587,6 +20342,16 @@ func NewHistoricalEventSiteRetired() *HistoricalEventSiteRetired {
 		SiteId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSiteRetired() *HistoricalEventSiteRetired {
+	x := a.historicalEventSiteRetired.new()
+	*x = HistoricalEventSiteRetired{
+		CivId:     -1,
+		SiteCivId: -1,
+		SiteId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventSiteRetired) Type() string { return "site retired" }
 func (x *HistoricalEventSiteRetired) RelatedToEntity(id int) bool {
 	return x.CivId == id || x.SiteCivId == id
@@ -18637,6 +20402,17 @@ func NewHistoricalEventSiteSurrendered() *HistoricalEventSiteSurrendered {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSiteSurrendered() *HistoricalEventSiteSurrendered {
+	x := a.historicalEventSiteSurrendered.new()
+	*x = HistoricalEventSiteSurrendered{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventSiteSurrendered) Type() string { return "site surrendered" }
 func (x *HistoricalEventSiteSurrendered) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.SiteCivId == id
@@ -18691,6 +20467,18 @@ func NewHistoricalEventSiteTakenOver() *HistoricalEventSiteTakenOver {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSiteTakenOver() *HistoricalEventSiteTakenOver {
+	x := a.historicalEventSiteTakenOver.new()
+	*x = HistoricalEventSiteTakenOver{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		NewSiteCivId:  -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventSiteTakenOver) Type() string { return "site taken over" }
 func (x *HistoricalEventSiteTakenOver) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.NewSiteCivId == id || x.SiteCivId == id
@@ -18789,6 +20577,17 @@ func NewHistoricalEventSiteTributeForced() *HistoricalEventSiteTributeForced {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSiteTributeForced() *HistoricalEventSiteTributeForced {
+	x := a.historicalEventSiteTributeForced.new()
+	*x = HistoricalEventSiteTributeForced{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventSiteTributeForced) Type() string { return "site tribute forced" }
 func (x *HistoricalEventSiteTributeForced) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.SiteCivId == id
@@ -18844,6 +20643,17 @@ func NewHistoricalEventSneakIntoSite() *HistoricalEventSneakIntoSite {
 		SiteId:        -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSneakIntoSite() *HistoricalEventSneakIntoSite {
+	x := a.historicalEventSneakIntoSite.new()
+	*x = HistoricalEventSneakIntoSite{
+		AttackerCivId: -1,
+		DefenderCivId: -1,
+		SiteCivId:     -1,
+		SiteId:        -1,
+	}
+	return x
+}
 func (x *HistoricalEventSneakIntoSite) Type() string { return "sneak into site" }
 func (x *HistoricalEventSneakIntoSite) RelatedToEntity(id int) bool {
 	return x.AttackerCivId == id || x.DefenderCivId == id || x.SiteCivId == id
@@ -18896,6 +20706,17 @@ func NewHistoricalEventSpottedLeavingSite() *HistoricalEventSpottedLeavingSite {
 		SpotterHfid: -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSpottedLeavingSite() *HistoricalEventSpottedLeavingSite {
+	x := a.historicalEventSpottedLeavingSite.new()
+	*x = HistoricalEventSpottedLeavingSite{
+		LeaverCivId: -1,
+		SiteCivId:   -1,
+		SiteId:      -1,
+		SpotterHfid: -1,
+	}
+	return x
+}
 func (x *HistoricalEventSpottedLeavingSite) Type() string { return "spotted leaving site" }
 func (x *HistoricalEventSpottedLeavingSite) RelatedToEntity(id int) bool {
 	return x.LeaverCivId == id || x.SiteCivId == id
@@ -18972,6 +20793,28 @@ func NewHistoricalEventSquadVsSquad() *HistoricalEventSquadVsSquad {
 		SubregionId:     -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventSquadVsSquad() *HistoricalEventSquadVsSquad {
+	x := a.historicalEventSquadVsSquad.new()
+	*x = HistoricalEventSquadVsSquad{
+		ALeaderHfid:     -1,
+		ALeadershipRoll: -1,
+		ASquadId:        -1,
+		DEffect:         -1,
+		DInteraction:    -1,
+		DLeaderHfid:     -1,
+		DLeadershipRoll: -1,
+		DNumber:         -1,
+		DRace:           -1,
+		DSlain:          -1,
+		DSquadId:        -1,
+		FeatureLayerId:  -1,
+		SiteId:          -1,
+		StructureId:     -1,
+		SubregionId:     -1,
+	}
+	return x
+}
 func (x *HistoricalEventSquadVsSquad) Type() string                { return "squad vs squad" }
 func (x *HistoricalEventSquadVsSquad) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventSquadVsSquad) RelatedToHf(id int) bool {
@@ -19123,6 +20966,21 @@ func NewHistoricalEventTacticalSituation() *HistoricalEventTacticalSituation {
 		SubregionId:    -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventTacticalSituation() *HistoricalEventTacticalSituation {
+	x := a.historicalEventTacticalSituation.new()
+	*x = HistoricalEventTacticalSituation{
+		ATacticianHfid: -1,
+		ATacticsRoll:   -1,
+		DTacticianHfid: -1,
+		DTacticsRoll:   -1,
+		FeatureLayerId: -1,
+		SiteId:         -1,
+		StructureId:    -1,
+		SubregionId:    -1,
+	}
+	return x
+}
 func (x *HistoricalEventTacticalSituation) Type() string                { return "tactical situation" }
 func (x *HistoricalEventTacticalSituation) RelatedToEntity(id int) bool { return false }
 func (x *HistoricalEventTacticalSituation) RelatedToHf(id int) bool {
@@ -19201,6 +21059,21 @@ func NewHistoricalEventTrade() *HistoricalEventTrade {
 		TraderHfid:       -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventTrade() *HistoricalEventTrade {
+	x := a.historicalEventTrade.new()
+	*x = HistoricalEventTrade{
+		AccountShift:     -1,
+		Allotment:        -1,
+		AllotmentIndex:   -1,
+		DestSiteId:       -1,
+		ProductionZoneId: -1,
+		SourceSiteId:     -1,
+		TraderEntityId:   -1,
+		TraderHfid:       -1,
+	}
+	return x
+}
 func (x *HistoricalEventTrade) Type() string                  { return "trade" }
 func (x *HistoricalEventTrade) RelatedToEntity(id int) bool   { return x.TraderEntityId == id }
 func (x *HistoricalEventTrade) RelatedToHf(id int) bool       { return x.TraderHfid == id }
@@ -19340,6 +21213,19 @@ func NewHistoricalEventWrittenContentComposed() *HistoricalEventWrittenContentCo
 		WcId:           -1,
 	}
 }
+
+func (a *arenas) newHistoricalEventWrittenContentComposed() *HistoricalEventWrittenContentComposed {
+	x := a.historicalEventWrittenContentComposed.new()
+	*x = HistoricalEventWrittenContentComposed{
+		CircumstanceId: -1,
+		HistFigureId:   -1,
+		ReasonId:       -1,
+		SiteId:         -1,
+		SubregionId:    -1,
+		WcId:           -1,
+	}
+	return x
+}
 func (x *HistoricalEventWrittenContentComposed) Type() string                           { return "written content composed" }
 func (x *HistoricalEventWrittenContentComposed) RelatedToEntity(id int) bool            { return false }
 func (x *HistoricalEventWrittenContentComposed) RelatedToHf(id int) bool                { return x.HistFigureId == id }
@@ -19561,28 +21447,28 @@ func (x *HistoricalFigure) Name() string { return x.Name_ }
 
 func (x *HistoricalFigure) CheckFields() {
 	if x.Sex != x.Appeared {
-		sameFields["HistoricalFigure"]["Sex"]["Appeared"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "Appeared")
 	}
 	if x.Sex != x.BirthSeconds72 {
-		sameFields["HistoricalFigure"]["Sex"]["BirthSeconds72"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "BirthSeconds72")
 	}
 	if x.Sex != x.BirthYear {
-		sameFields["HistoricalFigure"]["Sex"]["BirthYear"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "BirthYear")
 	}
 	if x.Sex != x.BreedId {
-		sameFields["HistoricalFigure"]["Sex"]["BreedId"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "BreedId")
 	}
 	if x.Sex != x.CurrentIdentityId {
-		sameFields["HistoricalFigure"]["Sex"]["CurrentIdentityId"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "CurrentIdentityId")
 	}
 	if x.Sex != x.DeathSeconds72 {
-		sameFields["HistoricalFigure"]["Sex"]["DeathSeconds72"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "DeathSeconds72")
 	}
 	if x.Sex != x.DeathYear {
-		sameFields["HistoricalFigure"]["Sex"]["DeathYear"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "DeathYear")
 	}
 	if x.Sex != x.EntPopId {
-		sameFields["HistoricalFigure"]["Sex"]["EntPopId"] = false
+		fieldsDiffer("HistoricalFigure", "Sex", "EntPopId")
 	}
 }
 
@@ -20579,7 +22465,8 @@ func (x *Landmass) MarshalJSON() ([]byte, error) {
 }
 
 type MountainPeak struct {
-	Coords    string `json:"coords" legend:"plus" related:""`    // coords
+	Coords    string `json:"coords" legend:"plus" related:""` // coords
+	coords    []Coord
 	Height    int    `json:"height" legend:"plus" related:""`    // height
 	Id_       int    `json:"id" legend:"plus" related:""`        // id
 	IsVolcano bool   `json:"isVolcano" legend:"plus" related:""` // is_volcano
@@ -20592,9 +22479,10 @@ func NewMountainPeak() *MountainPeak {
 		Id_:    -1,
 	}
 }
-func (x *MountainPeak) Id() int      { return x.Id_ }
-func (x *MountainPeak) setId(id int) { x.Id_ = id }
-func (x *MountainPeak) Name() string { return x.Name_ }
+func (x *MountainPeak) Id() int              { return x.Id_ }
+func (x *MountainPeak) setId(id int)         { x.Id_ = id }
+func (x *MountainPeak) Name() string         { return x.Name_ }
+func (x *MountainPeak) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
 
 func (x *MountainPeak) CheckFields() {
 }
@@ -21005,7 +22893,8 @@ func (s RegionType) MarshalJSON() ([]byte, error) {
 }
 
 type Region struct {
-	Coords   string         `json:"coords" legend:"plus" related:""`   // coords
+	Coords   string `json:"coords" legend:"plus" related:""` // coords
+	coords   []Coord
 	Evilness RegionEvilness `json:"evilness" legend:"plus" related:""` // evilness
 	ForceId  int            `json:"forceId" legend:"plus" related:""`  // force_id
 	Id_      int            `json:"id" legend:"both" related:""`       // id
@@ -21019,9 +22908,10 @@ func NewRegion() *Region {
 		Id_:     -1,
 	}
 }
-func (x *Region) Id() int      { return x.Id_ }
-func (x *Region) setId(id int) { x.Id_ = id }
-func (x *Region) Name() string { return x.Name_ }
+func (x *Region) Id() int              { return x.Id_ }
+func (x *Region) setId(id int)         { x.Id_ = id }
+func (x *Region) Name() string         { return x.Name_ }
+func (x *Region) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
 
 func (x *Region) CheckFields() {
 }
@@ -21766,8 +23656,9 @@ func (s SiteType) MarshalJSON() ([]byte, error) {
 }
 
 type Site struct {
-	CivId          int                       `json:"civId" legend:"plus" related:""`          // civ_id
-	Coords         string                    `json:"coords" legend:"base" related:""`         // coords
+	CivId          int    `json:"civId" legend:"plus" related:""`  // civ_id
+	Coords         string `json:"coords" legend:"base" related:""` // coords
+	coords         []Coord
 	CurOwnerId     int                       `json:"curOwnerId" legend:"plus" related:""`     // cur_owner_id
 	Id_            int                       `json:"id" legend:"both" related:""`             // id
 	Name_          string                    `json:"name" legend:"base" related:""`           // name
@@ -21789,9 +23680,10 @@ func NewSite() *Site {
 		Owner:          -1,
 	}
 }
-func (x *Site) Id() int      { return x.Id_ }
-func (x *Site) setId(id int) { x.Id_ = id }
-func (x *Site) Name() string { return x.Name_ }
+func (x *Site) Id() int              { return x.Id_ }
+func (x *Site) setId(id int)         { x.Id_ = id }
+func (x *Site) Name() string         { return x.Name_ }
+func (x *Site) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
 
 func (x *Site) CheckFields() {
 }
@@ -22158,40 +24050,40 @@ func (x *Structure) Name() string { return x.Name_ }
 
 func (x *Structure) CheckFields() {
 	if x.Deity != x.EntityId {
-		sameFields["Structure"]["Deity"]["EntityId"] = false
+		fieldsDiffer("Structure", "Deity", "EntityId")
 	}
 	if x.Deity != x.LocalId {
-		sameFields["Structure"]["Deity"]["LocalId"] = false
+		fieldsDiffer("Structure", "Deity", "LocalId")
 	}
 	if x.Deity != x.WorshipHfid {
-		sameFields["Structure"]["Deity"]["WorshipHfid"] = false
+		fieldsDiffer("Structure", "Deity", "WorshipHfid")
 	}
 	if x.DeityType != x.EntityId {
-		sameFields["Structure"]["DeityType"]["EntityId"] = false
+		fieldsDiffer("Structure", "DeityType", "EntityId")
 	}
 	if x.DeityType != x.LocalId {
-		sameFields["Structure"]["DeityType"]["LocalId"] = false
+		fieldsDiffer("Structure", "DeityType", "LocalId")
 	}
 	if x.DeityType != x.WorshipHfid {
-		sameFields["Structure"]["DeityType"]["WorshipHfid"] = false
+		fieldsDiffer("Structure", "DeityType", "WorshipHfid")
 	}
 	if x.DungeonType != x.EntityId {
-		sameFields["Structure"]["DungeonType"]["EntityId"] = false
+		fieldsDiffer("Structure", "DungeonType", "EntityId")
 	}
 	if x.DungeonType != x.LocalId {
-		sameFields["Structure"]["DungeonType"]["LocalId"] = false
+		fieldsDiffer("Structure", "DungeonType", "LocalId")
 	}
 	if x.DungeonType != x.WorshipHfid {
-		sameFields["Structure"]["DungeonType"]["WorshipHfid"] = false
+		fieldsDiffer("Structure", "DungeonType", "WorshipHfid")
 	}
 	if x.Religion != x.EntityId {
-		sameFields["Structure"]["Religion"]["EntityId"] = false
+		fieldsDiffer("Structure", "Religion", "EntityId")
 	}
 	if x.Religion != x.LocalId {
-		sameFields["Structure"]["Religion"]["LocalId"] = false
+		fieldsDiffer("Structure", "Religion", "LocalId")
 	}
 	if x.Religion != x.WorshipHfid {
-		sameFields["Structure"]["Religion"]["WorshipHfid"] = false
+		fieldsDiffer("Structure", "Religion", "WorshipHfid")
 	}
 }
 
@@ -22276,10 +24168,11 @@ func (s UndergroundRegionType) MarshalJSON() ([]byte, error) {
 }
 
 type UndergroundRegion struct {
-	Coords string                `json:"coords" legend:"plus" related:""` // coords
-	Depth  int                   `json:"depth" legend:"base" related:""`  // depth
-	Id_    int                   `json:"id" legend:"both" related:""`     // id
-	Type_  UndergroundRegionType `json:"type" legend:"base" related:""`   // type
+	Coords string `json:"coords" legend:"plus" related:""` // coords
+	coords []Coord
+	Depth  int                   `json:"depth" legend:"base" related:""` // depth
+	Id_    int                   `json:"id" legend:"both" related:""`    // id
+	Type_  UndergroundRegionType `json:"type" legend:"base" related:""`  // type
 }
 
 func NewUndergroundRegion() *UndergroundRegion {
@@ -22288,8 +24181,9 @@ func NewUndergroundRegion() *UndergroundRegion {
 		Id_:   -1,
 	}
 }
-func (x *UndergroundRegion) Id() int      { return x.Id_ }
-func (x *UndergroundRegion) setId(id int) { x.Id_ = id }
+func (x *UndergroundRegion) Id() int              { return x.Id_ }
+func (x *UndergroundRegion) setId(id int)         { x.Id_ = id }
+func (x *UndergroundRegion) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
 
 func (x *UndergroundRegion) CheckFields() {
 }
@@ -22394,11 +24288,12 @@ func (s WorldConstructionType) MarshalJSON() ([]byte, error) {
 }
 
 type WorldConstruction struct {
-	Coords string                `json:"coords" legend:"plus" related:""` // coords
-	Id_    int                   `json:"id" legend:"plus" related:""`     // id
-	Name_  string                `json:"name" legend:"plus" related:""`   // name
-	Type_  WorldConstructionType `json:"type" legend:"plus" related:""`   // type
-	Parts  []int                 `json:"parts" legend:"add" related:""`   // Parts
+	Coords string `json:"coords" legend:"plus" related:""` // coords
+	coords []Coord
+	Id_    int                   `json:"id" legend:"plus" related:""`   // id
+	Name_  string                `json:"name" legend:"plus" related:""` // name
+	Type_  WorldConstructionType `json:"type" legend:"plus" related:""` // type
+	Parts  []int                 `json:"parts" legend:"add" related:""` // Parts
 }
 
 func NewWorldConstruction() *WorldConstruction {
@@ -22406,9 +24301,10 @@ func NewWorldConstruction() *WorldConstruction {
 		Id_: -1,
 	}
 }
-func (x *WorldConstruction) Id() int      { return x.Id_ }
-func (x *WorldConstruction) setId(id int) { x.Id_ = id }
-func (x *WorldConstruction) Name() string { return x.Name_ }
+func (x *WorldConstruction) Id() int              { return x.Id_ }
+func (x *WorldConstruction) setId(id int)         { x.Id_ = id }
+func (x *WorldConstruction) Name() string         { return x.Name_ }
+func (x *WorldConstruction) Coordinates() []Coord { return coordinates(x.coords, x.Coords) }
 
 func (x *WorldConstruction) CheckFields() {
 }
@@ -22794,6 +24690,8 @@ type WrittenContent struct {
 	Reference  []*Reference       `json:"reference" legend:"plus" related:""`  // reference
 	Style      []string           `json:"style" legend:"both" related:""`      // style
 	Title      string             `json:"title" legend:"both" related:""`      // title
+	CitedBy    []int              `json:"citedBy" legend:"add" related:""`     // CitedBy
+	Copies     []int              `json:"copies" legend:"add" related:""`      // Copies
 }
 
 func NewWrittenContent() *WrittenContent {
@@ -22811,22 +24709,22 @@ func (x *WrittenContent) setId(id int) { x.Id_ = id }
 
 func (x *WrittenContent) CheckFields() {
 	if x.PageEnd != x.AuthorHfid {
-		sameFields["WrittenContent"]["PageEnd"]["AuthorHfid"] = false
+		fieldsDiffer("WrittenContent", "PageEnd", "AuthorHfid")
 	}
 	if x.PageEnd != x.AuthorRoll {
-		sameFields["WrittenContent"]["PageEnd"]["AuthorRoll"] = false
+		fieldsDiffer("WrittenContent", "PageEnd", "AuthorRoll")
 	}
 	if x.PageEnd != x.FormId {
-		sameFields["WrittenContent"]["PageEnd"]["FormId"] = false
+		fieldsDiffer("WrittenContent", "PageEnd", "FormId")
 	}
 	if x.PageStart != x.AuthorHfid {
-		sameFields["WrittenContent"]["PageStart"]["AuthorHfid"] = false
+		fieldsDiffer("WrittenContent", "PageStart", "AuthorHfid")
 	}
 	if x.PageStart != x.AuthorRoll {
-		sameFields["WrittenContent"]["PageStart"]["AuthorRoll"] = false
+		fieldsDiffer("WrittenContent", "PageStart", "AuthorRoll")
 	}
 	if x.PageStart != x.FormId {
-		sameFields["WrittenContent"]["PageStart"]["FormId"] = false
+		fieldsDiffer("WrittenContent", "PageStart", "FormId")
 	}
 }
 
@@ -22856,11 +24754,331 @@ func (x *WrittenContent) MarshalJSON() ([]byte, error) {
 	d["reference"] = x.Reference
 	d["style"] = x.Style
 	d["title"] = x.Title
+	d["citedBy"] = x.CitedBy
+	d["copies"] = x.Copies
 	return json.Marshal(d)
 }
 
+// historicalEventTypes creates empty details by type
+var historicalEventTypes = map[string]func() HistoricalEventDetails{
+	"add hf entity honor":                func() HistoricalEventDetails { return NewHistoricalEventAddHfEntityHonor() },
+	"add hf entity link":                 func() HistoricalEventDetails { return NewHistoricalEventAddHfEntityLink() },
+	"add hf hf link":                     func() HistoricalEventDetails { return NewHistoricalEventAddHfHfLink() },
+	"add hf site link":                   func() HistoricalEventDetails { return NewHistoricalEventAddHfSiteLink() },
+	"agreement concluded":                func() HistoricalEventDetails { return NewHistoricalEventAgreementConcluded() },
+	"agreement formed":                   func() HistoricalEventDetails { return NewHistoricalEventAgreementFormed() },
+	"agreement made":                     func() HistoricalEventDetails { return NewHistoricalEventAgreementMade() },
+	"agreement rejected":                 func() HistoricalEventDetails { return NewHistoricalEventAgreementRejected() },
+	"artifact claim formed":              func() HistoricalEventDetails { return NewHistoricalEventArtifactClaimFormed() },
+	"artifact copied":                    func() HistoricalEventDetails { return NewHistoricalEventArtifactCopied() },
+	"artifact created":                   func() HistoricalEventDetails { return NewHistoricalEventArtifactCreated() },
+	"artifact destroyed":                 func() HistoricalEventDetails { return NewHistoricalEventArtifactDestroyed() },
+	"artifact found":                     func() HistoricalEventDetails { return NewHistoricalEventArtifactFound() },
+	"artifact given":                     func() HistoricalEventDetails { return NewHistoricalEventArtifactGiven() },
+	"artifact lost":                      func() HistoricalEventDetails { return NewHistoricalEventArtifactLost() },
+	"artifact possessed":                 func() HistoricalEventDetails { return NewHistoricalEventArtifactPossessed() },
+	"artifact recovered":                 func() HistoricalEventDetails { return NewHistoricalEventArtifactRecovered() },
+	"artifact stored":                    func() HistoricalEventDetails { return NewHistoricalEventArtifactStored() },
+	"artifact transformed":               func() HistoricalEventDetails { return NewHistoricalEventArtifactTransformed() },
+	"assume identity":                    func() HistoricalEventDetails { return NewHistoricalEventAssumeIdentity() },
+	"attacked site":                      func() HistoricalEventDetails { return NewHistoricalEventAttackedSite() },
+	"body abused":                        func() HistoricalEventDetails { return NewHistoricalEventBodyAbused() },
+	"building profile acquired":          func() HistoricalEventDetails { return NewHistoricalEventBuildingProfileAcquired() },
+	"ceremony":                           func() HistoricalEventDetails { return NewHistoricalEventCeremony() },
+	"change hf body state":               func() HistoricalEventDetails { return NewHistoricalEventChangeHfBodyState() },
+	"change hf job":                      func() HistoricalEventDetails { return NewHistoricalEventChangeHfJob() },
+	"change hf state":                    func() HistoricalEventDetails { return NewHistoricalEventChangeHfState() },
+	"changed creature type":              func() HistoricalEventDetails { return NewHistoricalEventChangedCreatureType() },
+	"competition":                        func() HistoricalEventDetails { return NewHistoricalEventCompetition() },
+	"create entity position":             func() HistoricalEventDetails { return NewHistoricalEventCreateEntityPosition() },
+	"created site":                       func() HistoricalEventDetails { return NewHistoricalEventCreatedSite() },
+	"created structure":                  func() HistoricalEventDetails { return NewHistoricalEventCreatedStructure() },
+	"created world construction":         func() HistoricalEventDetails { return NewHistoricalEventCreatedWorldConstruction() },
+	"creature devoured":                  func() HistoricalEventDetails { return NewHistoricalEventCreatureDevoured() },
+	"dance form created":                 func() HistoricalEventDetails { return NewHistoricalEventDanceFormCreated() },
+	"destroyed site":                     func() HistoricalEventDetails { return NewHistoricalEventDestroyedSite() },
+	"diplomat lost":                      func() HistoricalEventDetails { return NewHistoricalEventDiplomatLost() },
+	"entity alliance formed":             func() HistoricalEventDetails { return NewHistoricalEventEntityAllianceFormed() },
+	"entity breach feature layer":        func() HistoricalEventDetails { return NewHistoricalEventEntityBreachFeatureLayer() },
+	"entity created":                     func() HistoricalEventDetails { return NewHistoricalEventEntityCreated() },
+	"entity dissolved":                   func() HistoricalEventDetails { return NewHistoricalEventEntityDissolved() },
+	"entity equipment purchase":          func() HistoricalEventDetails { return NewHistoricalEventEntityEquipmentPurchase() },
+	"entity expels hf":                   func() HistoricalEventDetails { return NewHistoricalEventEntityExpelsHf() },
+	"entity fled site":                   func() HistoricalEventDetails { return NewHistoricalEventEntityFledSite() },
+	"entity incorporated":                func() HistoricalEventDetails { return NewHistoricalEventEntityIncorporated() },
+	"entity law":                         func() HistoricalEventDetails { return NewHistoricalEventEntityLaw() },
+	"entity overthrown":                  func() HistoricalEventDetails { return NewHistoricalEventEntityOverthrown() },
+	"entity persecuted":                  func() HistoricalEventDetails { return NewHistoricalEventEntityPersecuted() },
+	"entity primary criminals":           func() HistoricalEventDetails { return NewHistoricalEventEntityPrimaryCriminals() },
+	"entity rampaged in site":            func() HistoricalEventDetails { return NewHistoricalEventEntityRampagedInSite() },
+	"entity relocate":                    func() HistoricalEventDetails { return NewHistoricalEventEntityRelocate() },
+	"entity searched site":               func() HistoricalEventDetails { return NewHistoricalEventEntitySearchedSite() },
+	"failed frame attempt":               func() HistoricalEventDetails { return NewHistoricalEventFailedFrameAttempt() },
+	"failed intrigue corruption":         func() HistoricalEventDetails { return NewHistoricalEventFailedIntrigueCorruption() },
+	"field battle":                       func() HistoricalEventDetails { return NewHistoricalEventFieldBattle() },
+	"first contact":                      func() HistoricalEventDetails { return NewHistoricalEventFirstContact() },
+	"first contact failed":               func() HistoricalEventDetails { return NewHistoricalEventFirstContactFailed() },
+	"gamble":                             func() HistoricalEventDetails { return NewHistoricalEventGamble() },
+	"hf abducted":                        func() HistoricalEventDetails { return NewHistoricalEventHfAbducted() },
+	"hf asked about artifact":            func() HistoricalEventDetails { return NewHistoricalEventHfAskedAboutArtifact() },
+	"hf attacked site":                   func() HistoricalEventDetails { return NewHistoricalEventHfAttackedSite() },
+	"hf carouse":                         func() HistoricalEventDetails { return NewHistoricalEventHfCarouse() },
+	"hf confronted":                      func() HistoricalEventDetails { return NewHistoricalEventHfConfronted() },
+	"hf convicted":                       func() HistoricalEventDetails { return NewHistoricalEventHfConvicted() },
+	"hf destroyed site":                  func() HistoricalEventDetails { return NewHistoricalEventHfDestroyedSite() },
+	"hf died":                            func() HistoricalEventDetails { return NewHistoricalEventHfDied() },
+	"hf disturbed structure":             func() HistoricalEventDetails { return NewHistoricalEventHfDisturbedStructure() },
+	"hf does interaction":                func() HistoricalEventDetails { return NewHistoricalEventHfDoesInteraction() },
+	"hf enslaved":                        func() HistoricalEventDetails { return NewHistoricalEventHfEnslaved() },
+	"hf equipment purchase":              func() HistoricalEventDetails { return NewHistoricalEventHfEquipmentPurchase() },
+	"hf freed":                           func() HistoricalEventDetails { return NewHistoricalEventHfFreed() },
+	"hf gains secret goal":               func() HistoricalEventDetails { return NewHistoricalEventHfGainsSecretGoal() },
+	"hf interrogated":                    func() HistoricalEventDetails { return NewHistoricalEventHfInterrogated() },
+	"hf learns secret":                   func() HistoricalEventDetails { return NewHistoricalEventHfLearnsSecret() },
+	"hf new pet":                         func() HistoricalEventDetails { return NewHistoricalEventHfNewPet() },
+	"hf performed horrible experiments":  func() HistoricalEventDetails { return NewHistoricalEventHfPerformedHorribleExperiments() },
+	"hf prayed inside structure":         func() HistoricalEventDetails { return NewHistoricalEventHfPrayedInsideStructure() },
+	"hf preach":                          func() HistoricalEventDetails { return NewHistoricalEventHfPreach() },
+	"hf profaned structure":              func() HistoricalEventDetails { return NewHistoricalEventHfProfanedStructure() },
+	"hf ransomed":                        func() HistoricalEventDetails { return NewHistoricalEventHfRansomed() },
+	"hf reach summit":                    func() HistoricalEventDetails { return NewHistoricalEventHfReachSummit() },
+	"hf recruited unit type for entity":  func() HistoricalEventDetails { return NewHistoricalEventHfRecruitedUnitTypeForEntity() },
+	"hf relationship denied":             func() HistoricalEventDetails { return NewHistoricalEventHfRelationshipDenied() },
+	"hf reunion":                         func() HistoricalEventDetails { return NewHistoricalEventHfReunion() },
+	"hf revived":                         func() HistoricalEventDetails { return NewHistoricalEventHfRevived() },
+	"hf simple battle event":             func() HistoricalEventDetails { return NewHistoricalEventHfSimpleBattleEvent() },
+	"hf travel":                          func() HistoricalEventDetails { return NewHistoricalEventHfTravel() },
+	"hf viewed artifact":                 func() HistoricalEventDetails { return NewHistoricalEventHfViewedArtifact() },
+	"hf wounded":                         func() HistoricalEventDetails { return NewHistoricalEventHfWounded() },
+	"hfs formed intrigue relationship":   func() HistoricalEventDetails { return NewHistoricalEventHfsFormedIntrigueRelationship() },
+	"hfs formed reputation relationship": func() HistoricalEventDetails { return NewHistoricalEventHfsFormedReputationRelationship() },
+	"holy city declaration":              func() HistoricalEventDetails { return NewHistoricalEventHolyCityDeclaration() },
+	"insurrection started":               func() HistoricalEventDetails { return NewHistoricalEventInsurrectionStarted() },
+	"item stolen":                        func() HistoricalEventDetails { return NewHistoricalEventItemStolen() },
+	"knowledge discovered":               func() HistoricalEventDetails { return NewHistoricalEventKnowledgeDiscovered() },
+	"masterpiece arch constructed":       func() HistoricalEventDetails { return NewHistoricalEventMasterpieceArchConstructed() },
+	"masterpiece dye":                    func() HistoricalEventDetails { return NewHistoricalEventMasterpieceDye() },
+	"masterpiece engraving":              func() HistoricalEventDetails { return NewHistoricalEventMasterpieceEngraving() },
+	"masterpiece food":                   func() HistoricalEventDetails { return NewHistoricalEventMasterpieceFood() },
+	"masterpiece item":                   func() HistoricalEventDetails { return NewHistoricalEventMasterpieceItem() },
+	"masterpiece item improvement":       func() HistoricalEventDetails { return NewHistoricalEventMasterpieceItemImprovement() },
+	"masterpiece lost":                   func() HistoricalEventDetails { return NewHistoricalEventMasterpieceLost() },
+	"merchant":                           func() HistoricalEventDetails { return NewHistoricalEventMerchant() },
+	"modified building":                  func() HistoricalEventDetails { return NewHistoricalEventModifiedBuilding() },
+	"musical form created":               func() HistoricalEventDetails { return NewHistoricalEventMusicalFormCreated() },
+	"new site leader":                    func() HistoricalEventDetails { return NewHistoricalEventNewSiteLeader() },
+	"peace accepted":                     func() HistoricalEventDetails { return NewHistoricalEventPeaceAccepted() },
+	"peace rejected":                     func() HistoricalEventDetails { return NewHistoricalEventPeaceRejected() },
+	"performance":                        func() HistoricalEventDetails { return NewHistoricalEventPerformance() },
+	"plundered site":                     func() HistoricalEventDetails { return NewHistoricalEventPlunderedSite() },
+	"poetic form created":                func() HistoricalEventDetails { return NewHistoricalEventPoeticFormCreated() },
+	"procession":                         func() HistoricalEventDetails { return NewHistoricalEventProcession() },
+	"razed structure":                    func() HistoricalEventDetails { return NewHistoricalEventRazedStructure() },
+	"reclaim site":                       func() HistoricalEventDetails { return NewHistoricalEventReclaimSite() },
+	"regionpop incorporated into entity": func() HistoricalEventDetails { return NewHistoricalEventRegionpopIncorporatedIntoEntity() },
+	"remove hf entity link":              func() HistoricalEventDetails { return NewHistoricalEventRemoveHfEntityLink() },
+	"remove hf hf link":                  func() HistoricalEventDetails { return NewHistoricalEventRemoveHfHfLink() },
+	"remove hf site link":                func() HistoricalEventDetails { return NewHistoricalEventRemoveHfSiteLink() },
+	"replaced structure":                 func() HistoricalEventDetails { return NewHistoricalEventReplacedStructure() },
+	"sabotage":                           func() HistoricalEventDetails { return NewHistoricalEventSabotage() },
+	"site died":                          func() HistoricalEventDetails { return NewHistoricalEventSiteDied() },
+	"site dispute":                       func() HistoricalEventDetails { return NewHistoricalEventSiteDispute() },
+	"site retired":                       func() HistoricalEventDetails { return NewHistoricalEventSiteRetired() },
+	"site surrendered":                   func() HistoricalEventDetails { return NewHistoricalEventSiteSurrendered() },
+	"site taken over":                    func() HistoricalEventDetails { return NewHistoricalEventSiteTakenOver() },
+	"site tribute forced":                func() HistoricalEventDetails { return NewHistoricalEventSiteTributeForced() },
+	"sneak into site":                    func() HistoricalEventDetails { return NewHistoricalEventSneakIntoSite() },
+	"spotted leaving site":               func() HistoricalEventDetails { return NewHistoricalEventSpottedLeavingSite() },
+	"squad vs squad":                     func() HistoricalEventDetails { return NewHistoricalEventSquadVsSquad() },
+	"tactical situation":                 func() HistoricalEventDetails { return NewHistoricalEventTacticalSituation() },
+	"trade":                              func() HistoricalEventDetails { return NewHistoricalEventTrade() },
+	"written content composed":           func() HistoricalEventDetails { return NewHistoricalEventWrittenContentComposed() },
+}
+
+// historicalEventCollectionTypes creates empty details by type
+var historicalEventCollectionTypes = map[string]func() HistoricalEventCollectionDetails{
+	"abduction":         func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionAbduction() },
+	"battle":            func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionBattle() },
+	"beast attack":      func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionBeastAttack() },
+	"ceremony":          func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionCeremony() },
+	"competition":       func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionCompetition() },
+	"duel":              func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionDuel() },
+	"entity overthrown": func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionEntityOverthrown() },
+	"insurrection":      func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionInsurrection() },
+	"journey":           func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionJourney() },
+	"occasion":          func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionOccasion() },
+	"performance":       func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionPerformance() },
+	"persecution":       func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionPersecution() },
+	"procession":        func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionProcession() },
+	"purge":             func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionPurge() },
+	"raid":              func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionRaid() },
+	"site conquered":    func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionSiteConquered() },
+	"theft":             func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionTheft() },
+	"war":               func() HistoricalEventCollectionDetails { return NewHistoricalEventCollectionWar() },
+}
+
+// arenas allocate the events and their details of one world
+type arenas struct {
+	historicalEvent                                arena[HistoricalEvent]
+	historicalEventAddHfEntityHonor                arena[HistoricalEventAddHfEntityHonor]
+	historicalEventAddHfEntityLink                 arena[HistoricalEventAddHfEntityLink]
+	historicalEventAddHfHfLink                     arena[HistoricalEventAddHfHfLink]
+	historicalEventAddHfSiteLink                   arena[HistoricalEventAddHfSiteLink]
+	historicalEventAgreementConcluded              arena[HistoricalEventAgreementConcluded]
+	historicalEventAgreementFormed                 arena[HistoricalEventAgreementFormed]
+	historicalEventAgreementMade                   arena[HistoricalEventAgreementMade]
+	historicalEventAgreementRejected               arena[HistoricalEventAgreementRejected]
+	historicalEventArtifactClaimFormed             arena[HistoricalEventArtifactClaimFormed]
+	historicalEventArtifactCopied                  arena[HistoricalEventArtifactCopied]
+	historicalEventArtifactCreated                 arena[HistoricalEventArtifactCreated]
+	historicalEventArtifactCreatedCircumstance     arena[HistoricalEventArtifactCreatedCircumstance]
+	historicalEventArtifactDestroyed               arena[HistoricalEventArtifactDestroyed]
+	historicalEventArtifactFound                   arena[HistoricalEventArtifactFound]
+	historicalEventArtifactGiven                   arena[HistoricalEventArtifactGiven]
+	historicalEventArtifactLost                    arena[HistoricalEventArtifactLost]
+	historicalEventArtifactPossessed               arena[HistoricalEventArtifactPossessed]
+	historicalEventArtifactRecovered               arena[HistoricalEventArtifactRecovered]
+	historicalEventArtifactStored                  arena[HistoricalEventArtifactStored]
+	historicalEventArtifactTransformed             arena[HistoricalEventArtifactTransformed]
+	historicalEventAssumeIdentity                  arena[HistoricalEventAssumeIdentity]
+	historicalEventAttackedSite                    arena[HistoricalEventAttackedSite]
+	historicalEventBodyAbused                      arena[HistoricalEventBodyAbused]
+	historicalEventBuildingProfileAcquired         arena[HistoricalEventBuildingProfileAcquired]
+	historicalEventCeremony                        arena[HistoricalEventCeremony]
+	historicalEventChangeHfBodyState               arena[HistoricalEventChangeHfBodyState]
+	historicalEventChangeHfJob                     arena[HistoricalEventChangeHfJob]
+	historicalEventChangeHfState                   arena[HistoricalEventChangeHfState]
+	historicalEventChangedCreatureType             arena[HistoricalEventChangedCreatureType]
+	historicalEventCollection                      arena[HistoricalEventCollection]
+	historicalEventCollectionAbduction             arena[HistoricalEventCollectionAbduction]
+	historicalEventCollectionBattle                arena[HistoricalEventCollectionBattle]
+	historicalEventCollectionBeastAttack           arena[HistoricalEventCollectionBeastAttack]
+	historicalEventCollectionCeremony              arena[HistoricalEventCollectionCeremony]
+	historicalEventCollectionCompetition           arena[HistoricalEventCollectionCompetition]
+	historicalEventCollectionDuel                  arena[HistoricalEventCollectionDuel]
+	historicalEventCollectionEntityOverthrown      arena[HistoricalEventCollectionEntityOverthrown]
+	historicalEventCollectionInsurrection          arena[HistoricalEventCollectionInsurrection]
+	historicalEventCollectionJourney               arena[HistoricalEventCollectionJourney]
+	historicalEventCollectionOccasion              arena[HistoricalEventCollectionOccasion]
+	historicalEventCollectionPerformance           arena[HistoricalEventCollectionPerformance]
+	historicalEventCollectionPersecution           arena[HistoricalEventCollectionPersecution]
+	historicalEventCollectionProcession            arena[HistoricalEventCollectionProcession]
+	historicalEventCollectionPurge                 arena[HistoricalEventCollectionPurge]
+	historicalEventCollectionRaid                  arena[HistoricalEventCollectionRaid]
+	historicalEventCollectionSiteConquered         arena[HistoricalEventCollectionSiteConquered]
+	historicalEventCollectionTheft                 arena[HistoricalEventCollectionTheft]
+	historicalEventCollectionWar                   arena[HistoricalEventCollectionWar]
+	historicalEventCompetition                     arena[HistoricalEventCompetition]
+	historicalEventCreateEntityPosition            arena[HistoricalEventCreateEntityPosition]
+	historicalEventCreatedSite                     arena[HistoricalEventCreatedSite]
+	historicalEventCreatedStructure                arena[HistoricalEventCreatedStructure]
+	historicalEventCreatedWorldConstruction        arena[HistoricalEventCreatedWorldConstruction]
+	historicalEventCreatureDevoured                arena[HistoricalEventCreatureDevoured]
+	historicalEventDanceFormCreated                arena[HistoricalEventDanceFormCreated]
+	historicalEventDestroyedSite                   arena[HistoricalEventDestroyedSite]
+	historicalEventDiplomatLost                    arena[HistoricalEventDiplomatLost]
+	historicalEventEntityAllianceFormed            arena[HistoricalEventEntityAllianceFormed]
+	historicalEventEntityBreachFeatureLayer        arena[HistoricalEventEntityBreachFeatureLayer]
+	historicalEventEntityCreated                   arena[HistoricalEventEntityCreated]
+	historicalEventEntityDissolved                 arena[HistoricalEventEntityDissolved]
+	historicalEventEntityEquipmentPurchase         arena[HistoricalEventEntityEquipmentPurchase]
+	historicalEventEntityExpelsHf                  arena[HistoricalEventEntityExpelsHf]
+	historicalEventEntityFledSite                  arena[HistoricalEventEntityFledSite]
+	historicalEventEntityIncorporated              arena[HistoricalEventEntityIncorporated]
+	historicalEventEntityLaw                       arena[HistoricalEventEntityLaw]
+	historicalEventEntityOverthrown                arena[HistoricalEventEntityOverthrown]
+	historicalEventEntityPersecuted                arena[HistoricalEventEntityPersecuted]
+	historicalEventEntityPrimaryCriminals          arena[HistoricalEventEntityPrimaryCriminals]
+	historicalEventEntityRampagedInSite            arena[HistoricalEventEntityRampagedInSite]
+	historicalEventEntityRelocate                  arena[HistoricalEventEntityRelocate]
+	historicalEventEntitySearchedSite              arena[HistoricalEventEntitySearchedSite]
+	historicalEventFailedFrameAttempt              arena[HistoricalEventFailedFrameAttempt]
+	historicalEventFailedIntrigueCorruption        arena[HistoricalEventFailedIntrigueCorruption]
+	historicalEventFieldBattle                     arena[HistoricalEventFieldBattle]
+	historicalEventFirstContact                    arena[HistoricalEventFirstContact]
+	historicalEventFirstContactFailed              arena[HistoricalEventFirstContactFailed]
+	historicalEventGamble                          arena[HistoricalEventGamble]
+	historicalEventHfAbducted                      arena[HistoricalEventHfAbducted]
+	historicalEventHfAskedAboutArtifact            arena[HistoricalEventHfAskedAboutArtifact]
+	historicalEventHfAttackedSite                  arena[HistoricalEventHfAttackedSite]
+	historicalEventHfCarouse                       arena[HistoricalEventHfCarouse]
+	historicalEventHfConfronted                    arena[HistoricalEventHfConfronted]
+	historicalEventHfConvicted                     arena[HistoricalEventHfConvicted]
+	historicalEventHfDestroyedSite                 arena[HistoricalEventHfDestroyedSite]
+	historicalEventHfDied                          arena[HistoricalEventHfDied]
+	historicalEventHfDisturbedStructure            arena[HistoricalEventHfDisturbedStructure]
+	historicalEventHfDoesInteraction               arena[HistoricalEventHfDoesInteraction]
+	historicalEventHfEnslaved                      arena[HistoricalEventHfEnslaved]
+	historicalEventHfEquipmentPurchase             arena[HistoricalEventHfEquipmentPurchase]
+	historicalEventHfFreed                         arena[HistoricalEventHfFreed]
+	historicalEventHfGainsSecretGoal               arena[HistoricalEventHfGainsSecretGoal]
+	historicalEventHfInterrogated                  arena[HistoricalEventHfInterrogated]
+	historicalEventHfLearnsSecret                  arena[HistoricalEventHfLearnsSecret]
+	historicalEventHfNewPet                        arena[HistoricalEventHfNewPet]
+	historicalEventHfPerformedHorribleExperiments  arena[HistoricalEventHfPerformedHorribleExperiments]
+	historicalEventHfPrayedInsideStructure         arena[HistoricalEventHfPrayedInsideStructure]
+	historicalEventHfPreach                        arena[HistoricalEventHfPreach]
+	historicalEventHfProfanedStructure             arena[HistoricalEventHfProfanedStructure]
+	historicalEventHfRansomed                      arena[HistoricalEventHfRansomed]
+	historicalEventHfReachSummit                   arena[HistoricalEventHfReachSummit]
+	historicalEventHfRecruitedUnitTypeForEntity    arena[HistoricalEventHfRecruitedUnitTypeForEntity]
+	historicalEventHfRelationshipDenied            arena[HistoricalEventHfRelationshipDenied]
+	historicalEventHfReunion                       arena[HistoricalEventHfReunion]
+	historicalEventHfRevived                       arena[HistoricalEventHfRevived]
+	historicalEventHfSimpleBattleEvent             arena[HistoricalEventHfSimpleBattleEvent]
+	historicalEventHfTravel                        arena[HistoricalEventHfTravel]
+	historicalEventHfViewedArtifact                arena[HistoricalEventHfViewedArtifact]
+	historicalEventHfWounded                       arena[HistoricalEventHfWounded]
+	historicalEventHfsFormedIntrigueRelationship   arena[HistoricalEventHfsFormedIntrigueRelationship]
+	historicalEventHfsFormedReputationRelationship arena[HistoricalEventHfsFormedReputationRelationship]
+	historicalEventHolyCityDeclaration             arena[HistoricalEventHolyCityDeclaration]
+	historicalEventInsurrectionStarted             arena[HistoricalEventInsurrectionStarted]
+	historicalEventItemStolen                      arena[HistoricalEventItemStolen]
+	historicalEventItemStolenCircumstance          arena[HistoricalEventItemStolenCircumstance]
+	historicalEventKnowledgeDiscovered             arena[HistoricalEventKnowledgeDiscovered]
+	historicalEventMasterpieceArchConstructed      arena[HistoricalEventMasterpieceArchConstructed]
+	historicalEventMasterpieceDye                  arena[HistoricalEventMasterpieceDye]
+	historicalEventMasterpieceEngraving            arena[HistoricalEventMasterpieceEngraving]
+	historicalEventMasterpieceFood                 arena[HistoricalEventMasterpieceFood]
+	historicalEventMasterpieceItem                 arena[HistoricalEventMasterpieceItem]
+	historicalEventMasterpieceItemImprovement      arena[HistoricalEventMasterpieceItemImprovement]
+	historicalEventMasterpieceLost                 arena[HistoricalEventMasterpieceLost]
+	historicalEventMerchant                        arena[HistoricalEventMerchant]
+	historicalEventModifiedBuilding                arena[HistoricalEventModifiedBuilding]
+	historicalEventMusicalFormCreated              arena[HistoricalEventMusicalFormCreated]
+	historicalEventNewSiteLeader                   arena[HistoricalEventNewSiteLeader]
+	historicalEventPeaceAccepted                   arena[HistoricalEventPeaceAccepted]
+	historicalEventPeaceRejected                   arena[HistoricalEventPeaceRejected]
+	historicalEventPerformance                     arena[HistoricalEventPerformance]
+	historicalEventPlunderedSite                   arena[HistoricalEventPlunderedSite]
+	historicalEventPoeticFormCreated               arena[HistoricalEventPoeticFormCreated]
+	historicalEventProcession                      arena[HistoricalEventProcession]
+	historicalEventRazedStructure                  arena[HistoricalEventRazedStructure]
+	historicalEventReclaimSite                     arena[HistoricalEventReclaimSite]
+	historicalEventRegionpopIncorporatedIntoEntity arena[HistoricalEventRegionpopIncorporatedIntoEntity]
+	historicalEventRelationship                    arena[HistoricalEventRelationship]
+	historicalEventRelationshipSupplement          arena[HistoricalEventRelationshipSupplement]
+	historicalEventRemoveHfEntityLink              arena[HistoricalEventRemoveHfEntityLink]
+	historicalEventRemoveHfHfLink                  arena[HistoricalEventRemoveHfHfLink]
+	historicalEventRemoveHfSiteLink                arena[HistoricalEventRemoveHfSiteLink]
+	historicalEventReplacedStructure               arena[HistoricalEventReplacedStructure]
+	historicalEventSabotage                        arena[HistoricalEventSabotage]
+	historicalEventSiteDied                        arena[HistoricalEventSiteDied]
+	historicalEventSiteDispute                     arena[HistoricalEventSiteDispute]
+	historicalEventSiteRetired                     arena[HistoricalEventSiteRetired]
+	historicalEventSiteSurrendered                 arena[HistoricalEventSiteSurrendered]
+	historicalEventSiteTakenOver                   arena[HistoricalEventSiteTakenOver]
+	historicalEventSiteTributeForced               arena[HistoricalEventSiteTributeForced]
+	historicalEventSneakIntoSite                   arena[HistoricalEventSneakIntoSite]
+	historicalEventSpottedLeavingSite              arena[HistoricalEventSpottedLeavingSite]
+	historicalEventSquadVsSquad                    arena[HistoricalEventSquadVsSquad]
+	historicalEventTacticalSituation               arena[HistoricalEventTacticalSituation]
+	historicalEventTrade                           arena[HistoricalEventTrade]
+	historicalEventWrittenContentComposed          arena[HistoricalEventWrittenContentComposed]
+}
+
 // Parser
-func parseArtifact(p *util.XMLParser) (*Artifact, error) {
+func parseArtifact(p *parser) (*Artifact, error) {
 	var obj = NewArtifact()
 
 	for {
@@ -22939,7 +25157,7 @@ func parseArtifact(p *util.XMLParser) (*Artifact, error) {
 		}
 	}
 }
-func parseArtifactPlus(p *util.XMLParser, obj *Artifact) (*Artifact, error) {
+func parseArtifactPlus(p *parser, obj *Artifact) (*Artifact, error) {
 	if obj == nil {
 		obj = NewArtifact()
 	}
@@ -22969,19 +25187,19 @@ func parseArtifactPlus(p *util.XMLParser, obj *Artifact) (*Artifact, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemSubtype = txt(data)
+				obj.ItemSubtype = p.itxt(data)
 			case "item_type":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemType = txt(data)
+				obj.ItemType = p.itxt(data)
 			case "mat":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.Mat = txt(data)
+				obj.Mat = p.itxt(data)
 			case "page_count":
 				data, err := p.Value()
 				if err != nil {
@@ -23005,7 +25223,7 @@ func parseArtifactPlus(p *util.XMLParser, obj *Artifact) (*Artifact, error) {
 		}
 	}
 }
-func parseCreature(p *util.XMLParser) (*Creature, error) {
+func parseCreature(p *parser) (*Creature, error) {
 	var obj = NewCreature()
 
 	for {
@@ -23027,7 +25245,7 @@ func parseCreature(p *util.XMLParser) (*Creature, error) {
 		}
 	}
 }
-func parseCreaturePlus(p *util.XMLParser, obj *Creature) (*Creature, error) {
+func parseCreaturePlus(p *parser, obj *Creature) (*Creature, error) {
 	if obj == nil {
 		obj = NewCreature()
 	}
@@ -23363,7 +25581,7 @@ func parseCreaturePlus(p *util.XMLParser, obj *Creature) (*Creature, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.CreatureId = txt(data)
+				obj.CreatureId = p.itxt(data)
 			case "does_not_exist":
 				_, err := p.Value()
 				if err != nil {
@@ -23765,7 +25983,7 @@ func parseCreaturePlus(p *util.XMLParser, obj *Creature) (*Creature, error) {
 		}
 	}
 }
-func parseDanceForm(p *util.XMLParser) (*DanceForm, error) {
+func parseDanceForm(p *parser) (*DanceForm, error) {
 	var obj = NewDanceForm()
 
 	for {
@@ -23799,7 +26017,7 @@ func parseDanceForm(p *util.XMLParser) (*DanceForm, error) {
 		}
 	}
 }
-func parseDanceFormPlus(p *util.XMLParser, obj *DanceForm) (*DanceForm, error) {
+func parseDanceFormPlus(p *parser, obj *DanceForm) (*DanceForm, error) {
 	if obj == nil {
 		obj = NewDanceForm()
 	}
@@ -23835,7 +26053,7 @@ func parseDanceFormPlus(p *util.XMLParser, obj *DanceForm) (*DanceForm, error) {
 		}
 	}
 }
-func parseDfWorld(p *util.XMLParser) (*DfWorld, error) {
+func parseDfWorld(p *parser) (*DfWorld, error) {
 	var obj = NewDfWorld()
 
 	for {
@@ -23887,7 +26105,7 @@ func parseDfWorld(p *util.XMLParser) (*DfWorld, error) {
 		}
 	}
 }
-func parseDfWorldPlus(p *util.XMLParser, obj *DfWorld) (*DfWorld, error) {
+func parseDfWorldPlus(p *parser, obj *DfWorld) (*DfWorld, error) {
 	if obj == nil {
 		obj = NewDfWorld()
 	}
@@ -23967,7 +26185,7 @@ func parseDfWorldPlus(p *util.XMLParser, obj *DfWorld) (*DfWorld, error) {
 		}
 	}
 }
-func parseEntity(p *util.XMLParser) (*Entity, error) {
+func parseEntity(p *parser) (*Entity, error) {
 	var obj = NewEntity()
 
 	for {
@@ -24004,7 +26222,7 @@ func parseEntity(p *util.XMLParser) (*Entity, error) {
 		}
 	}
 }
-func parseEntityPlus(p *util.XMLParser, obj *Entity) (*Entity, error) {
+func parseEntityPlus(p *parser, obj *Entity) (*Entity, error) {
 	if obj == nil {
 		obj = NewEntity()
 	}
@@ -24064,7 +26282,7 @@ func parseEntityPlus(p *util.XMLParser, obj *Entity) (*Entity, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Race = txt(data)
+				obj.Race = p.itxt(data)
 			case "type":
 				data, err := p.Value()
 				if err != nil {
@@ -24094,7 +26312,7 @@ func parseEntityPlus(p *util.XMLParser, obj *Entity) (*Entity, error) {
 		}
 	}
 }
-func parseEntityEntityLink(p *util.XMLParser) (*EntityEntityLink, error) {
+func parseEntityEntityLink(p *parser) (*EntityEntityLink, error) {
 	var obj = NewEntityEntityLink()
 
 	for {
@@ -24116,7 +26334,7 @@ func parseEntityEntityLink(p *util.XMLParser) (*EntityEntityLink, error) {
 		}
 	}
 }
-func parseEntityEntityLinkPlus(p *util.XMLParser, obj *EntityEntityLink) (*EntityEntityLink, error) {
+func parseEntityEntityLinkPlus(p *parser, obj *EntityEntityLink) (*EntityEntityLink, error) {
 	if obj == nil {
 		obj = NewEntityEntityLink()
 	}
@@ -24158,7 +26376,7 @@ func parseEntityEntityLinkPlus(p *util.XMLParser, obj *EntityEntityLink) (*Entit
 		}
 	}
 }
-func parseEntityFormerPositionLink(p *util.XMLParser) (*EntityFormerPositionLink, error) {
+func parseEntityFormerPositionLink(p *parser) (*EntityFormerPositionLink, error) {
 	var obj = NewEntityFormerPositionLink()
 
 	for {
@@ -24204,7 +26422,7 @@ func parseEntityFormerPositionLink(p *util.XMLParser) (*EntityFormerPositionLink
 		}
 	}
 }
-func parseEntityFormerPositionLinkPlus(p *util.XMLParser, obj *EntityFormerPositionLink) (*EntityFormerPositionLink, error) {
+func parseEntityFormerPositionLinkPlus(p *parser, obj *EntityFormerPositionLink) (*EntityFormerPositionLink, error) {
 	if obj == nil {
 		obj = NewEntityFormerPositionLink()
 	}
@@ -24228,7 +26446,7 @@ func parseEntityFormerPositionLinkPlus(p *util.XMLParser, obj *EntityFormerPosit
 		}
 	}
 }
-func parseEntityFormerSquadLink(p *util.XMLParser) (*EntityFormerSquadLink, error) {
+func parseEntityFormerSquadLink(p *parser) (*EntityFormerSquadLink, error) {
 	var obj = NewEntityFormerSquadLink()
 
 	for {
@@ -24274,7 +26492,7 @@ func parseEntityFormerSquadLink(p *util.XMLParser) (*EntityFormerSquadLink, erro
 		}
 	}
 }
-func parseEntityFormerSquadLinkPlus(p *util.XMLParser, obj *EntityFormerSquadLink) (*EntityFormerSquadLink, error) {
+func parseEntityFormerSquadLinkPlus(p *parser, obj *EntityFormerSquadLink) (*EntityFormerSquadLink, error) {
 	if obj == nil {
 		obj = NewEntityFormerSquadLink()
 	}
@@ -24298,7 +26516,7 @@ func parseEntityFormerSquadLinkPlus(p *util.XMLParser, obj *EntityFormerSquadLin
 		}
 	}
 }
-func parseEntityPopulation(p *util.XMLParser) (*EntityPopulation, error) {
+func parseEntityPopulation(p *parser) (*EntityPopulation, error) {
 	var obj = NewEntityPopulation()
 
 	for {
@@ -24326,7 +26544,7 @@ func parseEntityPopulation(p *util.XMLParser) (*EntityPopulation, error) {
 		}
 	}
 }
-func parseEntityPopulationPlus(p *util.XMLParser, obj *EntityPopulation) (*EntityPopulation, error) {
+func parseEntityPopulationPlus(p *parser, obj *EntityPopulation) (*EntityPopulation, error) {
 	if obj == nil {
 		obj = NewEntityPopulation()
 	}
@@ -24356,7 +26574,7 @@ func parseEntityPopulationPlus(p *util.XMLParser, obj *EntityPopulation) (*Entit
 				if err != nil {
 					return nil, err
 				}
-				obj.Race = txt(data)
+				obj.Race = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -24368,7 +26586,7 @@ func parseEntityPopulationPlus(p *util.XMLParser, obj *EntityPopulation) (*Entit
 		}
 	}
 }
-func parseEntityPosition(p *util.XMLParser) (*EntityPosition, error) {
+func parseEntityPosition(p *parser) (*EntityPosition, error) {
 	var obj = NewEntityPosition()
 
 	for {
@@ -24390,7 +26608,7 @@ func parseEntityPosition(p *util.XMLParser) (*EntityPosition, error) {
 		}
 	}
 }
-func parseEntityPositionPlus(p *util.XMLParser, obj *EntityPosition) (*EntityPosition, error) {
+func parseEntityPositionPlus(p *parser, obj *EntityPosition) (*EntityPosition, error) {
 	if obj == nil {
 		obj = NewEntityPosition()
 	}
@@ -24432,19 +26650,19 @@ func parseEntityPositionPlus(p *util.XMLParser, obj *EntityPosition) (*EntityPos
 				if err != nil {
 					return nil, err
 				}
-				obj.Spouse = txt(data)
+				obj.Spouse = p.itxt(data)
 			case "spouse_female":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.SpouseFemale = txt(data)
+				obj.SpouseFemale = p.itxt(data)
 			case "spouse_male":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.SpouseMale = txt(data)
+				obj.SpouseMale = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -24456,7 +26674,7 @@ func parseEntityPositionPlus(p *util.XMLParser, obj *EntityPosition) (*EntityPos
 		}
 	}
 }
-func parseEntityPositionAssignment(p *util.XMLParser) (*EntityPositionAssignment, error) {
+func parseEntityPositionAssignment(p *parser) (*EntityPositionAssignment, error) {
 	var obj = NewEntityPositionAssignment()
 
 	for {
@@ -24478,7 +26696,7 @@ func parseEntityPositionAssignment(p *util.XMLParser) (*EntityPositionAssignment
 		}
 	}
 }
-func parseEntityPositionAssignmentPlus(p *util.XMLParser, obj *EntityPositionAssignment) (*EntityPositionAssignment, error) {
+func parseEntityPositionAssignmentPlus(p *parser, obj *EntityPositionAssignment) (*EntityPositionAssignment, error) {
 	if obj == nil {
 		obj = NewEntityPositionAssignment()
 	}
@@ -24526,7 +26744,7 @@ func parseEntityPositionAssignmentPlus(p *util.XMLParser, obj *EntityPositionAss
 		}
 	}
 }
-func parseEntityPositionLink(p *util.XMLParser) (*EntityPositionLink, error) {
+func parseEntityPositionLink(p *parser) (*EntityPositionLink, error) {
 	var obj = NewEntityPositionLink()
 
 	for {
@@ -24566,7 +26784,7 @@ func parseEntityPositionLink(p *util.XMLParser) (*EntityPositionLink, error) {
 		}
 	}
 }
-func parseEntityPositionLinkPlus(p *util.XMLParser, obj *EntityPositionLink) (*EntityPositionLink, error) {
+func parseEntityPositionLinkPlus(p *parser, obj *EntityPositionLink) (*EntityPositionLink, error) {
 	if obj == nil {
 		obj = NewEntityPositionLink()
 	}
@@ -24590,7 +26808,7 @@ func parseEntityPositionLinkPlus(p *util.XMLParser, obj *EntityPositionLink) (*E
 		}
 	}
 }
-func parseEntityReputation(p *util.XMLParser) (*EntityReputation, error) {
+func parseEntityReputation(p *parser) (*EntityReputation, error) {
 	var obj = NewEntityReputation()
 
 	for {
@@ -24702,7 +26920,7 @@ func parseEntityReputation(p *util.XMLParser) (*EntityReputation, error) {
 		}
 	}
 }
-func parseEntityReputationPlus(p *util.XMLParser, obj *EntityReputation) (*EntityReputation, error) {
+func parseEntityReputationPlus(p *parser, obj *EntityReputation) (*EntityReputation, error) {
 	if obj == nil {
 		obj = NewEntityReputation()
 	}
@@ -24726,7 +26944,7 @@ func parseEntityReputationPlus(p *util.XMLParser, obj *EntityReputation) (*Entit
 		}
 	}
 }
-func parseEntitySquadLink(p *util.XMLParser) (*EntitySquadLink, error) {
+func parseEntitySquadLink(p *parser) (*EntitySquadLink, error) {
 	var obj = NewEntitySquadLink()
 
 	for {
@@ -24772,7 +26990,7 @@ func parseEntitySquadLink(p *util.XMLParser) (*EntitySquadLink, error) {
 		}
 	}
 }
-func parseEntitySquadLinkPlus(p *util.XMLParser, obj *EntitySquadLink) (*EntitySquadLink, error) {
+func parseEntitySquadLinkPlus(p *parser, obj *EntitySquadLink) (*EntitySquadLink, error) {
 	if obj == nil {
 		obj = NewEntitySquadLink()
 	}
@@ -24796,7 +27014,7 @@ func parseEntitySquadLinkPlus(p *util.XMLParser, obj *EntitySquadLink) (*EntityS
 		}
 	}
 }
-func parseFeature(p *util.XMLParser) (*Feature, error) {
+func parseFeature(p *parser) (*Feature, error) {
 	var obj = NewFeature()
 
 	for {
@@ -24818,7 +27036,7 @@ func parseFeature(p *util.XMLParser) (*Feature, error) {
 		}
 	}
 }
-func parseFeaturePlus(p *util.XMLParser, obj *Feature) (*Feature, error) {
+func parseFeaturePlus(p *parser, obj *Feature) (*Feature, error) {
 	if obj == nil {
 		obj = NewFeature()
 	}
@@ -24854,7 +27072,7 @@ func parseFeaturePlus(p *util.XMLParser, obj *Feature) (*Feature, error) {
 		}
 	}
 }
-func parseHfLink(p *util.XMLParser) (*HfLink, error) {
+func parseHfLink(p *parser) (*HfLink, error) {
 	var obj = NewHfLink()
 
 	for {
@@ -24894,7 +27112,7 @@ func parseHfLink(p *util.XMLParser) (*HfLink, error) {
 		}
 	}
 }
-func parseHfLinkPlus(p *util.XMLParser, obj *HfLink) (*HfLink, error) {
+func parseHfLinkPlus(p *parser, obj *HfLink) (*HfLink, error) {
 	if obj == nil {
 		obj = NewHfLink()
 	}
@@ -24918,7 +27136,7 @@ func parseHfLinkPlus(p *util.XMLParser, obj *HfLink) (*HfLink, error) {
 		}
 	}
 }
-func parseHfSkill(p *util.XMLParser) (*HfSkill, error) {
+func parseHfSkill(p *parser) (*HfSkill, error) {
 	var obj = NewHfSkill()
 
 	for {
@@ -24934,7 +27152,7 @@ func parseHfSkill(p *util.XMLParser) (*HfSkill, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Skill = txt(data)
+				obj.Skill = p.itxt(data)
 			case "total_ip":
 				data, err := p.Value()
 				if err != nil {
@@ -24952,7 +27170,7 @@ func parseHfSkill(p *util.XMLParser) (*HfSkill, error) {
 		}
 	}
 }
-func parseHfSkillPlus(p *util.XMLParser, obj *HfSkill) (*HfSkill, error) {
+func parseHfSkillPlus(p *parser, obj *HfSkill) (*HfSkill, error) {
 	if obj == nil {
 		obj = NewHfSkill()
 	}
@@ -24976,7 +27194,7 @@ func parseHfSkillPlus(p *util.XMLParser, obj *HfSkill) (*HfSkill, error) {
 		}
 	}
 }
-func parseHistoricalEra(p *util.XMLParser) (*HistoricalEra, error) {
+func parseHistoricalEra(p *parser) (*HistoricalEra, error) {
 	var obj = NewHistoricalEra()
 
 	for {
@@ -25010,7 +27228,7 @@ func parseHistoricalEra(p *util.XMLParser) (*HistoricalEra, error) {
 		}
 	}
 }
-func parseHistoricalEraPlus(p *util.XMLParser, obj *HistoricalEra) (*HistoricalEra, error) {
+func parseHistoricalEraPlus(p *parser, obj *HistoricalEra) (*HistoricalEra, error) {
 	if obj == nil {
 		obj = NewHistoricalEra()
 	}
@@ -25034,8 +27252,8 @@ func parseHistoricalEraPlus(p *util.XMLParser, obj *HistoricalEra) (*HistoricalE
 		}
 	}
 }
-func parseHistoricalEvent(p *util.XMLParser) (*HistoricalEvent, error) {
-	var obj = NewHistoricalEvent()
+func parseHistoricalEvent(p *parser) (*HistoricalEvent, error) {
+	var obj = p.newHistoricalEvent()
 
 	for {
 		t, n, err := p.Token()
@@ -25352,9 +27570,9 @@ func parseHistoricalEvent(p *util.XMLParser) (*HistoricalEvent, error) {
 		}
 	}
 }
-func parseHistoricalEventPlus(p *util.XMLParser, obj *HistoricalEvent) (*HistoricalEvent, error) {
+func parseHistoricalEventPlus(p *parser, obj *HistoricalEvent) (*HistoricalEvent, error) {
 	if obj == nil {
-		obj = NewHistoricalEvent()
+		obj = p.newHistoricalEvent()
 	}
 
 	for {
@@ -25490,8 +27708,8 @@ func parseHistoricalEventPlus(p *util.XMLParser, obj *HistoricalEvent) (*Histori
 		}
 	}
 }
-func parseHistoricalEventAddHfEntityHonor(p *util.XMLParser) (*HistoricalEventAddHfEntityHonor, error) {
-	var obj = NewHistoricalEventAddHfEntityHonor()
+func parseHistoricalEventAddHfEntityHonor(p *parser) (*HistoricalEventAddHfEntityHonor, error) {
+	var obj = p.newHistoricalEventAddHfEntityHonor()
 
 	for {
 		t, n, err := p.Token()
@@ -25530,9 +27748,9 @@ func parseHistoricalEventAddHfEntityHonor(p *util.XMLParser) (*HistoricalEventAd
 		}
 	}
 }
-func parseHistoricalEventAddHfEntityHonorPlus(p *util.XMLParser, obj *HistoricalEventAddHfEntityHonor) (*HistoricalEventAddHfEntityHonor, error) {
+func parseHistoricalEventAddHfEntityHonorPlus(p *parser, obj *HistoricalEventAddHfEntityHonor) (*HistoricalEventAddHfEntityHonor, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAddHfEntityHonor()
+		obj = p.newHistoricalEventAddHfEntityHonor()
 	}
 
 	for {
@@ -25554,8 +27772,8 @@ func parseHistoricalEventAddHfEntityHonorPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventAddHfEntityLink(p *util.XMLParser) (*HistoricalEventAddHfEntityLink, error) {
-	var obj = NewHistoricalEventAddHfEntityLink()
+func parseHistoricalEventAddHfEntityLink(p *parser) (*HistoricalEventAddHfEntityLink, error) {
+	var obj = p.newHistoricalEventAddHfEntityLink()
 
 	for {
 		t, n, err := p.Token()
@@ -25612,9 +27830,9 @@ func parseHistoricalEventAddHfEntityLink(p *util.XMLParser) (*HistoricalEventAdd
 		}
 	}
 }
-func parseHistoricalEventAddHfEntityLinkPlus(p *util.XMLParser, obj *HistoricalEventAddHfEntityLink) (*HistoricalEventAddHfEntityLink, error) {
+func parseHistoricalEventAddHfEntityLinkPlus(p *parser, obj *HistoricalEventAddHfEntityLink) (*HistoricalEventAddHfEntityLink, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAddHfEntityLink()
+		obj = p.newHistoricalEventAddHfEntityLink()
 	}
 
 	for {
@@ -25654,7 +27872,7 @@ func parseHistoricalEventAddHfEntityLinkPlus(p *util.XMLParser, obj *HistoricalE
 				if err != nil {
 					return nil, err
 				}
-				obj.Position = txt(data)
+				obj.Position = p.itxt(data)
 			case "promise_to_hfid":
 				data, err := p.Value()
 				if err != nil {
@@ -25672,8 +27890,8 @@ func parseHistoricalEventAddHfEntityLinkPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventAddHfHfLink(p *util.XMLParser) (*HistoricalEventAddHfHfLink, error) {
-	var obj = NewHistoricalEventAddHfHfLink()
+func parseHistoricalEventAddHfHfLink(p *parser) (*HistoricalEventAddHfHfLink, error) {
+	var obj = p.newHistoricalEventAddHfHfLink()
 
 	for {
 		t, n, err := p.Token()
@@ -25706,9 +27924,9 @@ func parseHistoricalEventAddHfHfLink(p *util.XMLParser) (*HistoricalEventAddHfHf
 		}
 	}
 }
-func parseHistoricalEventAddHfHfLinkPlus(p *util.XMLParser, obj *HistoricalEventAddHfHfLink) (*HistoricalEventAddHfHfLink, error) {
+func parseHistoricalEventAddHfHfLinkPlus(p *parser, obj *HistoricalEventAddHfHfLink) (*HistoricalEventAddHfHfLink, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAddHfHfLink()
+		obj = p.newHistoricalEventAddHfHfLink()
 	}
 
 	for {
@@ -25748,8 +27966,8 @@ func parseHistoricalEventAddHfHfLinkPlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventAddHfSiteLink(p *util.XMLParser) (*HistoricalEventAddHfSiteLink, error) {
-	var obj = NewHistoricalEventAddHfSiteLink()
+func parseHistoricalEventAddHfSiteLink(p *parser) (*HistoricalEventAddHfSiteLink, error) {
+	var obj = p.newHistoricalEventAddHfSiteLink()
 
 	for {
 		t, n, err := p.Token()
@@ -25776,9 +27994,9 @@ func parseHistoricalEventAddHfSiteLink(p *util.XMLParser) (*HistoricalEventAddHf
 		}
 	}
 }
-func parseHistoricalEventAddHfSiteLinkPlus(p *util.XMLParser, obj *HistoricalEventAddHfSiteLink) (*HistoricalEventAddHfSiteLink, error) {
+func parseHistoricalEventAddHfSiteLinkPlus(p *parser, obj *HistoricalEventAddHfSiteLink) (*HistoricalEventAddHfSiteLink, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAddHfSiteLink()
+		obj = p.newHistoricalEventAddHfSiteLink()
 	}
 
 	for {
@@ -25830,8 +28048,8 @@ func parseHistoricalEventAddHfSiteLinkPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventAgreementConcluded(p *util.XMLParser) (*HistoricalEventAgreementConcluded, error) {
-	var obj = NewHistoricalEventAgreementConcluded()
+func parseHistoricalEventAgreementConcluded(p *parser) (*HistoricalEventAgreementConcluded, error) {
+	var obj = p.newHistoricalEventAgreementConcluded()
 
 	for {
 		t, n, err := p.Token()
@@ -25852,9 +28070,9 @@ func parseHistoricalEventAgreementConcluded(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventAgreementConcludedPlus(p *util.XMLParser, obj *HistoricalEventAgreementConcluded) (*HistoricalEventAgreementConcluded, error) {
+func parseHistoricalEventAgreementConcludedPlus(p *parser, obj *HistoricalEventAgreementConcluded) (*HistoricalEventAgreementConcluded, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAgreementConcluded()
+		obj = p.newHistoricalEventAgreementConcluded()
 	}
 
 	for {
@@ -25906,8 +28124,8 @@ func parseHistoricalEventAgreementConcludedPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventAgreementFormed(p *util.XMLParser) (*HistoricalEventAgreementFormed, error) {
-	var obj = NewHistoricalEventAgreementFormed()
+func parseHistoricalEventAgreementFormed(p *parser) (*HistoricalEventAgreementFormed, error) {
+	var obj = p.newHistoricalEventAgreementFormed()
 
 	for {
 		t, n, err := p.Token()
@@ -26066,9 +28284,9 @@ func parseHistoricalEventAgreementFormed(p *util.XMLParser) (*HistoricalEventAgr
 		}
 	}
 }
-func parseHistoricalEventAgreementFormedPlus(p *util.XMLParser, obj *HistoricalEventAgreementFormed) (*HistoricalEventAgreementFormed, error) {
+func parseHistoricalEventAgreementFormedPlus(p *parser, obj *HistoricalEventAgreementFormed) (*HistoricalEventAgreementFormed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAgreementFormed()
+		obj = p.newHistoricalEventAgreementFormed()
 	}
 
 	for {
@@ -26090,8 +28308,8 @@ func parseHistoricalEventAgreementFormedPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventAgreementMade(p *util.XMLParser) (*HistoricalEventAgreementMade, error) {
-	var obj = NewHistoricalEventAgreementMade()
+func parseHistoricalEventAgreementMade(p *parser) (*HistoricalEventAgreementMade, error) {
+	var obj = p.newHistoricalEventAgreementMade()
 
 	for {
 		t, n, err := p.Token()
@@ -26118,9 +28336,9 @@ func parseHistoricalEventAgreementMade(p *util.XMLParser) (*HistoricalEventAgree
 		}
 	}
 }
-func parseHistoricalEventAgreementMadePlus(p *util.XMLParser, obj *HistoricalEventAgreementMade) (*HistoricalEventAgreementMade, error) {
+func parseHistoricalEventAgreementMadePlus(p *parser, obj *HistoricalEventAgreementMade) (*HistoricalEventAgreementMade, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAgreementMade()
+		obj = p.newHistoricalEventAgreementMade()
 	}
 
 	for {
@@ -26166,8 +28384,8 @@ func parseHistoricalEventAgreementMadePlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventAgreementRejected(p *util.XMLParser) (*HistoricalEventAgreementRejected, error) {
-	var obj = NewHistoricalEventAgreementRejected()
+func parseHistoricalEventAgreementRejected(p *parser) (*HistoricalEventAgreementRejected, error) {
+	var obj = p.newHistoricalEventAgreementRejected()
 
 	for {
 		t, n, err := p.Token()
@@ -26194,9 +28412,9 @@ func parseHistoricalEventAgreementRejected(p *util.XMLParser) (*HistoricalEventA
 		}
 	}
 }
-func parseHistoricalEventAgreementRejectedPlus(p *util.XMLParser, obj *HistoricalEventAgreementRejected) (*HistoricalEventAgreementRejected, error) {
+func parseHistoricalEventAgreementRejectedPlus(p *parser, obj *HistoricalEventAgreementRejected) (*HistoricalEventAgreementRejected, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAgreementRejected()
+		obj = p.newHistoricalEventAgreementRejected()
 	}
 
 	for {
@@ -26242,8 +28460,8 @@ func parseHistoricalEventAgreementRejectedPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventArtifactClaimFormed(p *util.XMLParser) (*HistoricalEventArtifactClaimFormed, error) {
-	var obj = NewHistoricalEventArtifactClaimFormed()
+func parseHistoricalEventArtifactClaimFormed(p *parser) (*HistoricalEventArtifactClaimFormed, error) {
+	var obj = p.newHistoricalEventArtifactClaimFormed()
 
 	for {
 		t, n, err := p.Token()
@@ -26300,9 +28518,9 @@ func parseHistoricalEventArtifactClaimFormed(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventArtifactClaimFormedPlus(p *util.XMLParser, obj *HistoricalEventArtifactClaimFormed) (*HistoricalEventArtifactClaimFormed, error) {
+func parseHistoricalEventArtifactClaimFormedPlus(p *parser, obj *HistoricalEventArtifactClaimFormed) (*HistoricalEventArtifactClaimFormed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactClaimFormed()
+		obj = p.newHistoricalEventArtifactClaimFormed()
 	}
 
 	for {
@@ -26324,8 +28542,8 @@ func parseHistoricalEventArtifactClaimFormedPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventArtifactCopied(p *util.XMLParser) (*HistoricalEventArtifactCopied, error) {
-	var obj = NewHistoricalEventArtifactCopied()
+func parseHistoricalEventArtifactCopied(p *parser) (*HistoricalEventArtifactCopied, error) {
+	var obj = p.newHistoricalEventArtifactCopied()
 
 	for {
 		t, n, err := p.Token()
@@ -26394,9 +28612,9 @@ func parseHistoricalEventArtifactCopied(p *util.XMLParser) (*HistoricalEventArti
 		}
 	}
 }
-func parseHistoricalEventArtifactCopiedPlus(p *util.XMLParser, obj *HistoricalEventArtifactCopied) (*HistoricalEventArtifactCopied, error) {
+func parseHistoricalEventArtifactCopiedPlus(p *parser, obj *HistoricalEventArtifactCopied) (*HistoricalEventArtifactCopied, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactCopied()
+		obj = p.newHistoricalEventArtifactCopied()
 	}
 
 	for {
@@ -26418,8 +28636,8 @@ func parseHistoricalEventArtifactCopiedPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventArtifactCreated(p *util.XMLParser) (*HistoricalEventArtifactCreated, error) {
-	var obj = NewHistoricalEventArtifactCreated()
+func parseHistoricalEventArtifactCreated(p *parser) (*HistoricalEventArtifactCreated, error) {
+	var obj = p.newHistoricalEventArtifactCreated()
 
 	for {
 		t, n, err := p.Token()
@@ -26476,9 +28694,9 @@ func parseHistoricalEventArtifactCreated(p *util.XMLParser) (*HistoricalEventArt
 		}
 	}
 }
-func parseHistoricalEventArtifactCreatedPlus(p *util.XMLParser, obj *HistoricalEventArtifactCreated) (*HistoricalEventArtifactCreated, error) {
+func parseHistoricalEventArtifactCreatedPlus(p *parser, obj *HistoricalEventArtifactCreated) (*HistoricalEventArtifactCreated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactCreated()
+		obj = p.newHistoricalEventArtifactCreated()
 	}
 
 	for {
@@ -26539,8 +28757,8 @@ func parseHistoricalEventArtifactCreatedPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventArtifactCreatedCircumstance(p *util.XMLParser) (*HistoricalEventArtifactCreatedCircumstance, error) {
-	var obj = NewHistoricalEventArtifactCreatedCircumstance()
+func parseHistoricalEventArtifactCreatedCircumstance(p *parser) (*HistoricalEventArtifactCreatedCircumstance, error) {
+	var obj = p.newHistoricalEventArtifactCreatedCircumstance()
 
 	for {
 		t, n, err := p.Token()
@@ -26561,9 +28779,9 @@ func parseHistoricalEventArtifactCreatedCircumstance(p *util.XMLParser) (*Histor
 		}
 	}
 }
-func parseHistoricalEventArtifactCreatedCircumstancePlus(p *util.XMLParser, obj *HistoricalEventArtifactCreatedCircumstance) (*HistoricalEventArtifactCreatedCircumstance, error) {
+func parseHistoricalEventArtifactCreatedCircumstancePlus(p *parser, obj *HistoricalEventArtifactCreatedCircumstance) (*HistoricalEventArtifactCreatedCircumstance, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactCreatedCircumstance()
+		obj = p.newHistoricalEventArtifactCreatedCircumstance()
 	}
 
 	for {
@@ -26597,8 +28815,8 @@ func parseHistoricalEventArtifactCreatedCircumstancePlus(p *util.XMLParser, obj
 		}
 	}
 }
-func parseHistoricalEventArtifactDestroyed(p *util.XMLParser) (*HistoricalEventArtifactDestroyed, error) {
-	var obj = NewHistoricalEventArtifactDestroyed()
+func parseHistoricalEventArtifactDestroyed(p *parser) (*HistoricalEventArtifactDestroyed, error) {
+	var obj = p.newHistoricalEventArtifactDestroyed()
 
 	for {
 		t, n, err := p.Token()
@@ -26637,9 +28855,9 @@ func parseHistoricalEventArtifactDestroyed(p *util.XMLParser) (*HistoricalEventA
 		}
 	}
 }
-func parseHistoricalEventArtifactDestroyedPlus(p *util.XMLParser, obj *HistoricalEventArtifactDestroyed) (*HistoricalEventArtifactDestroyed, error) {
+func parseHistoricalEventArtifactDestroyedPlus(p *parser, obj *HistoricalEventArtifactDestroyed) (*HistoricalEventArtifactDestroyed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactDestroyed()
+		obj = p.newHistoricalEventArtifactDestroyed()
 	}
 
 	for {
@@ -26661,8 +28879,8 @@ func parseHistoricalEventArtifactDestroyedPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventArtifactFound(p *util.XMLParser) (*HistoricalEventArtifactFound, error) {
-	var obj = NewHistoricalEventArtifactFound()
+func parseHistoricalEventArtifactFound(p *parser) (*HistoricalEventArtifactFound, error) {
+	var obj = p.newHistoricalEventArtifactFound()
 
 	for {
 		t, n, err := p.Token()
@@ -26713,9 +28931,9 @@ func parseHistoricalEventArtifactFound(p *util.XMLParser) (*HistoricalEventArtif
 		}
 	}
 }
-func parseHistoricalEventArtifactFoundPlus(p *util.XMLParser, obj *HistoricalEventArtifactFound) (*HistoricalEventArtifactFound, error) {
+func parseHistoricalEventArtifactFoundPlus(p *parser, obj *HistoricalEventArtifactFound) (*HistoricalEventArtifactFound, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactFound()
+		obj = p.newHistoricalEventArtifactFound()
 	}
 
 	for {
@@ -26737,8 +28955,8 @@ func parseHistoricalEventArtifactFoundPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventArtifactGiven(p *util.XMLParser) (*HistoricalEventArtifactGiven, error) {
-	var obj = NewHistoricalEventArtifactGiven()
+func parseHistoricalEventArtifactGiven(p *parser) (*HistoricalEventArtifactGiven, error) {
+	var obj = p.newHistoricalEventArtifactGiven()
 
 	for {
 		t, n, err := p.Token()
@@ -26801,9 +29019,9 @@ func parseHistoricalEventArtifactGiven(p *util.XMLParser) (*HistoricalEventArtif
 		}
 	}
 }
-func parseHistoricalEventArtifactGivenPlus(p *util.XMLParser, obj *HistoricalEventArtifactGiven) (*HistoricalEventArtifactGiven, error) {
+func parseHistoricalEventArtifactGivenPlus(p *parser, obj *HistoricalEventArtifactGiven) (*HistoricalEventArtifactGiven, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactGiven()
+		obj = p.newHistoricalEventArtifactGiven()
 	}
 
 	for {
@@ -26825,8 +29043,8 @@ func parseHistoricalEventArtifactGivenPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventArtifactLost(p *util.XMLParser) (*HistoricalEventArtifactLost, error) {
-	var obj = NewHistoricalEventArtifactLost()
+func parseHistoricalEventArtifactLost(p *parser) (*HistoricalEventArtifactLost, error) {
+	var obj = p.newHistoricalEventArtifactLost()
 
 	for {
 		t, n, err := p.Token()
@@ -26877,9 +29095,9 @@ func parseHistoricalEventArtifactLost(p *util.XMLParser) (*HistoricalEventArtifa
 		}
 	}
 }
-func parseHistoricalEventArtifactLostPlus(p *util.XMLParser, obj *HistoricalEventArtifactLost) (*HistoricalEventArtifactLost, error) {
+func parseHistoricalEventArtifactLostPlus(p *parser, obj *HistoricalEventArtifactLost) (*HistoricalEventArtifactLost, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactLost()
+		obj = p.newHistoricalEventArtifactLost()
 	}
 
 	for {
@@ -26901,8 +29119,8 @@ func parseHistoricalEventArtifactLostPlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventArtifactPossessed(p *util.XMLParser) (*HistoricalEventArtifactPossessed, error) {
-	var obj = NewHistoricalEventArtifactPossessed()
+func parseHistoricalEventArtifactPossessed(p *parser) (*HistoricalEventArtifactPossessed, error) {
+	var obj = p.newHistoricalEventArtifactPossessed()
 
 	for {
 		t, n, err := p.Token()
@@ -26983,9 +29201,9 @@ func parseHistoricalEventArtifactPossessed(p *util.XMLParser) (*HistoricalEventA
 		}
 	}
 }
-func parseHistoricalEventArtifactPossessedPlus(p *util.XMLParser, obj *HistoricalEventArtifactPossessed) (*HistoricalEventArtifactPossessed, error) {
+func parseHistoricalEventArtifactPossessedPlus(p *parser, obj *HistoricalEventArtifactPossessed) (*HistoricalEventArtifactPossessed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactPossessed()
+		obj = p.newHistoricalEventArtifactPossessed()
 	}
 
 	for {
@@ -27007,8 +29225,8 @@ func parseHistoricalEventArtifactPossessedPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventArtifactRecovered(p *util.XMLParser) (*HistoricalEventArtifactRecovered, error) {
-	var obj = NewHistoricalEventArtifactRecovered()
+func parseHistoricalEventArtifactRecovered(p *parser) (*HistoricalEventArtifactRecovered, error) {
+	var obj = p.newHistoricalEventArtifactRecovered()
 
 	for {
 		t, n, err := p.Token()
@@ -27071,9 +29289,9 @@ func parseHistoricalEventArtifactRecovered(p *util.XMLParser) (*HistoricalEventA
 		}
 	}
 }
-func parseHistoricalEventArtifactRecoveredPlus(p *util.XMLParser, obj *HistoricalEventArtifactRecovered) (*HistoricalEventArtifactRecovered, error) {
+func parseHistoricalEventArtifactRecoveredPlus(p *parser, obj *HistoricalEventArtifactRecovered) (*HistoricalEventArtifactRecovered, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactRecovered()
+		obj = p.newHistoricalEventArtifactRecovered()
 	}
 
 	for {
@@ -27095,8 +29313,8 @@ func parseHistoricalEventArtifactRecoveredPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventArtifactStored(p *util.XMLParser) (*HistoricalEventArtifactStored, error) {
-	var obj = NewHistoricalEventArtifactStored()
+func parseHistoricalEventArtifactStored(p *parser) (*HistoricalEventArtifactStored, error) {
+	var obj = p.newHistoricalEventArtifactStored()
 
 	for {
 		t, n, err := p.Token()
@@ -27141,9 +29359,9 @@ func parseHistoricalEventArtifactStored(p *util.XMLParser) (*HistoricalEventArti
 		}
 	}
 }
-func parseHistoricalEventArtifactStoredPlus(p *util.XMLParser, obj *HistoricalEventArtifactStored) (*HistoricalEventArtifactStored, error) {
+func parseHistoricalEventArtifactStoredPlus(p *parser, obj *HistoricalEventArtifactStored) (*HistoricalEventArtifactStored, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactStored()
+		obj = p.newHistoricalEventArtifactStored()
 	}
 
 	for {
@@ -27165,8 +29383,8 @@ func parseHistoricalEventArtifactStoredPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventArtifactTransformed(p *util.XMLParser) (*HistoricalEventArtifactTransformed, error) {
-	var obj = NewHistoricalEventArtifactTransformed()
+func parseHistoricalEventArtifactTransformed(p *parser) (*HistoricalEventArtifactTransformed, error) {
+	var obj = p.newHistoricalEventArtifactTransformed()
 
 	for {
 		t, n, err := p.Token()
@@ -27217,9 +29435,9 @@ func parseHistoricalEventArtifactTransformed(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventArtifactTransformedPlus(p *util.XMLParser, obj *HistoricalEventArtifactTransformed) (*HistoricalEventArtifactTransformed, error) {
+func parseHistoricalEventArtifactTransformedPlus(p *parser, obj *HistoricalEventArtifactTransformed) (*HistoricalEventArtifactTransformed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventArtifactTransformed()
+		obj = p.newHistoricalEventArtifactTransformed()
 	}
 
 	for {
@@ -27241,8 +29459,8 @@ func parseHistoricalEventArtifactTransformedPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventAssumeIdentity(p *util.XMLParser) (*HistoricalEventAssumeIdentity, error) {
-	var obj = NewHistoricalEventAssumeIdentity()
+func parseHistoricalEventAssumeIdentity(p *parser) (*HistoricalEventAssumeIdentity, error) {
+	var obj = p.newHistoricalEventAssumeIdentity()
 
 	for {
 		t, n, err := p.Token()
@@ -27281,9 +29499,9 @@ func parseHistoricalEventAssumeIdentity(p *util.XMLParser) (*HistoricalEventAssu
 		}
 	}
 }
-func parseHistoricalEventAssumeIdentityPlus(p *util.XMLParser, obj *HistoricalEventAssumeIdentity) (*HistoricalEventAssumeIdentity, error) {
+func parseHistoricalEventAssumeIdentityPlus(p *parser, obj *HistoricalEventAssumeIdentity) (*HistoricalEventAssumeIdentity, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAssumeIdentity()
+		obj = p.newHistoricalEventAssumeIdentity()
 	}
 
 	for {
@@ -27299,7 +29517,7 @@ func parseHistoricalEventAssumeIdentityPlus(p *util.XMLParser, obj *HistoricalEv
 				if err != nil {
 					return nil, err
 				}
-				obj.IdentityCaste = txt(data)
+				obj.IdentityCaste = p.itxt(data)
 			case "identity_histfig_id":
 				data, err := p.Value()
 				if err != nil {
@@ -27323,7 +29541,7 @@ func parseHistoricalEventAssumeIdentityPlus(p *util.XMLParser, obj *HistoricalEv
 				if err != nil {
 					return nil, err
 				}
-				obj.IdentityRace = txt(data)
+				obj.IdentityRace = p.itxt(data)
 			case "target":
 				data, err := p.Value()
 				if err != nil {
@@ -27347,8 +29565,8 @@ func parseHistoricalEventAssumeIdentityPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventAttackedSite(p *util.XMLParser) (*HistoricalEventAttackedSite, error) {
-	var obj = NewHistoricalEventAttackedSite()
+func parseHistoricalEventAttackedSite(p *parser) (*HistoricalEventAttackedSite, error) {
+	var obj = p.newHistoricalEventAttackedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -27429,9 +29647,9 @@ func parseHistoricalEventAttackedSite(p *util.XMLParser) (*HistoricalEventAttack
 		}
 	}
 }
-func parseHistoricalEventAttackedSitePlus(p *util.XMLParser, obj *HistoricalEventAttackedSite) (*HistoricalEventAttackedSite, error) {
+func parseHistoricalEventAttackedSitePlus(p *parser, obj *HistoricalEventAttackedSite) (*HistoricalEventAttackedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventAttackedSite()
+		obj = p.newHistoricalEventAttackedSite()
 	}
 
 	for {
@@ -27453,8 +29671,8 @@ func parseHistoricalEventAttackedSitePlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventBodyAbused(p *util.XMLParser) (*HistoricalEventBodyAbused, error) {
-	var obj = NewHistoricalEventBodyAbused()
+func parseHistoricalEventBodyAbused(p *parser) (*HistoricalEventBodyAbused, error) {
+	var obj = p.newHistoricalEventBodyAbused()
 
 	for {
 		t, n, err := p.Token()
@@ -27470,6 +29688,7 @@ func parseHistoricalEventBodyAbused(p *util.XMLParser) (*HistoricalEventBodyAbus
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -27499,9 +29718,9 @@ func parseHistoricalEventBodyAbused(p *util.XMLParser) (*HistoricalEventBodyAbus
 		}
 	}
 }
-func parseHistoricalEventBodyAbusedPlus(p *util.XMLParser, obj *HistoricalEventBodyAbused) (*HistoricalEventBodyAbused, error) {
+func parseHistoricalEventBodyAbusedPlus(p *parser, obj *HistoricalEventBodyAbused) (*HistoricalEventBodyAbused, error) {
 	if obj == nil {
-		obj = NewHistoricalEventBodyAbused()
+		obj = p.newHistoricalEventBodyAbused()
 	}
 
 	for {
@@ -27547,7 +29766,7 @@ func parseHistoricalEventBodyAbusedPlus(p *util.XMLParser, obj *HistoricalEventB
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemMat = txt(data)
+				obj.ItemMat = p.itxt(data)
 			case "item_subtype":
 				data, err := p.Value()
 				if err != nil {
@@ -27601,8 +29820,8 @@ func parseHistoricalEventBodyAbusedPlus(p *util.XMLParser, obj *HistoricalEventB
 		}
 	}
 }
-func parseHistoricalEventBuildingProfileAcquired(p *util.XMLParser) (*HistoricalEventBuildingProfileAcquired, error) {
-	var obj = NewHistoricalEventBuildingProfileAcquired()
+func parseHistoricalEventBuildingProfileAcquired(p *parser) (*HistoricalEventBuildingProfileAcquired, error) {
+	var obj = p.newHistoricalEventBuildingProfileAcquired()
 
 	for {
 		t, n, err := p.Token()
@@ -27671,9 +29890,9 @@ func parseHistoricalEventBuildingProfileAcquired(p *util.XMLParser) (*Historical
 		}
 	}
 }
-func parseHistoricalEventBuildingProfileAcquiredPlus(p *util.XMLParser, obj *HistoricalEventBuildingProfileAcquired) (*HistoricalEventBuildingProfileAcquired, error) {
+func parseHistoricalEventBuildingProfileAcquiredPlus(p *parser, obj *HistoricalEventBuildingProfileAcquired) (*HistoricalEventBuildingProfileAcquired, error) {
 	if obj == nil {
-		obj = NewHistoricalEventBuildingProfileAcquired()
+		obj = p.newHistoricalEventBuildingProfileAcquired()
 	}
 
 	for {
@@ -27695,8 +29914,8 @@ func parseHistoricalEventBuildingProfileAcquiredPlus(p *util.XMLParser, obj *His
 		}
 	}
 }
-func parseHistoricalEventCeremony(p *util.XMLParser) (*HistoricalEventCeremony, error) {
-	var obj = NewHistoricalEventCeremony()
+func parseHistoricalEventCeremony(p *parser) (*HistoricalEventCeremony, error) {
+	var obj = p.newHistoricalEventCeremony()
 
 	for {
 		t, n, err := p.Token()
@@ -27753,9 +29972,9 @@ func parseHistoricalEventCeremony(p *util.XMLParser) (*HistoricalEventCeremony,
 		}
 	}
 }
-func parseHistoricalEventCeremonyPlus(p *util.XMLParser, obj *HistoricalEventCeremony) (*HistoricalEventCeremony, error) {
+func parseHistoricalEventCeremonyPlus(p *parser, obj *HistoricalEventCeremony) (*HistoricalEventCeremony, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCeremony()
+		obj = p.newHistoricalEventCeremony()
 	}
 
 	for {
@@ -27777,8 +29996,8 @@ func parseHistoricalEventCeremonyPlus(p *util.XMLParser, obj *HistoricalEventCer
 		}
 	}
 }
-func parseHistoricalEventChangeHfBodyState(p *util.XMLParser) (*HistoricalEventChangeHfBodyState, error) {
-	var obj = NewHistoricalEventChangeHfBodyState()
+func parseHistoricalEventChangeHfBodyState(p *parser) (*HistoricalEventChangeHfBodyState, error) {
+	var obj = p.newHistoricalEventChangeHfBodyState()
 
 	for {
 		t, n, err := p.Token()
@@ -27800,6 +30019,7 @@ func parseHistoricalEventChangeHfBodyState(p *util.XMLParser) (*HistoricalEventC
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -27841,9 +30061,9 @@ func parseHistoricalEventChangeHfBodyState(p *util.XMLParser) (*HistoricalEventC
 		}
 	}
 }
-func parseHistoricalEventChangeHfBodyStatePlus(p *util.XMLParser, obj *HistoricalEventChangeHfBodyState) (*HistoricalEventChangeHfBodyState, error) {
+func parseHistoricalEventChangeHfBodyStatePlus(p *parser, obj *HistoricalEventChangeHfBodyState) (*HistoricalEventChangeHfBodyState, error) {
 	if obj == nil {
-		obj = NewHistoricalEventChangeHfBodyState()
+		obj = p.newHistoricalEventChangeHfBodyState()
 	}
 
 	for {
@@ -27865,8 +30085,8 @@ func parseHistoricalEventChangeHfBodyStatePlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventChangeHfJob(p *util.XMLParser) (*HistoricalEventChangeHfJob, error) {
-	var obj = NewHistoricalEventChangeHfJob()
+func parseHistoricalEventChangeHfJob(p *parser) (*HistoricalEventChangeHfJob, error) {
+	var obj = p.newHistoricalEventChangeHfJob()
 
 	for {
 		t, n, err := p.Token()
@@ -27911,9 +30131,9 @@ func parseHistoricalEventChangeHfJob(p *util.XMLParser) (*HistoricalEventChangeH
 		}
 	}
 }
-func parseHistoricalEventChangeHfJobPlus(p *util.XMLParser, obj *HistoricalEventChangeHfJob) (*HistoricalEventChangeHfJob, error) {
+func parseHistoricalEventChangeHfJobPlus(p *parser, obj *HistoricalEventChangeHfJob) (*HistoricalEventChangeHfJob, error) {
 	if obj == nil {
-		obj = NewHistoricalEventChangeHfJob()
+		obj = p.newHistoricalEventChangeHfJob()
 	}
 
 	for {
@@ -27935,13 +30155,13 @@ func parseHistoricalEventChangeHfJobPlus(p *util.XMLParser, obj *HistoricalEvent
 				if err != nil {
 					return nil, err
 				}
-				obj.NewJob = txt(data)
+				obj.NewJob = p.itxt(data)
 			case "old_job":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.OldJob = txt(data)
+				obj.OldJob = p.itxt(data)
 			case "site":
 				data, err := p.Value()
 				if err != nil {
@@ -27959,8 +30179,8 @@ func parseHistoricalEventChangeHfJobPlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventChangeHfState(p *util.XMLParser) (*HistoricalEventChangeHfState, error) {
-	var obj = NewHistoricalEventChangeHfState()
+func parseHistoricalEventChangeHfState(p *parser) (*HistoricalEventChangeHfState, error) {
+	var obj = p.newHistoricalEventChangeHfState()
 
 	for {
 		t, n, err := p.Token()
@@ -27976,6 +30196,7 @@ func parseHistoricalEventChangeHfState(p *util.XMLParser) (*HistoricalEventChang
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -28029,9 +30250,9 @@ func parseHistoricalEventChangeHfState(p *util.XMLParser) (*HistoricalEventChang
 		}
 	}
 }
-func parseHistoricalEventChangeHfStatePlus(p *util.XMLParser, obj *HistoricalEventChangeHfState) (*HistoricalEventChangeHfState, error) {
+func parseHistoricalEventChangeHfStatePlus(p *parser, obj *HistoricalEventChangeHfState) (*HistoricalEventChangeHfState, error) {
 	if obj == nil {
-		obj = NewHistoricalEventChangeHfState()
+		obj = p.newHistoricalEventChangeHfState()
 	}
 
 	for {
@@ -28077,8 +30298,8 @@ func parseHistoricalEventChangeHfStatePlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventChangedCreatureType(p *util.XMLParser) (*HistoricalEventChangedCreatureType, error) {
-	var obj = NewHistoricalEventChangedCreatureType()
+func parseHistoricalEventChangedCreatureType(p *parser) (*HistoricalEventChangedCreatureType, error) {
+	var obj = p.newHistoricalEventChangedCreatureType()
 
 	for {
 		t, n, err := p.Token()
@@ -28105,25 +30326,25 @@ func parseHistoricalEventChangedCreatureType(p *util.XMLParser) (*HistoricalEven
 				if err != nil {
 					return nil, err
 				}
-				obj.NewCaste = txt(data)
+				obj.NewCaste = p.itxt(data)
 			case "new_race":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.NewRace = txt(data)
+				obj.NewRace = p.itxt(data)
 			case "old_caste":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.OldCaste = txt(data)
+				obj.OldCaste = p.itxt(data)
 			case "old_race":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.OldRace = txt(data)
+				obj.OldRace = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -28135,9 +30356,9 @@ func parseHistoricalEventChangedCreatureType(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventChangedCreatureTypePlus(p *util.XMLParser, obj *HistoricalEventChangedCreatureType) (*HistoricalEventChangedCreatureType, error) {
+func parseHistoricalEventChangedCreatureTypePlus(p *parser, obj *HistoricalEventChangedCreatureType) (*HistoricalEventChangedCreatureType, error) {
 	if obj == nil {
-		obj = NewHistoricalEventChangedCreatureType()
+		obj = p.newHistoricalEventChangedCreatureType()
 	}
 
 	for {
@@ -28165,25 +30386,25 @@ func parseHistoricalEventChangedCreatureTypePlus(p *util.XMLParser, obj *Histori
 				if err != nil {
 					return nil, err
 				}
-				obj.NewCaste = txt(data)
+				obj.NewCaste = p.itxt(data)
 			case "new_race":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.NewRace = txt(data)
+				obj.NewRace = p.itxt(data)
 			case "old_caste":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.OldCaste = txt(data)
+				obj.OldCaste = p.itxt(data)
 			case "old_race":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.OldRace = txt(data)
+				obj.OldRace = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -28195,8 +30416,8 @@ func parseHistoricalEventChangedCreatureTypePlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventCollection(p *util.XMLParser) (*HistoricalEventCollection, error) {
-	var obj = NewHistoricalEventCollection()
+func parseHistoricalEventCollection(p *parser) (*HistoricalEventCollection, error) {
+	var obj = p.newHistoricalEventCollection()
 
 	for {
 		t, n, err := p.Token()
@@ -28308,9 +30529,9 @@ func parseHistoricalEventCollection(p *util.XMLParser) (*HistoricalEventCollecti
 		}
 	}
 }
-func parseHistoricalEventCollectionPlus(p *util.XMLParser, obj *HistoricalEventCollection) (*HistoricalEventCollection, error) {
+func parseHistoricalEventCollectionPlus(p *parser, obj *HistoricalEventCollection) (*HistoricalEventCollection, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollection()
+		obj = p.newHistoricalEventCollection()
 	}
 
 	for {
@@ -28332,8 +30553,8 @@ func parseHistoricalEventCollectionPlus(p *util.XMLParser, obj *HistoricalEventC
 		}
 	}
 }
-func parseHistoricalEventCollectionAbduction(p *util.XMLParser) (*HistoricalEventCollectionAbduction, error) {
-	var obj = NewHistoricalEventCollectionAbduction()
+func parseHistoricalEventCollectionAbduction(p *parser) (*HistoricalEventCollectionAbduction, error) {
+	var obj = p.newHistoricalEventCollectionAbduction()
 
 	for {
 		t, n, err := p.Token()
@@ -28355,6 +30576,7 @@ func parseHistoricalEventCollectionAbduction(p *util.XMLParser) (*HistoricalEven
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "defending_enid":
 				data, err := p.Value()
 				if err != nil {
@@ -28402,9 +30624,9 @@ func parseHistoricalEventCollectionAbduction(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventCollectionAbductionPlus(p *util.XMLParser, obj *HistoricalEventCollectionAbduction) (*HistoricalEventCollectionAbduction, error) {
+func parseHistoricalEventCollectionAbductionPlus(p *parser, obj *HistoricalEventCollectionAbduction) (*HistoricalEventCollectionAbduction, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionAbduction()
+		obj = p.newHistoricalEventCollectionAbduction()
 	}
 
 	for {
@@ -28426,8 +30648,8 @@ func parseHistoricalEventCollectionAbductionPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventCollectionBattle(p *util.XMLParser) (*HistoricalEventCollectionBattle, error) {
-	var obj = NewHistoricalEventCollectionBattle()
+func parseHistoricalEventCollectionBattle(p *parser) (*HistoricalEventCollectionBattle, error) {
+	var obj = p.newHistoricalEventCollectionBattle()
 
 	for {
 		t, n, err := p.Token()
@@ -28490,7 +30712,7 @@ func parseHistoricalEventCollectionBattle(p *util.XMLParser) (*HistoricalEventCo
 				if err != nil {
 					return nil, err
 				}
-				obj.AttackingSquadRace = append(obj.AttackingSquadRace, txt(data))
+				obj.AttackingSquadRace = append(obj.AttackingSquadRace, p.itxt(data))
 			case "attacking_squad_site":
 				data, err := p.Value()
 				if err != nil {
@@ -28509,6 +30731,7 @@ func parseHistoricalEventCollectionBattle(p *util.XMLParser) (*HistoricalEventCo
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "d_support_merc_enid":
 				data, err := p.Value()
 				if err != nil {
@@ -28562,7 +30785,7 @@ func parseHistoricalEventCollectionBattle(p *util.XMLParser) (*HistoricalEventCo
 				if err != nil {
 					return nil, err
 				}
-				obj.DefendingSquadRace = append(obj.DefendingSquadRace, txt(data))
+				obj.DefendingSquadRace = append(obj.DefendingSquadRace, p.itxt(data))
 			case "defending_squad_site":
 				data, err := p.Value()
 				if err != nil {
@@ -28628,9 +30851,9 @@ func parseHistoricalEventCollectionBattle(p *util.XMLParser) (*HistoricalEventCo
 		}
 	}
 }
-func parseHistoricalEventCollectionBattlePlus(p *util.XMLParser, obj *HistoricalEventCollectionBattle) (*HistoricalEventCollectionBattle, error) {
+func parseHistoricalEventCollectionBattlePlus(p *parser, obj *HistoricalEventCollectionBattle) (*HistoricalEventCollectionBattle, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionBattle()
+		obj = p.newHistoricalEventCollectionBattle()
 	}
 
 	for {
@@ -28652,8 +30875,8 @@ func parseHistoricalEventCollectionBattlePlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventCollectionBeastAttack(p *util.XMLParser) (*HistoricalEventCollectionBeastAttack, error) {
-	var obj = NewHistoricalEventCollectionBeastAttack()
+func parseHistoricalEventCollectionBeastAttack(p *parser) (*HistoricalEventCollectionBeastAttack, error) {
+	var obj = p.newHistoricalEventCollectionBeastAttack()
 
 	for {
 		t, n, err := p.Token()
@@ -28669,6 +30892,7 @@ func parseHistoricalEventCollectionBeastAttack(p *util.XMLParser) (*HistoricalEv
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "defending_enid":
 				data, err := p.Value()
 				if err != nil {
@@ -28716,9 +30940,9 @@ func parseHistoricalEventCollectionBeastAttack(p *util.XMLParser) (*HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventCollectionBeastAttackPlus(p *util.XMLParser, obj *HistoricalEventCollectionBeastAttack) (*HistoricalEventCollectionBeastAttack, error) {
+func parseHistoricalEventCollectionBeastAttackPlus(p *parser, obj *HistoricalEventCollectionBeastAttack) (*HistoricalEventCollectionBeastAttack, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionBeastAttack()
+		obj = p.newHistoricalEventCollectionBeastAttack()
 	}
 
 	for {
@@ -28740,8 +30964,8 @@ func parseHistoricalEventCollectionBeastAttackPlus(p *util.XMLParser, obj *Histo
 		}
 	}
 }
-func parseHistoricalEventCollectionCeremony(p *util.XMLParser) (*HistoricalEventCollectionCeremony, error) {
-	var obj = NewHistoricalEventCollectionCeremony()
+func parseHistoricalEventCollectionCeremony(p *parser) (*HistoricalEventCollectionCeremony, error) {
+	var obj = p.newHistoricalEventCollectionCeremony()
 
 	for {
 		t, n, err := p.Token()
@@ -28768,9 +30992,9 @@ func parseHistoricalEventCollectionCeremony(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventCollectionCeremonyPlus(p *util.XMLParser, obj *HistoricalEventCollectionCeremony) (*HistoricalEventCollectionCeremony, error) {
+func parseHistoricalEventCollectionCeremonyPlus(p *parser, obj *HistoricalEventCollectionCeremony) (*HistoricalEventCollectionCeremony, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionCeremony()
+		obj = p.newHistoricalEventCollectionCeremony()
 	}
 
 	for {
@@ -28792,8 +31016,8 @@ func parseHistoricalEventCollectionCeremonyPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventCollectionCompetition(p *util.XMLParser) (*HistoricalEventCollectionCompetition, error) {
-	var obj = NewHistoricalEventCollectionCompetition()
+func parseHistoricalEventCollectionCompetition(p *parser) (*HistoricalEventCollectionCompetition, error) {
+	var obj = p.newHistoricalEventCollectionCompetition()
 
 	for {
 		t, n, err := p.Token()
@@ -28820,9 +31044,9 @@ func parseHistoricalEventCollectionCompetition(p *util.XMLParser) (*HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventCollectionCompetitionPlus(p *util.XMLParser, obj *HistoricalEventCollectionCompetition) (*HistoricalEventCollectionCompetition, error) {
+func parseHistoricalEventCollectionCompetitionPlus(p *parser, obj *HistoricalEventCollectionCompetition) (*HistoricalEventCollectionCompetition, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionCompetition()
+		obj = p.newHistoricalEventCollectionCompetition()
 	}
 
 	for {
@@ -28844,8 +31068,8 @@ func parseHistoricalEventCollectionCompetitionPlus(p *util.XMLParser, obj *Histo
 		}
 	}
 }
-func parseHistoricalEventCollectionDuel(p *util.XMLParser) (*HistoricalEventCollectionDuel, error) {
-	var obj = NewHistoricalEventCollectionDuel()
+func parseHistoricalEventCollectionDuel(p *parser) (*HistoricalEventCollectionDuel, error) {
+	var obj = p.newHistoricalEventCollectionDuel()
 
 	for {
 		t, n, err := p.Token()
@@ -28867,6 +31091,7 @@ func parseHistoricalEventCollectionDuel(p *util.XMLParser) (*HistoricalEventColl
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "defending_hfid":
 				data, err := p.Value()
 				if err != nil {
@@ -28914,9 +31139,9 @@ func parseHistoricalEventCollectionDuel(p *util.XMLParser) (*HistoricalEventColl
 		}
 	}
 }
-func parseHistoricalEventCollectionDuelPlus(p *util.XMLParser, obj *HistoricalEventCollectionDuel) (*HistoricalEventCollectionDuel, error) {
+func parseHistoricalEventCollectionDuelPlus(p *parser, obj *HistoricalEventCollectionDuel) (*HistoricalEventCollectionDuel, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionDuel()
+		obj = p.newHistoricalEventCollectionDuel()
 	}
 
 	for {
@@ -28938,8 +31163,8 @@ func parseHistoricalEventCollectionDuelPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventCollectionEntityOverthrown(p *util.XMLParser) (*HistoricalEventCollectionEntityOverthrown, error) {
-	var obj = NewHistoricalEventCollectionEntityOverthrown()
+func parseHistoricalEventCollectionEntityOverthrown(p *parser) (*HistoricalEventCollectionEntityOverthrown, error) {
+	var obj = p.newHistoricalEventCollectionEntityOverthrown()
 
 	for {
 		t, n, err := p.Token()
@@ -28978,9 +31203,9 @@ func parseHistoricalEventCollectionEntityOverthrown(p *util.XMLParser) (*Histori
 		}
 	}
 }
-func parseHistoricalEventCollectionEntityOverthrownPlus(p *util.XMLParser, obj *HistoricalEventCollectionEntityOverthrown) (*HistoricalEventCollectionEntityOverthrown, error) {
+func parseHistoricalEventCollectionEntityOverthrownPlus(p *parser, obj *HistoricalEventCollectionEntityOverthrown) (*HistoricalEventCollectionEntityOverthrown, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionEntityOverthrown()
+		obj = p.newHistoricalEventCollectionEntityOverthrown()
 	}
 
 	for {
@@ -29002,8 +31227,8 @@ func parseHistoricalEventCollectionEntityOverthrownPlus(p *util.XMLParser, obj *
 		}
 	}
 }
-func parseHistoricalEventCollectionInsurrection(p *util.XMLParser) (*HistoricalEventCollectionInsurrection, error) {
-	var obj = NewHistoricalEventCollectionInsurrection()
+func parseHistoricalEventCollectionInsurrection(p *parser) (*HistoricalEventCollectionInsurrection, error) {
+	var obj = p.newHistoricalEventCollectionInsurrection()
 
 	for {
 		t, n, err := p.Token()
@@ -29042,9 +31267,9 @@ func parseHistoricalEventCollectionInsurrection(p *util.XMLParser) (*HistoricalE
 		}
 	}
 }
-func parseHistoricalEventCollectionInsurrectionPlus(p *util.XMLParser, obj *HistoricalEventCollectionInsurrection) (*HistoricalEventCollectionInsurrection, error) {
+func parseHistoricalEventCollectionInsurrectionPlus(p *parser, obj *HistoricalEventCollectionInsurrection) (*HistoricalEventCollectionInsurrection, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionInsurrection()
+		obj = p.newHistoricalEventCollectionInsurrection()
 	}
 
 	for {
@@ -29066,8 +31291,8 @@ func parseHistoricalEventCollectionInsurrectionPlus(p *util.XMLParser, obj *Hist
 		}
 	}
 }
-func parseHistoricalEventCollectionJourney(p *util.XMLParser) (*HistoricalEventCollectionJourney, error) {
-	var obj = NewHistoricalEventCollectionJourney()
+func parseHistoricalEventCollectionJourney(p *parser) (*HistoricalEventCollectionJourney, error) {
+	var obj = p.newHistoricalEventCollectionJourney()
 
 	for {
 		t, n, err := p.Token()
@@ -29094,9 +31319,9 @@ func parseHistoricalEventCollectionJourney(p *util.XMLParser) (*HistoricalEventC
 		}
 	}
 }
-func parseHistoricalEventCollectionJourneyPlus(p *util.XMLParser, obj *HistoricalEventCollectionJourney) (*HistoricalEventCollectionJourney, error) {
+func parseHistoricalEventCollectionJourneyPlus(p *parser, obj *HistoricalEventCollectionJourney) (*HistoricalEventCollectionJourney, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionJourney()
+		obj = p.newHistoricalEventCollectionJourney()
 	}
 
 	for {
@@ -29118,8 +31343,8 @@ func parseHistoricalEventCollectionJourneyPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventCollectionOccasion(p *util.XMLParser) (*HistoricalEventCollectionOccasion, error) {
-	var obj = NewHistoricalEventCollectionOccasion()
+func parseHistoricalEventCollectionOccasion(p *parser) (*HistoricalEventCollectionOccasion, error) {
+	var obj = p.newHistoricalEventCollectionOccasion()
 
 	for {
 		t, n, err := p.Token()
@@ -29158,9 +31383,9 @@ func parseHistoricalEventCollectionOccasion(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventCollectionOccasionPlus(p *util.XMLParser, obj *HistoricalEventCollectionOccasion) (*HistoricalEventCollectionOccasion, error) {
+func parseHistoricalEventCollectionOccasionPlus(p *parser, obj *HistoricalEventCollectionOccasion) (*HistoricalEventCollectionOccasion, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionOccasion()
+		obj = p.newHistoricalEventCollectionOccasion()
 	}
 
 	for {
@@ -29182,8 +31407,8 @@ func parseHistoricalEventCollectionOccasionPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventCollectionPerformance(p *util.XMLParser) (*HistoricalEventCollectionPerformance, error) {
-	var obj = NewHistoricalEventCollectionPerformance()
+func parseHistoricalEventCollectionPerformance(p *parser) (*HistoricalEventCollectionPerformance, error) {
+	var obj = p.newHistoricalEventCollectionPerformance()
 
 	for {
 		t, n, err := p.Token()
@@ -29210,9 +31435,9 @@ func parseHistoricalEventCollectionPerformance(p *util.XMLParser) (*HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventCollectionPerformancePlus(p *util.XMLParser, obj *HistoricalEventCollectionPerformance) (*HistoricalEventCollectionPerformance, error) {
+func parseHistoricalEventCollectionPerformancePlus(p *parser, obj *HistoricalEventCollectionPerformance) (*HistoricalEventCollectionPerformance, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionPerformance()
+		obj = p.newHistoricalEventCollectionPerformance()
 	}
 
 	for {
@@ -29234,8 +31459,8 @@ func parseHistoricalEventCollectionPerformancePlus(p *util.XMLParser, obj *Histo
 		}
 	}
 }
-func parseHistoricalEventCollectionPersecution(p *util.XMLParser) (*HistoricalEventCollectionPersecution, error) {
-	var obj = NewHistoricalEventCollectionPersecution()
+func parseHistoricalEventCollectionPersecution(p *parser) (*HistoricalEventCollectionPersecution, error) {
+	var obj = p.newHistoricalEventCollectionPersecution()
 
 	for {
 		t, n, err := p.Token()
@@ -29274,9 +31499,9 @@ func parseHistoricalEventCollectionPersecution(p *util.XMLParser) (*HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventCollectionPersecutionPlus(p *util.XMLParser, obj *HistoricalEventCollectionPersecution) (*HistoricalEventCollectionPersecution, error) {
+func parseHistoricalEventCollectionPersecutionPlus(p *parser, obj *HistoricalEventCollectionPersecution) (*HistoricalEventCollectionPersecution, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionPersecution()
+		obj = p.newHistoricalEventCollectionPersecution()
 	}
 
 	for {
@@ -29298,8 +31523,8 @@ func parseHistoricalEventCollectionPersecutionPlus(p *util.XMLParser, obj *Histo
 		}
 	}
 }
-func parseHistoricalEventCollectionProcession(p *util.XMLParser) (*HistoricalEventCollectionProcession, error) {
-	var obj = NewHistoricalEventCollectionProcession()
+func parseHistoricalEventCollectionProcession(p *parser) (*HistoricalEventCollectionProcession, error) {
+	var obj = p.newHistoricalEventCollectionProcession()
 
 	for {
 		t, n, err := p.Token()
@@ -29326,9 +31551,9 @@ func parseHistoricalEventCollectionProcession(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventCollectionProcessionPlus(p *util.XMLParser, obj *HistoricalEventCollectionProcession) (*HistoricalEventCollectionProcession, error) {
+func parseHistoricalEventCollectionProcessionPlus(p *parser, obj *HistoricalEventCollectionProcession) (*HistoricalEventCollectionProcession, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionProcession()
+		obj = p.newHistoricalEventCollectionProcession()
 	}
 
 	for {
@@ -29350,8 +31575,8 @@ func parseHistoricalEventCollectionProcessionPlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventCollectionPurge(p *util.XMLParser) (*HistoricalEventCollectionPurge, error) {
-	var obj = NewHistoricalEventCollectionPurge()
+func parseHistoricalEventCollectionPurge(p *parser) (*HistoricalEventCollectionPurge, error) {
+	var obj = p.newHistoricalEventCollectionPurge()
 
 	for {
 		t, n, err := p.Token()
@@ -29390,9 +31615,9 @@ func parseHistoricalEventCollectionPurge(p *util.XMLParser) (*HistoricalEventCol
 		}
 	}
 }
-func parseHistoricalEventCollectionPurgePlus(p *util.XMLParser, obj *HistoricalEventCollectionPurge) (*HistoricalEventCollectionPurge, error) {
+func parseHistoricalEventCollectionPurgePlus(p *parser, obj *HistoricalEventCollectionPurge) (*HistoricalEventCollectionPurge, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionPurge()
+		obj = p.newHistoricalEventCollectionPurge()
 	}
 
 	for {
@@ -29414,8 +31639,8 @@ func parseHistoricalEventCollectionPurgePlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventCollectionRaid(p *util.XMLParser) (*HistoricalEventCollectionRaid, error) {
-	var obj = NewHistoricalEventCollectionRaid()
+func parseHistoricalEventCollectionRaid(p *parser) (*HistoricalEventCollectionRaid, error) {
+	var obj = p.newHistoricalEventCollectionRaid()
 
 	for {
 		t, n, err := p.Token()
@@ -29437,6 +31662,7 @@ func parseHistoricalEventCollectionRaid(p *util.XMLParser) (*HistoricalEventColl
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "defending_enid":
 				data, err := p.Value()
 				if err != nil {
@@ -29484,9 +31710,9 @@ func parseHistoricalEventCollectionRaid(p *util.XMLParser) (*HistoricalEventColl
 		}
 	}
 }
-func parseHistoricalEventCollectionRaidPlus(p *util.XMLParser, obj *HistoricalEventCollectionRaid) (*HistoricalEventCollectionRaid, error) {
+func parseHistoricalEventCollectionRaidPlus(p *parser, obj *HistoricalEventCollectionRaid) (*HistoricalEventCollectionRaid, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionRaid()
+		obj = p.newHistoricalEventCollectionRaid()
 	}
 
 	for {
@@ -29508,8 +31734,8 @@ func parseHistoricalEventCollectionRaidPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventCollectionSiteConquered(p *util.XMLParser) (*HistoricalEventCollectionSiteConquered, error) {
-	var obj = NewHistoricalEventCollectionSiteConquered()
+func parseHistoricalEventCollectionSiteConquered(p *parser) (*HistoricalEventCollectionSiteConquered, error) {
+	var obj = p.newHistoricalEventCollectionSiteConquered()
 
 	for {
 		t, n, err := p.Token()
@@ -29560,9 +31786,9 @@ func parseHistoricalEventCollectionSiteConquered(p *util.XMLParser) (*Historical
 		}
 	}
 }
-func parseHistoricalEventCollectionSiteConqueredPlus(p *util.XMLParser, obj *HistoricalEventCollectionSiteConquered) (*HistoricalEventCollectionSiteConquered, error) {
+func parseHistoricalEventCollectionSiteConqueredPlus(p *parser, obj *HistoricalEventCollectionSiteConquered) (*HistoricalEventCollectionSiteConquered, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionSiteConquered()
+		obj = p.newHistoricalEventCollectionSiteConquered()
 	}
 
 	for {
@@ -29584,8 +31810,8 @@ func parseHistoricalEventCollectionSiteConqueredPlus(p *util.XMLParser, obj *His
 		}
 	}
 }
-func parseHistoricalEventCollectionTheft(p *util.XMLParser) (*HistoricalEventCollectionTheft, error) {
-	var obj = NewHistoricalEventCollectionTheft()
+func parseHistoricalEventCollectionTheft(p *parser) (*HistoricalEventCollectionTheft, error) {
+	var obj = p.newHistoricalEventCollectionTheft()
 
 	for {
 		t, n, err := p.Token()
@@ -29607,6 +31833,7 @@ func parseHistoricalEventCollectionTheft(p *util.XMLParser) (*HistoricalEventCol
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "defending_enid":
 				data, err := p.Value()
 				if err != nil {
@@ -29654,9 +31881,9 @@ func parseHistoricalEventCollectionTheft(p *util.XMLParser) (*HistoricalEventCol
 		}
 	}
 }
-func parseHistoricalEventCollectionTheftPlus(p *util.XMLParser, obj *HistoricalEventCollectionTheft) (*HistoricalEventCollectionTheft, error) {
+func parseHistoricalEventCollectionTheftPlus(p *parser, obj *HistoricalEventCollectionTheft) (*HistoricalEventCollectionTheft, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionTheft()
+		obj = p.newHistoricalEventCollectionTheft()
 	}
 
 	for {
@@ -29678,8 +31905,8 @@ func parseHistoricalEventCollectionTheftPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventCollectionWar(p *util.XMLParser) (*HistoricalEventCollectionWar, error) {
-	var obj = NewHistoricalEventCollectionWar()
+func parseHistoricalEventCollectionWar(p *parser) (*HistoricalEventCollectionWar, error) {
+	var obj = p.newHistoricalEventCollectionWar()
 
 	for {
 		t, n, err := p.Token()
@@ -29718,9 +31945,9 @@ func parseHistoricalEventCollectionWar(p *util.XMLParser) (*HistoricalEventColle
 		}
 	}
 }
-func parseHistoricalEventCollectionWarPlus(p *util.XMLParser, obj *HistoricalEventCollectionWar) (*HistoricalEventCollectionWar, error) {
+func parseHistoricalEventCollectionWarPlus(p *parser, obj *HistoricalEventCollectionWar) (*HistoricalEventCollectionWar, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCollectionWar()
+		obj = p.newHistoricalEventCollectionWar()
 	}
 
 	for {
@@ -29742,8 +31969,8 @@ func parseHistoricalEventCollectionWarPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventCompetition(p *util.XMLParser) (*HistoricalEventCompetition, error) {
-	var obj = NewHistoricalEventCompetition()
+func parseHistoricalEventCompetition(p *parser) (*HistoricalEventCompetition, error) {
+	var obj = p.newHistoricalEventCompetition()
 
 	for {
 		t, n, err := p.Token()
@@ -29812,9 +32039,9 @@ func parseHistoricalEventCompetition(p *util.XMLParser) (*HistoricalEventCompeti
 		}
 	}
 }
-func parseHistoricalEventCompetitionPlus(p *util.XMLParser, obj *HistoricalEventCompetition) (*HistoricalEventCompetition, error) {
+func parseHistoricalEventCompetitionPlus(p *parser, obj *HistoricalEventCompetition) (*HistoricalEventCompetition, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCompetition()
+		obj = p.newHistoricalEventCompetition()
 	}
 
 	for {
@@ -29836,8 +32063,8 @@ func parseHistoricalEventCompetitionPlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventCreateEntityPosition(p *util.XMLParser) (*HistoricalEventCreateEntityPosition, error) {
-	var obj = NewHistoricalEventCreateEntityPosition()
+func parseHistoricalEventCreateEntityPosition(p *parser) (*HistoricalEventCreateEntityPosition, error) {
+	var obj = p.newHistoricalEventCreateEntityPosition()
 
 	for {
 		t, n, err := p.Token()
@@ -29858,9 +32085,9 @@ func parseHistoricalEventCreateEntityPosition(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventCreateEntityPositionPlus(p *util.XMLParser, obj *HistoricalEventCreateEntityPosition) (*HistoricalEventCreateEntityPosition, error) {
+func parseHistoricalEventCreateEntityPositionPlus(p *parser, obj *HistoricalEventCreateEntityPosition) (*HistoricalEventCreateEntityPosition, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCreateEntityPosition()
+		obj = p.newHistoricalEventCreateEntityPosition()
 	}
 
 	for {
@@ -29888,7 +32115,7 @@ func parseHistoricalEventCreateEntityPositionPlus(p *util.XMLParser, obj *Histor
 				if err != nil {
 					return nil, err
 				}
-				obj.Position = txt(data)
+				obj.Position = p.itxt(data)
 			case "reason":
 				data, err := p.Value()
 				if err != nil {
@@ -29912,8 +32139,8 @@ func parseHistoricalEventCreateEntityPositionPlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventCreatedSite(p *util.XMLParser) (*HistoricalEventCreatedSite, error) {
-	var obj = NewHistoricalEventCreatedSite()
+func parseHistoricalEventCreatedSite(p *parser) (*HistoricalEventCreatedSite, error) {
+	var obj = p.newHistoricalEventCreatedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -29964,9 +32191,9 @@ func parseHistoricalEventCreatedSite(p *util.XMLParser) (*HistoricalEventCreated
 		}
 	}
 }
-func parseHistoricalEventCreatedSitePlus(p *util.XMLParser, obj *HistoricalEventCreatedSite) (*HistoricalEventCreatedSite, error) {
+func parseHistoricalEventCreatedSitePlus(p *parser, obj *HistoricalEventCreatedSite) (*HistoricalEventCreatedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCreatedSite()
+		obj = p.newHistoricalEventCreatedSite()
 	}
 
 	for {
@@ -29988,8 +32215,8 @@ func parseHistoricalEventCreatedSitePlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventCreatedStructure(p *util.XMLParser) (*HistoricalEventCreatedStructure, error) {
-	var obj = NewHistoricalEventCreatedStructure()
+func parseHistoricalEventCreatedStructure(p *parser) (*HistoricalEventCreatedStructure, error) {
+	var obj = p.newHistoricalEventCreatedStructure()
 
 	for {
 		t, n, err := p.Token()
@@ -30046,9 +32273,9 @@ func parseHistoricalEventCreatedStructure(p *util.XMLParser) (*HistoricalEventCr
 		}
 	}
 }
-func parseHistoricalEventCreatedStructurePlus(p *util.XMLParser, obj *HistoricalEventCreatedStructure) (*HistoricalEventCreatedStructure, error) {
+func parseHistoricalEventCreatedStructurePlus(p *parser, obj *HistoricalEventCreatedStructure) (*HistoricalEventCreatedStructure, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCreatedStructure()
+		obj = p.newHistoricalEventCreatedStructure()
 	}
 
 	for {
@@ -30106,8 +32333,8 @@ func parseHistoricalEventCreatedStructurePlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventCreatedWorldConstruction(p *util.XMLParser) (*HistoricalEventCreatedWorldConstruction, error) {
-	var obj = NewHistoricalEventCreatedWorldConstruction()
+func parseHistoricalEventCreatedWorldConstruction(p *parser) (*HistoricalEventCreatedWorldConstruction, error) {
+	var obj = p.newHistoricalEventCreatedWorldConstruction()
 
 	for {
 		t, n, err := p.Token()
@@ -30164,9 +32391,9 @@ func parseHistoricalEventCreatedWorldConstruction(p *util.XMLParser) (*Historica
 		}
 	}
 }
-func parseHistoricalEventCreatedWorldConstructionPlus(p *util.XMLParser, obj *HistoricalEventCreatedWorldConstruction) (*HistoricalEventCreatedWorldConstruction, error) {
+func parseHistoricalEventCreatedWorldConstructionPlus(p *parser, obj *HistoricalEventCreatedWorldConstruction) (*HistoricalEventCreatedWorldConstruction, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCreatedWorldConstruction()
+		obj = p.newHistoricalEventCreatedWorldConstruction()
 	}
 
 	for {
@@ -30188,8 +32415,8 @@ func parseHistoricalEventCreatedWorldConstructionPlus(p *util.XMLParser, obj *Hi
 		}
 	}
 }
-func parseHistoricalEventCreatureDevoured(p *util.XMLParser) (*HistoricalEventCreatureDevoured, error) {
-	var obj = NewHistoricalEventCreatureDevoured()
+func parseHistoricalEventCreatureDevoured(p *parser) (*HistoricalEventCreatureDevoured, error) {
+	var obj = p.newHistoricalEventCreatureDevoured()
 
 	for {
 		t, n, err := p.Token()
@@ -30228,9 +32455,9 @@ func parseHistoricalEventCreatureDevoured(p *util.XMLParser) (*HistoricalEventCr
 		}
 	}
 }
-func parseHistoricalEventCreatureDevouredPlus(p *util.XMLParser, obj *HistoricalEventCreatureDevoured) (*HistoricalEventCreatureDevoured, error) {
+func parseHistoricalEventCreatureDevouredPlus(p *parser, obj *HistoricalEventCreatureDevoured) (*HistoricalEventCreatureDevoured, error) {
 	if obj == nil {
-		obj = NewHistoricalEventCreatureDevoured()
+		obj = p.newHistoricalEventCreatureDevoured()
 	}
 
 	for {
@@ -30246,7 +32473,7 @@ func parseHistoricalEventCreatureDevouredPlus(p *util.XMLParser, obj *Historical
 				if err != nil {
 					return nil, err
 				}
-				obj.Caste = txt(data)
+				obj.Caste = p.itxt(data)
 			case "eater":
 				data, err := p.Value()
 				if err != nil {
@@ -30264,7 +32491,7 @@ func parseHistoricalEventCreatureDevouredPlus(p *util.XMLParser, obj *Historical
 				if err != nil {
 					return nil, err
 				}
-				obj.Race = txt(data)
+				obj.Race = p.itxt(data)
 			case "site":
 				data, err := p.Value()
 				if err != nil {
@@ -30288,8 +32515,8 @@ func parseHistoricalEventCreatureDevouredPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventDanceFormCreated(p *util.XMLParser) (*HistoricalEventDanceFormCreated, error) {
-	var obj = NewHistoricalEventDanceFormCreated()
+func parseHistoricalEventDanceFormCreated(p *parser) (*HistoricalEventDanceFormCreated, error) {
+	var obj = p.newHistoricalEventDanceFormCreated()
 
 	for {
 		t, n, err := p.Token()
@@ -30358,9 +32585,9 @@ func parseHistoricalEventDanceFormCreated(p *util.XMLParser) (*HistoricalEventDa
 		}
 	}
 }
-func parseHistoricalEventDanceFormCreatedPlus(p *util.XMLParser, obj *HistoricalEventDanceFormCreated) (*HistoricalEventDanceFormCreated, error) {
+func parseHistoricalEventDanceFormCreatedPlus(p *parser, obj *HistoricalEventDanceFormCreated) (*HistoricalEventDanceFormCreated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventDanceFormCreated()
+		obj = p.newHistoricalEventDanceFormCreated()
 	}
 
 	for {
@@ -30382,8 +32609,8 @@ func parseHistoricalEventDanceFormCreatedPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventDestroyedSite(p *util.XMLParser) (*HistoricalEventDestroyedSite, error) {
-	var obj = NewHistoricalEventDestroyedSite()
+func parseHistoricalEventDestroyedSite(p *parser) (*HistoricalEventDestroyedSite, error) {
+	var obj = p.newHistoricalEventDestroyedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -30434,9 +32661,9 @@ func parseHistoricalEventDestroyedSite(p *util.XMLParser) (*HistoricalEventDestr
 		}
 	}
 }
-func parseHistoricalEventDestroyedSitePlus(p *util.XMLParser, obj *HistoricalEventDestroyedSite) (*HistoricalEventDestroyedSite, error) {
+func parseHistoricalEventDestroyedSitePlus(p *parser, obj *HistoricalEventDestroyedSite) (*HistoricalEventDestroyedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventDestroyedSite()
+		obj = p.newHistoricalEventDestroyedSite()
 	}
 
 	for {
@@ -30458,8 +32685,8 @@ func parseHistoricalEventDestroyedSitePlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventDiplomatLost(p *util.XMLParser) (*HistoricalEventDiplomatLost, error) {
-	var obj = NewHistoricalEventDiplomatLost()
+func parseHistoricalEventDiplomatLost(p *parser) (*HistoricalEventDiplomatLost, error) {
+	var obj = p.newHistoricalEventDiplomatLost()
 
 	for {
 		t, n, err := p.Token()
@@ -30486,9 +32713,9 @@ func parseHistoricalEventDiplomatLost(p *util.XMLParser) (*HistoricalEventDiplom
 		}
 	}
 }
-func parseHistoricalEventDiplomatLostPlus(p *util.XMLParser, obj *HistoricalEventDiplomatLost) (*HistoricalEventDiplomatLost, error) {
+func parseHistoricalEventDiplomatLostPlus(p *parser, obj *HistoricalEventDiplomatLost) (*HistoricalEventDiplomatLost, error) {
 	if obj == nil {
-		obj = NewHistoricalEventDiplomatLost()
+		obj = p.newHistoricalEventDiplomatLost()
 	}
 
 	for {
@@ -30528,8 +32755,8 @@ func parseHistoricalEventDiplomatLostPlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventEntityAllianceFormed(p *util.XMLParser) (*HistoricalEventEntityAllianceFormed, error) {
-	var obj = NewHistoricalEventEntityAllianceFormed()
+func parseHistoricalEventEntityAllianceFormed(p *parser) (*HistoricalEventEntityAllianceFormed, error) {
+	var obj = p.newHistoricalEventEntityAllianceFormed()
 
 	for {
 		t, n, err := p.Token()
@@ -30562,9 +32789,9 @@ func parseHistoricalEventEntityAllianceFormed(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventEntityAllianceFormedPlus(p *util.XMLParser, obj *HistoricalEventEntityAllianceFormed) (*HistoricalEventEntityAllianceFormed, error) {
+func parseHistoricalEventEntityAllianceFormedPlus(p *parser, obj *HistoricalEventEntityAllianceFormed) (*HistoricalEventEntityAllianceFormed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityAllianceFormed()
+		obj = p.newHistoricalEventEntityAllianceFormed()
 	}
 
 	for {
@@ -30586,8 +32813,8 @@ func parseHistoricalEventEntityAllianceFormedPlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventEntityBreachFeatureLayer(p *util.XMLParser) (*HistoricalEventEntityBreachFeatureLayer, error) {
-	var obj = NewHistoricalEventEntityBreachFeatureLayer()
+func parseHistoricalEventEntityBreachFeatureLayer(p *parser) (*HistoricalEventEntityBreachFeatureLayer, error) {
+	var obj = p.newHistoricalEventEntityBreachFeatureLayer()
 
 	for {
 		t, n, err := p.Token()
@@ -30632,9 +32859,9 @@ func parseHistoricalEventEntityBreachFeatureLayer(p *util.XMLParser) (*Historica
 		}
 	}
 }
-func parseHistoricalEventEntityBreachFeatureLayerPlus(p *util.XMLParser, obj *HistoricalEventEntityBreachFeatureLayer) (*HistoricalEventEntityBreachFeatureLayer, error) {
+func parseHistoricalEventEntityBreachFeatureLayerPlus(p *parser, obj *HistoricalEventEntityBreachFeatureLayer) (*HistoricalEventEntityBreachFeatureLayer, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityBreachFeatureLayer()
+		obj = p.newHistoricalEventEntityBreachFeatureLayer()
 	}
 
 	for {
@@ -30656,8 +32883,8 @@ func parseHistoricalEventEntityBreachFeatureLayerPlus(p *util.XMLParser, obj *Hi
 		}
 	}
 }
-func parseHistoricalEventEntityCreated(p *util.XMLParser) (*HistoricalEventEntityCreated, error) {
-	var obj = NewHistoricalEventEntityCreated()
+func parseHistoricalEventEntityCreated(p *parser) (*HistoricalEventEntityCreated, error) {
+	var obj = p.newHistoricalEventEntityCreated()
 
 	for {
 		t, n, err := p.Token()
@@ -30702,9 +32929,9 @@ func parseHistoricalEventEntityCreated(p *util.XMLParser) (*HistoricalEventEntit
 		}
 	}
 }
-func parseHistoricalEventEntityCreatedPlus(p *util.XMLParser, obj *HistoricalEventEntityCreated) (*HistoricalEventEntityCreated, error) {
+func parseHistoricalEventEntityCreatedPlus(p *parser, obj *HistoricalEventEntityCreated) (*HistoricalEventEntityCreated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityCreated()
+		obj = p.newHistoricalEventEntityCreated()
 	}
 
 	for {
@@ -30726,8 +32953,8 @@ func parseHistoricalEventEntityCreatedPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventEntityDissolved(p *util.XMLParser) (*HistoricalEventEntityDissolved, error) {
-	var obj = NewHistoricalEventEntityDissolved()
+func parseHistoricalEventEntityDissolved(p *parser) (*HistoricalEventEntityDissolved, error) {
+	var obj = p.newHistoricalEventEntityDissolved()
 
 	for {
 		t, n, err := p.Token()
@@ -30760,9 +32987,9 @@ func parseHistoricalEventEntityDissolved(p *util.XMLParser) (*HistoricalEventEnt
 		}
 	}
 }
-func parseHistoricalEventEntityDissolvedPlus(p *util.XMLParser, obj *HistoricalEventEntityDissolved) (*HistoricalEventEntityDissolved, error) {
+func parseHistoricalEventEntityDissolvedPlus(p *parser, obj *HistoricalEventEntityDissolved) (*HistoricalEventEntityDissolved, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityDissolved()
+		obj = p.newHistoricalEventEntityDissolved()
 	}
 
 	for {
@@ -30784,8 +33011,8 @@ func parseHistoricalEventEntityDissolvedPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventEntityEquipmentPurchase(p *util.XMLParser) (*HistoricalEventEntityEquipmentPurchase, error) {
-	var obj = NewHistoricalEventEntityEquipmentPurchase()
+func parseHistoricalEventEntityEquipmentPurchase(p *parser) (*HistoricalEventEntityEquipmentPurchase, error) {
+	var obj = p.newHistoricalEventEntityEquipmentPurchase()
 
 	for {
 		t, n, err := p.Token()
@@ -30824,9 +33051,9 @@ func parseHistoricalEventEntityEquipmentPurchase(p *util.XMLParser) (*Historical
 		}
 	}
 }
-func parseHistoricalEventEntityEquipmentPurchasePlus(p *util.XMLParser, obj *HistoricalEventEntityEquipmentPurchase) (*HistoricalEventEntityEquipmentPurchase, error) {
+func parseHistoricalEventEntityEquipmentPurchasePlus(p *parser, obj *HistoricalEventEntityEquipmentPurchase) (*HistoricalEventEntityEquipmentPurchase, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityEquipmentPurchase()
+		obj = p.newHistoricalEventEntityEquipmentPurchase()
 	}
 
 	for {
@@ -30848,8 +33075,8 @@ func parseHistoricalEventEntityEquipmentPurchasePlus(p *util.XMLParser, obj *His
 		}
 	}
 }
-func parseHistoricalEventEntityExpelsHf(p *util.XMLParser) (*HistoricalEventEntityExpelsHf, error) {
-	var obj = NewHistoricalEventEntityExpelsHf()
+func parseHistoricalEventEntityExpelsHf(p *parser) (*HistoricalEventEntityExpelsHf, error) {
+	var obj = p.newHistoricalEventEntityExpelsHf()
 
 	for {
 		t, n, err := p.Token()
@@ -30888,9 +33115,9 @@ func parseHistoricalEventEntityExpelsHf(p *util.XMLParser) (*HistoricalEventEnti
 		}
 	}
 }
-func parseHistoricalEventEntityExpelsHfPlus(p *util.XMLParser, obj *HistoricalEventEntityExpelsHf) (*HistoricalEventEntityExpelsHf, error) {
+func parseHistoricalEventEntityExpelsHfPlus(p *parser, obj *HistoricalEventEntityExpelsHf) (*HistoricalEventEntityExpelsHf, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityExpelsHf()
+		obj = p.newHistoricalEventEntityExpelsHf()
 	}
 
 	for {
@@ -30912,8 +33139,8 @@ func parseHistoricalEventEntityExpelsHfPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventEntityFledSite(p *util.XMLParser) (*HistoricalEventEntityFledSite, error) {
-	var obj = NewHistoricalEventEntityFledSite()
+func parseHistoricalEventEntityFledSite(p *parser) (*HistoricalEventEntityFledSite, error) {
+	var obj = p.newHistoricalEventEntityFledSite()
 
 	for {
 		t, n, err := p.Token()
@@ -30946,9 +33173,9 @@ func parseHistoricalEventEntityFledSite(p *util.XMLParser) (*HistoricalEventEnti
 		}
 	}
 }
-func parseHistoricalEventEntityFledSitePlus(p *util.XMLParser, obj *HistoricalEventEntityFledSite) (*HistoricalEventEntityFledSite, error) {
+func parseHistoricalEventEntityFledSitePlus(p *parser, obj *HistoricalEventEntityFledSite) (*HistoricalEventEntityFledSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityFledSite()
+		obj = p.newHistoricalEventEntityFledSite()
 	}
 
 	for {
@@ -30970,8 +33197,8 @@ func parseHistoricalEventEntityFledSitePlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventEntityIncorporated(p *util.XMLParser) (*HistoricalEventEntityIncorporated, error) {
-	var obj = NewHistoricalEventEntityIncorporated()
+func parseHistoricalEventEntityIncorporated(p *parser) (*HistoricalEventEntityIncorporated, error) {
+	var obj = p.newHistoricalEventEntityIncorporated()
 
 	for {
 		t, n, err := p.Token()
@@ -31022,9 +33249,9 @@ func parseHistoricalEventEntityIncorporated(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventEntityIncorporatedPlus(p *util.XMLParser, obj *HistoricalEventEntityIncorporated) (*HistoricalEventEntityIncorporated, error) {
+func parseHistoricalEventEntityIncorporatedPlus(p *parser, obj *HistoricalEventEntityIncorporated) (*HistoricalEventEntityIncorporated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityIncorporated()
+		obj = p.newHistoricalEventEntityIncorporated()
 	}
 
 	for {
@@ -31046,8 +33273,8 @@ func parseHistoricalEventEntityIncorporatedPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventEntityLaw(p *util.XMLParser) (*HistoricalEventEntityLaw, error) {
-	var obj = NewHistoricalEventEntityLaw()
+func parseHistoricalEventEntityLaw(p *parser) (*HistoricalEventEntityLaw, error) {
+	var obj = p.newHistoricalEventEntityLaw()
 
 	for {
 		t, n, err := p.Token()
@@ -31092,9 +33319,9 @@ func parseHistoricalEventEntityLaw(p *util.XMLParser) (*HistoricalEventEntityLaw
 		}
 	}
 }
-func parseHistoricalEventEntityLawPlus(p *util.XMLParser, obj *HistoricalEventEntityLaw) (*HistoricalEventEntityLaw, error) {
+func parseHistoricalEventEntityLawPlus(p *parser, obj *HistoricalEventEntityLaw) (*HistoricalEventEntityLaw, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityLaw()
+		obj = p.newHistoricalEventEntityLaw()
 	}
 
 	for {
@@ -31116,8 +33343,8 @@ func parseHistoricalEventEntityLawPlus(p *util.XMLParser, obj *HistoricalEventEn
 		}
 	}
 }
-func parseHistoricalEventEntityOverthrown(p *util.XMLParser) (*HistoricalEventEntityOverthrown, error) {
-	var obj = NewHistoricalEventEntityOverthrown()
+func parseHistoricalEventEntityOverthrown(p *parser) (*HistoricalEventEntityOverthrown, error) {
+	var obj = p.newHistoricalEventEntityOverthrown()
 
 	for {
 		t, n, err := p.Token()
@@ -31180,9 +33407,9 @@ func parseHistoricalEventEntityOverthrown(p *util.XMLParser) (*HistoricalEventEn
 		}
 	}
 }
-func parseHistoricalEventEntityOverthrownPlus(p *util.XMLParser, obj *HistoricalEventEntityOverthrown) (*HistoricalEventEntityOverthrown, error) {
+func parseHistoricalEventEntityOverthrownPlus(p *parser, obj *HistoricalEventEntityOverthrown) (*HistoricalEventEntityOverthrown, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityOverthrown()
+		obj = p.newHistoricalEventEntityOverthrown()
 	}
 
 	for {
@@ -31204,8 +33431,8 @@ func parseHistoricalEventEntityOverthrownPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventEntityPersecuted(p *util.XMLParser) (*HistoricalEventEntityPersecuted, error) {
-	var obj = NewHistoricalEventEntityPersecuted()
+func parseHistoricalEventEntityPersecuted(p *parser) (*HistoricalEventEntityPersecuted, error) {
+	var obj = p.newHistoricalEventEntityPersecuted()
 
 	for {
 		t, n, err := p.Token()
@@ -31292,9 +33519,9 @@ func parseHistoricalEventEntityPersecuted(p *util.XMLParser) (*HistoricalEventEn
 		}
 	}
 }
-func parseHistoricalEventEntityPersecutedPlus(p *util.XMLParser, obj *HistoricalEventEntityPersecuted) (*HistoricalEventEntityPersecuted, error) {
+func parseHistoricalEventEntityPersecutedPlus(p *parser, obj *HistoricalEventEntityPersecuted) (*HistoricalEventEntityPersecuted, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityPersecuted()
+		obj = p.newHistoricalEventEntityPersecuted()
 	}
 
 	for {
@@ -31316,8 +33543,8 @@ func parseHistoricalEventEntityPersecutedPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventEntityPrimaryCriminals(p *util.XMLParser) (*HistoricalEventEntityPrimaryCriminals, error) {
-	var obj = NewHistoricalEventEntityPrimaryCriminals()
+func parseHistoricalEventEntityPrimaryCriminals(p *parser) (*HistoricalEventEntityPrimaryCriminals, error) {
+	var obj = p.newHistoricalEventEntityPrimaryCriminals()
 
 	for {
 		t, n, err := p.Token()
@@ -31356,9 +33583,9 @@ func parseHistoricalEventEntityPrimaryCriminals(p *util.XMLParser) (*HistoricalE
 		}
 	}
 }
-func parseHistoricalEventEntityPrimaryCriminalsPlus(p *util.XMLParser, obj *HistoricalEventEntityPrimaryCriminals) (*HistoricalEventEntityPrimaryCriminals, error) {
+func parseHistoricalEventEntityPrimaryCriminalsPlus(p *parser, obj *HistoricalEventEntityPrimaryCriminals) (*HistoricalEventEntityPrimaryCriminals, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityPrimaryCriminals()
+		obj = p.newHistoricalEventEntityPrimaryCriminals()
 	}
 
 	for {
@@ -31404,8 +33631,8 @@ func parseHistoricalEventEntityPrimaryCriminalsPlus(p *util.XMLParser, obj *Hist
 		}
 	}
 }
-func parseHistoricalEventEntityRampagedInSite(p *util.XMLParser) (*HistoricalEventEntityRampagedInSite, error) {
-	var obj = NewHistoricalEventEntityRampagedInSite()
+func parseHistoricalEventEntityRampagedInSite(p *parser) (*HistoricalEventEntityRampagedInSite, error) {
+	var obj = p.newHistoricalEventEntityRampagedInSite()
 
 	for {
 		t, n, err := p.Token()
@@ -31438,9 +33665,9 @@ func parseHistoricalEventEntityRampagedInSite(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventEntityRampagedInSitePlus(p *util.XMLParser, obj *HistoricalEventEntityRampagedInSite) (*HistoricalEventEntityRampagedInSite, error) {
+func parseHistoricalEventEntityRampagedInSitePlus(p *parser, obj *HistoricalEventEntityRampagedInSite) (*HistoricalEventEntityRampagedInSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityRampagedInSite()
+		obj = p.newHistoricalEventEntityRampagedInSite()
 	}
 
 	for {
@@ -31462,8 +33689,8 @@ func parseHistoricalEventEntityRampagedInSitePlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventEntityRelocate(p *util.XMLParser) (*HistoricalEventEntityRelocate, error) {
-	var obj = NewHistoricalEventEntityRelocate()
+func parseHistoricalEventEntityRelocate(p *parser) (*HistoricalEventEntityRelocate, error) {
+	var obj = p.newHistoricalEventEntityRelocate()
 
 	for {
 		t, n, err := p.Token()
@@ -31502,9 +33729,9 @@ func parseHistoricalEventEntityRelocate(p *util.XMLParser) (*HistoricalEventEnti
 		}
 	}
 }
-func parseHistoricalEventEntityRelocatePlus(p *util.XMLParser, obj *HistoricalEventEntityRelocate) (*HistoricalEventEntityRelocate, error) {
+func parseHistoricalEventEntityRelocatePlus(p *parser, obj *HistoricalEventEntityRelocate) (*HistoricalEventEntityRelocate, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntityRelocate()
+		obj = p.newHistoricalEventEntityRelocate()
 	}
 
 	for {
@@ -31550,8 +33777,8 @@ func parseHistoricalEventEntityRelocatePlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventEntitySearchedSite(p *util.XMLParser) (*HistoricalEventEntitySearchedSite, error) {
-	var obj = NewHistoricalEventEntitySearchedSite()
+func parseHistoricalEventEntitySearchedSite(p *parser) (*HistoricalEventEntitySearchedSite, error) {
+	var obj = p.newHistoricalEventEntitySearchedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -31590,9 +33817,9 @@ func parseHistoricalEventEntitySearchedSite(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventEntitySearchedSitePlus(p *util.XMLParser, obj *HistoricalEventEntitySearchedSite) (*HistoricalEventEntitySearchedSite, error) {
+func parseHistoricalEventEntitySearchedSitePlus(p *parser, obj *HistoricalEventEntitySearchedSite) (*HistoricalEventEntitySearchedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventEntitySearchedSite()
+		obj = p.newHistoricalEventEntitySearchedSite()
 	}
 
 	for {
@@ -31614,8 +33841,8 @@ func parseHistoricalEventEntitySearchedSitePlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventFailedFrameAttempt(p *util.XMLParser) (*HistoricalEventFailedFrameAttempt, error) {
-	var obj = NewHistoricalEventFailedFrameAttempt()
+func parseHistoricalEventFailedFrameAttempt(p *parser) (*HistoricalEventFailedFrameAttempt, error) {
+	var obj = p.newHistoricalEventFailedFrameAttempt()
 
 	for {
 		t, n, err := p.Token()
@@ -31672,9 +33899,9 @@ func parseHistoricalEventFailedFrameAttempt(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventFailedFrameAttemptPlus(p *util.XMLParser, obj *HistoricalEventFailedFrameAttempt) (*HistoricalEventFailedFrameAttempt, error) {
+func parseHistoricalEventFailedFrameAttemptPlus(p *parser, obj *HistoricalEventFailedFrameAttempt) (*HistoricalEventFailedFrameAttempt, error) {
 	if obj == nil {
-		obj = NewHistoricalEventFailedFrameAttempt()
+		obj = p.newHistoricalEventFailedFrameAttempt()
 	}
 
 	for {
@@ -31696,8 +33923,8 @@ func parseHistoricalEventFailedFrameAttemptPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventFailedIntrigueCorruption(p *util.XMLParser) (*HistoricalEventFailedIntrigueCorruption, error) {
-	var obj = NewHistoricalEventFailedIntrigueCorruption()
+func parseHistoricalEventFailedIntrigueCorruption(p *parser) (*HistoricalEventFailedIntrigueCorruption, error) {
+	var obj = p.newHistoricalEventFailedIntrigueCorruption()
 
 	for {
 		t, n, err := p.Token()
@@ -31868,9 +34095,9 @@ func parseHistoricalEventFailedIntrigueCorruption(p *util.XMLParser) (*Historica
 		}
 	}
 }
-func parseHistoricalEventFailedIntrigueCorruptionPlus(p *util.XMLParser, obj *HistoricalEventFailedIntrigueCorruption) (*HistoricalEventFailedIntrigueCorruption, error) {
+func parseHistoricalEventFailedIntrigueCorruptionPlus(p *parser, obj *HistoricalEventFailedIntrigueCorruption) (*HistoricalEventFailedIntrigueCorruption, error) {
 	if obj == nil {
-		obj = NewHistoricalEventFailedIntrigueCorruption()
+		obj = p.newHistoricalEventFailedIntrigueCorruption()
 	}
 
 	for {
@@ -31892,8 +34119,8 @@ func parseHistoricalEventFailedIntrigueCorruptionPlus(p *util.XMLParser, obj *Hi
 		}
 	}
 }
-func parseHistoricalEventFieldBattle(p *util.XMLParser) (*HistoricalEventFieldBattle, error) {
-	var obj = NewHistoricalEventFieldBattle()
+func parseHistoricalEventFieldBattle(p *parser) (*HistoricalEventFieldBattle, error) {
+	var obj = p.newHistoricalEventFieldBattle()
 
 	for {
 		t, n, err := p.Token()
@@ -31933,6 +34160,7 @@ func parseHistoricalEventFieldBattle(p *util.XMLParser) (*HistoricalEventFieldBa
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "d_support_merc_enid":
 				data, err := p.Value()
 				if err != nil {
@@ -31980,9 +34208,9 @@ func parseHistoricalEventFieldBattle(p *util.XMLParser) (*HistoricalEventFieldBa
 		}
 	}
 }
-func parseHistoricalEventFieldBattlePlus(p *util.XMLParser, obj *HistoricalEventFieldBattle) (*HistoricalEventFieldBattle, error) {
+func parseHistoricalEventFieldBattlePlus(p *parser, obj *HistoricalEventFieldBattle) (*HistoricalEventFieldBattle, error) {
 	if obj == nil {
-		obj = NewHistoricalEventFieldBattle()
+		obj = p.newHistoricalEventFieldBattle()
 	}
 
 	for {
@@ -32004,8 +34232,8 @@ func parseHistoricalEventFieldBattlePlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventFirstContact(p *util.XMLParser) (*HistoricalEventFirstContact, error) {
-	var obj = NewHistoricalEventFirstContact()
+func parseHistoricalEventFirstContact(p *parser) (*HistoricalEventFirstContact, error) {
+	var obj = p.newHistoricalEventFirstContact()
 
 	for {
 		t, n, err := p.Token()
@@ -32044,9 +34272,9 @@ func parseHistoricalEventFirstContact(p *util.XMLParser) (*HistoricalEventFirstC
 		}
 	}
 }
-func parseHistoricalEventFirstContactPlus(p *util.XMLParser, obj *HistoricalEventFirstContact) (*HistoricalEventFirstContact, error) {
+func parseHistoricalEventFirstContactPlus(p *parser, obj *HistoricalEventFirstContact) (*HistoricalEventFirstContact, error) {
 	if obj == nil {
-		obj = NewHistoricalEventFirstContact()
+		obj = p.newHistoricalEventFirstContact()
 	}
 
 	for {
@@ -32068,8 +34296,8 @@ func parseHistoricalEventFirstContactPlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventFirstContactFailed(p *util.XMLParser) (*HistoricalEventFirstContactFailed, error) {
-	var obj = NewHistoricalEventFirstContactFailed()
+func parseHistoricalEventFirstContactFailed(p *parser) (*HistoricalEventFirstContactFailed, error) {
+	var obj = p.newHistoricalEventFirstContactFailed()
 
 	for {
 		t, n, err := p.Token()
@@ -32108,9 +34336,9 @@ func parseHistoricalEventFirstContactFailed(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventFirstContactFailedPlus(p *util.XMLParser, obj *HistoricalEventFirstContactFailed) (*HistoricalEventFirstContactFailed, error) {
+func parseHistoricalEventFirstContactFailedPlus(p *parser, obj *HistoricalEventFirstContactFailed) (*HistoricalEventFirstContactFailed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventFirstContactFailed()
+		obj = p.newHistoricalEventFirstContactFailed()
 	}
 
 	for {
@@ -32132,8 +34360,8 @@ func parseHistoricalEventFirstContactFailedPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventGamble(p *util.XMLParser) (*HistoricalEventGamble, error) {
-	var obj = NewHistoricalEventGamble()
+func parseHistoricalEventGamble(p *parser) (*HistoricalEventGamble, error) {
+	var obj = p.newHistoricalEventGamble()
 
 	for {
 		t, n, err := p.Token()
@@ -32184,9 +34412,9 @@ func parseHistoricalEventGamble(p *util.XMLParser) (*HistoricalEventGamble, erro
 		}
 	}
 }
-func parseHistoricalEventGamblePlus(p *util.XMLParser, obj *HistoricalEventGamble) (*HistoricalEventGamble, error) {
+func parseHistoricalEventGamblePlus(p *parser, obj *HistoricalEventGamble) (*HistoricalEventGamble, error) {
 	if obj == nil {
-		obj = NewHistoricalEventGamble()
+		obj = p.newHistoricalEventGamble()
 	}
 
 	for {
@@ -32208,8 +34436,8 @@ func parseHistoricalEventGamblePlus(p *util.XMLParser, obj *HistoricalEventGambl
 		}
 	}
 }
-func parseHistoricalEventHfAbducted(p *util.XMLParser) (*HistoricalEventHfAbducted, error) {
-	var obj = NewHistoricalEventHfAbducted()
+func parseHistoricalEventHfAbducted(p *parser) (*HistoricalEventHfAbducted, error) {
+	var obj = p.newHistoricalEventHfAbducted()
 
 	for {
 		t, n, err := p.Token()
@@ -32260,9 +34488,9 @@ func parseHistoricalEventHfAbducted(p *util.XMLParser) (*HistoricalEventHfAbduct
 		}
 	}
 }
-func parseHistoricalEventHfAbductedPlus(p *util.XMLParser, obj *HistoricalEventHfAbducted) (*HistoricalEventHfAbducted, error) {
+func parseHistoricalEventHfAbductedPlus(p *parser, obj *HistoricalEventHfAbducted) (*HistoricalEventHfAbducted, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfAbducted()
+		obj = p.newHistoricalEventHfAbducted()
 	}
 
 	for {
@@ -32284,8 +34512,8 @@ func parseHistoricalEventHfAbductedPlus(p *util.XMLParser, obj *HistoricalEventH
 		}
 	}
 }
-func parseHistoricalEventHfAskedAboutArtifact(p *util.XMLParser) (*HistoricalEventHfAskedAboutArtifact, error) {
-	var obj = NewHistoricalEventHfAskedAboutArtifact()
+func parseHistoricalEventHfAskedAboutArtifact(p *parser) (*HistoricalEventHfAskedAboutArtifact, error) {
+	var obj = p.newHistoricalEventHfAskedAboutArtifact()
 
 	for {
 		t, n, err := p.Token()
@@ -32330,9 +34558,9 @@ func parseHistoricalEventHfAskedAboutArtifact(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventHfAskedAboutArtifactPlus(p *util.XMLParser, obj *HistoricalEventHfAskedAboutArtifact) (*HistoricalEventHfAskedAboutArtifact, error) {
+func parseHistoricalEventHfAskedAboutArtifactPlus(p *parser, obj *HistoricalEventHfAskedAboutArtifact) (*HistoricalEventHfAskedAboutArtifact, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfAskedAboutArtifact()
+		obj = p.newHistoricalEventHfAskedAboutArtifact()
 	}
 
 	for {
@@ -32354,8 +34582,8 @@ func parseHistoricalEventHfAskedAboutArtifactPlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventHfAttackedSite(p *util.XMLParser) (*HistoricalEventHfAttackedSite, error) {
-	var obj = NewHistoricalEventHfAttackedSite()
+func parseHistoricalEventHfAttackedSite(p *parser) (*HistoricalEventHfAttackedSite, error) {
+	var obj = p.newHistoricalEventHfAttackedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -32400,9 +34628,9 @@ func parseHistoricalEventHfAttackedSite(p *util.XMLParser) (*HistoricalEventHfAt
 		}
 	}
 }
-func parseHistoricalEventHfAttackedSitePlus(p *util.XMLParser, obj *HistoricalEventHfAttackedSite) (*HistoricalEventHfAttackedSite, error) {
+func parseHistoricalEventHfAttackedSitePlus(p *parser, obj *HistoricalEventHfAttackedSite) (*HistoricalEventHfAttackedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfAttackedSite()
+		obj = p.newHistoricalEventHfAttackedSite()
 	}
 
 	for {
@@ -32424,8 +34652,8 @@ func parseHistoricalEventHfAttackedSitePlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventHfCarouse(p *util.XMLParser) (*HistoricalEventHfCarouse, error) {
-	var obj = NewHistoricalEventHfCarouse()
+func parseHistoricalEventHfCarouse(p *parser) (*HistoricalEventHfCarouse, error) {
+	var obj = p.newHistoricalEventHfCarouse()
 
 	for {
 		t, n, err := p.Token()
@@ -32476,9 +34704,9 @@ func parseHistoricalEventHfCarouse(p *util.XMLParser) (*HistoricalEventHfCarouse
 		}
 	}
 }
-func parseHistoricalEventHfCarousePlus(p *util.XMLParser, obj *HistoricalEventHfCarouse) (*HistoricalEventHfCarouse, error) {
+func parseHistoricalEventHfCarousePlus(p *parser, obj *HistoricalEventHfCarouse) (*HistoricalEventHfCarouse, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfCarouse()
+		obj = p.newHistoricalEventHfCarouse()
 	}
 
 	for {
@@ -32500,8 +34728,8 @@ func parseHistoricalEventHfCarousePlus(p *util.XMLParser, obj *HistoricalEventHf
 		}
 	}
 }
-func parseHistoricalEventHfConfronted(p *util.XMLParser) (*HistoricalEventHfConfronted, error) {
-	var obj = NewHistoricalEventHfConfronted()
+func parseHistoricalEventHfConfronted(p *parser) (*HistoricalEventHfConfronted, error) {
+	var obj = p.newHistoricalEventHfConfronted()
 
 	for {
 		t, n, err := p.Token()
@@ -32517,6 +34745,7 @@ func parseHistoricalEventHfConfronted(p *util.XMLParser) (*HistoricalEventHfConf
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -32564,9 +34793,9 @@ func parseHistoricalEventHfConfronted(p *util.XMLParser) (*HistoricalEventHfConf
 		}
 	}
 }
-func parseHistoricalEventHfConfrontedPlus(p *util.XMLParser, obj *HistoricalEventHfConfronted) (*HistoricalEventHfConfronted, error) {
+func parseHistoricalEventHfConfrontedPlus(p *parser, obj *HistoricalEventHfConfronted) (*HistoricalEventHfConfronted, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfConfronted()
+		obj = p.newHistoricalEventHfConfronted()
 	}
 
 	for {
@@ -32588,8 +34817,8 @@ func parseHistoricalEventHfConfrontedPlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventHfConvicted(p *util.XMLParser) (*HistoricalEventHfConvicted, error) {
-	var obj = NewHistoricalEventHfConvicted()
+func parseHistoricalEventHfConvicted(p *parser) (*HistoricalEventHfConvicted, error) {
+	var obj = p.newHistoricalEventHfConvicted()
 
 	for {
 		t, n, err := p.Token()
@@ -32772,9 +35001,9 @@ func parseHistoricalEventHfConvicted(p *util.XMLParser) (*HistoricalEventHfConvi
 		}
 	}
 }
-func parseHistoricalEventHfConvictedPlus(p *util.XMLParser, obj *HistoricalEventHfConvicted) (*HistoricalEventHfConvicted, error) {
+func parseHistoricalEventHfConvictedPlus(p *parser, obj *HistoricalEventHfConvicted) (*HistoricalEventHfConvicted, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfConvicted()
+		obj = p.newHistoricalEventHfConvicted()
 	}
 
 	for {
@@ -32796,8 +35025,8 @@ func parseHistoricalEventHfConvictedPlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventHfDestroyedSite(p *util.XMLParser) (*HistoricalEventHfDestroyedSite, error) {
-	var obj = NewHistoricalEventHfDestroyedSite()
+func parseHistoricalEventHfDestroyedSite(p *parser) (*HistoricalEventHfDestroyedSite, error) {
+	var obj = p.newHistoricalEventHfDestroyedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -32842,9 +35071,9 @@ func parseHistoricalEventHfDestroyedSite(p *util.XMLParser) (*HistoricalEventHfD
 		}
 	}
 }
-func parseHistoricalEventHfDestroyedSitePlus(p *util.XMLParser, obj *HistoricalEventHfDestroyedSite) (*HistoricalEventHfDestroyedSite, error) {
+func parseHistoricalEventHfDestroyedSitePlus(p *parser, obj *HistoricalEventHfDestroyedSite) (*HistoricalEventHfDestroyedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfDestroyedSite()
+		obj = p.newHistoricalEventHfDestroyedSite()
 	}
 
 	for {
@@ -32866,8 +35095,8 @@ func parseHistoricalEventHfDestroyedSitePlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventHfDied(p *util.XMLParser) (*HistoricalEventHfDied, error) {
-	var obj = NewHistoricalEventHfDied()
+func parseHistoricalEventHfDied(p *parser) (*HistoricalEventHfDied, error) {
+	var obj = p.newHistoricalEventHfDied()
 
 	for {
 		t, n, err := p.Token()
@@ -32906,7 +35135,7 @@ func parseHistoricalEventHfDied(p *util.XMLParser) (*HistoricalEventHfDied, erro
 				if err != nil {
 					return nil, err
 				}
-				obj.SlayerCaste = txt(data)
+				obj.SlayerCaste = p.itxt(data)
 			case "slayer_hfid":
 				data, err := p.Value()
 				if err != nil {
@@ -32924,7 +35153,7 @@ func parseHistoricalEventHfDied(p *util.XMLParser) (*HistoricalEventHfDied, erro
 				if err != nil {
 					return nil, err
 				}
-				obj.SlayerRace = txt(data)
+				obj.SlayerRace = p.itxt(data)
 			case "slayer_shooter_item_id":
 				data, err := p.Value()
 				if err != nil {
@@ -32948,9 +35177,9 @@ func parseHistoricalEventHfDied(p *util.XMLParser) (*HistoricalEventHfDied, erro
 		}
 	}
 }
-func parseHistoricalEventHfDiedPlus(p *util.XMLParser, obj *HistoricalEventHfDied) (*HistoricalEventHfDied, error) {
+func parseHistoricalEventHfDiedPlus(p *parser, obj *HistoricalEventHfDied) (*HistoricalEventHfDied, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfDied()
+		obj = p.newHistoricalEventHfDied()
 	}
 
 	for {
@@ -32984,19 +35213,19 @@ func parseHistoricalEventHfDiedPlus(p *util.XMLParser, obj *HistoricalEventHfDie
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemSubtype = txt(data)
+				obj.ItemSubtype = p.itxt(data)
 			case "item_type":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemType = txt(data)
+				obj.ItemType = p.itxt(data)
 			case "mat":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.Mat = txt(data)
+				obj.Mat = p.itxt(data)
 			case "shooter_artifact_id":
 				data, err := p.Value()
 				if err != nil {
@@ -33026,7 +35255,7 @@ func parseHistoricalEventHfDiedPlus(p *util.XMLParser, obj *HistoricalEventHfDie
 				if err != nil {
 					return nil, err
 				}
-				obj.ShooterMat = txt(data)
+				obj.ShooterMat = p.itxt(data)
 			case "site":
 				data, err := p.Value()
 				if err != nil {
@@ -33038,7 +35267,7 @@ func parseHistoricalEventHfDiedPlus(p *util.XMLParser, obj *HistoricalEventHfDie
 				if err != nil {
 					return nil, err
 				}
-				obj.SlayerCaste = txt(data)
+				obj.SlayerCaste = p.itxt(data)
 			case "slayer_hf":
 				data, err := p.Value()
 				if err != nil {
@@ -33050,7 +35279,7 @@ func parseHistoricalEventHfDiedPlus(p *util.XMLParser, obj *HistoricalEventHfDie
 				if err != nil {
 					return nil, err
 				}
-				obj.SlayerRace = txt(data)
+				obj.SlayerRace = p.itxt(data)
 			case "victim_hf":
 				data, err := p.Value()
 				if err != nil {
@@ -33068,8 +35297,8 @@ func parseHistoricalEventHfDiedPlus(p *util.XMLParser, obj *HistoricalEventHfDie
 		}
 	}
 }
-func parseHistoricalEventHfDisturbedStructure(p *util.XMLParser) (*HistoricalEventHfDisturbedStructure, error) {
-	var obj = NewHistoricalEventHfDisturbedStructure()
+func parseHistoricalEventHfDisturbedStructure(p *parser) (*HistoricalEventHfDisturbedStructure, error) {
+	var obj = p.newHistoricalEventHfDisturbedStructure()
 
 	for {
 		t, n, err := p.Token()
@@ -33108,9 +35337,9 @@ func parseHistoricalEventHfDisturbedStructure(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventHfDisturbedStructurePlus(p *util.XMLParser, obj *HistoricalEventHfDisturbedStructure) (*HistoricalEventHfDisturbedStructure, error) {
+func parseHistoricalEventHfDisturbedStructurePlus(p *parser, obj *HistoricalEventHfDisturbedStructure) (*HistoricalEventHfDisturbedStructure, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfDisturbedStructure()
+		obj = p.newHistoricalEventHfDisturbedStructure()
 	}
 
 	for {
@@ -33156,8 +35385,8 @@ func parseHistoricalEventHfDisturbedStructurePlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventHfDoesInteraction(p *util.XMLParser) (*HistoricalEventHfDoesInteraction, error) {
-	var obj = NewHistoricalEventHfDoesInteraction()
+func parseHistoricalEventHfDoesInteraction(p *parser) (*HistoricalEventHfDoesInteraction, error) {
+	var obj = p.newHistoricalEventHfDoesInteraction()
 
 	for {
 		t, n, err := p.Token()
@@ -33178,7 +35407,7 @@ func parseHistoricalEventHfDoesInteraction(p *util.XMLParser) (*HistoricalEventH
 				if err != nil {
 					return nil, err
 				}
-				obj.Interaction = txt(data)
+				obj.Interaction = p.itxt(data)
 			case "target_hfid":
 				data, err := p.Value()
 				if err != nil {
@@ -33196,9 +35425,9 @@ func parseHistoricalEventHfDoesInteraction(p *util.XMLParser) (*HistoricalEventH
 		}
 	}
 }
-func parseHistoricalEventHfDoesInteractionPlus(p *util.XMLParser, obj *HistoricalEventHfDoesInteraction) (*HistoricalEventHfDoesInteraction, error) {
+func parseHistoricalEventHfDoesInteractionPlus(p *parser, obj *HistoricalEventHfDoesInteraction) (*HistoricalEventHfDoesInteraction, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfDoesInteraction()
+		obj = p.newHistoricalEventHfDoesInteraction()
 	}
 
 	for {
@@ -33220,7 +35449,7 @@ func parseHistoricalEventHfDoesInteractionPlus(p *util.XMLParser, obj *Historica
 				if err != nil {
 					return nil, err
 				}
-				obj.InteractionAction = txt(data)
+				obj.InteractionAction = p.itxt(data)
 			case "region":
 				data, err := p.Value()
 				if err != nil {
@@ -33256,8 +35485,8 @@ func parseHistoricalEventHfDoesInteractionPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventHfEnslaved(p *util.XMLParser) (*HistoricalEventHfEnslaved, error) {
-	var obj = NewHistoricalEventHfEnslaved()
+func parseHistoricalEventHfEnslaved(p *parser) (*HistoricalEventHfEnslaved, error) {
+	var obj = p.newHistoricalEventHfEnslaved()
 
 	for {
 		t, n, err := p.Token()
@@ -33302,9 +35531,9 @@ func parseHistoricalEventHfEnslaved(p *util.XMLParser) (*HistoricalEventHfEnslav
 		}
 	}
 }
-func parseHistoricalEventHfEnslavedPlus(p *util.XMLParser, obj *HistoricalEventHfEnslaved) (*HistoricalEventHfEnslaved, error) {
+func parseHistoricalEventHfEnslavedPlus(p *parser, obj *HistoricalEventHfEnslaved) (*HistoricalEventHfEnslaved, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfEnslaved()
+		obj = p.newHistoricalEventHfEnslaved()
 	}
 
 	for {
@@ -33326,8 +35555,8 @@ func parseHistoricalEventHfEnslavedPlus(p *util.XMLParser, obj *HistoricalEventH
 		}
 	}
 }
-func parseHistoricalEventHfEquipmentPurchase(p *util.XMLParser) (*HistoricalEventHfEquipmentPurchase, error) {
-	var obj = NewHistoricalEventHfEquipmentPurchase()
+func parseHistoricalEventHfEquipmentPurchase(p *parser) (*HistoricalEventHfEquipmentPurchase, error) {
+	var obj = p.newHistoricalEventHfEquipmentPurchase()
 
 	for {
 		t, n, err := p.Token()
@@ -33384,9 +35613,9 @@ func parseHistoricalEventHfEquipmentPurchase(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventHfEquipmentPurchasePlus(p *util.XMLParser, obj *HistoricalEventHfEquipmentPurchase) (*HistoricalEventHfEquipmentPurchase, error) {
+func parseHistoricalEventHfEquipmentPurchasePlus(p *parser, obj *HistoricalEventHfEquipmentPurchase) (*HistoricalEventHfEquipmentPurchase, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfEquipmentPurchase()
+		obj = p.newHistoricalEventHfEquipmentPurchase()
 	}
 
 	for {
@@ -33408,8 +35637,8 @@ func parseHistoricalEventHfEquipmentPurchasePlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventHfFreed(p *util.XMLParser) (*HistoricalEventHfFreed, error) {
-	var obj = NewHistoricalEventHfFreed()
+func parseHistoricalEventHfFreed(p *parser) (*HistoricalEventHfFreed, error) {
+	var obj = p.newHistoricalEventHfFreed()
 
 	for {
 		t, n, err := p.Token()
@@ -33466,9 +35695,9 @@ func parseHistoricalEventHfFreed(p *util.XMLParser) (*HistoricalEventHfFreed, er
 		}
 	}
 }
-func parseHistoricalEventHfFreedPlus(p *util.XMLParser, obj *HistoricalEventHfFreed) (*HistoricalEventHfFreed, error) {
+func parseHistoricalEventHfFreedPlus(p *parser, obj *HistoricalEventHfFreed) (*HistoricalEventHfFreed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfFreed()
+		obj = p.newHistoricalEventHfFreed()
 	}
 
 	for {
@@ -33490,8 +35719,8 @@ func parseHistoricalEventHfFreedPlus(p *util.XMLParser, obj *HistoricalEventHfFr
 		}
 	}
 }
-func parseHistoricalEventHfGainsSecretGoal(p *util.XMLParser) (*HistoricalEventHfGainsSecretGoal, error) {
-	var obj = NewHistoricalEventHfGainsSecretGoal()
+func parseHistoricalEventHfGainsSecretGoal(p *parser) (*HistoricalEventHfGainsSecretGoal, error) {
+	var obj = p.newHistoricalEventHfGainsSecretGoal()
 
 	for {
 		t, n, err := p.Token()
@@ -33524,9 +35753,9 @@ func parseHistoricalEventHfGainsSecretGoal(p *util.XMLParser) (*HistoricalEventH
 		}
 	}
 }
-func parseHistoricalEventHfGainsSecretGoalPlus(p *util.XMLParser, obj *HistoricalEventHfGainsSecretGoal) (*HistoricalEventHfGainsSecretGoal, error) {
+func parseHistoricalEventHfGainsSecretGoalPlus(p *parser, obj *HistoricalEventHfGainsSecretGoal) (*HistoricalEventHfGainsSecretGoal, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfGainsSecretGoal()
+		obj = p.newHistoricalEventHfGainsSecretGoal()
 	}
 
 	for {
@@ -33548,8 +35777,8 @@ func parseHistoricalEventHfGainsSecretGoalPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventHfInterrogated(p *util.XMLParser) (*HistoricalEventHfInterrogated, error) {
-	var obj = NewHistoricalEventHfInterrogated()
+func parseHistoricalEventHfInterrogated(p *parser) (*HistoricalEventHfInterrogated, error) {
+	var obj = p.newHistoricalEventHfInterrogated()
 
 	for {
 		t, n, err := p.Token()
@@ -33606,9 +35835,9 @@ func parseHistoricalEventHfInterrogated(p *util.XMLParser) (*HistoricalEventHfIn
 		}
 	}
 }
-func parseHistoricalEventHfInterrogatedPlus(p *util.XMLParser, obj *HistoricalEventHfInterrogated) (*HistoricalEventHfInterrogated, error) {
+func parseHistoricalEventHfInterrogatedPlus(p *parser, obj *HistoricalEventHfInterrogated) (*HistoricalEventHfInterrogated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfInterrogated()
+		obj = p.newHistoricalEventHfInterrogated()
 	}
 
 	for {
@@ -33630,8 +35859,8 @@ func parseHistoricalEventHfInterrogatedPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventHfLearnsSecret(p *util.XMLParser) (*HistoricalEventHfLearnsSecret, error) {
-	var obj = NewHistoricalEventHfLearnsSecret()
+func parseHistoricalEventHfLearnsSecret(p *parser) (*HistoricalEventHfLearnsSecret, error) {
+	var obj = p.newHistoricalEventHfLearnsSecret()
 
 	for {
 		t, n, err := p.Token()
@@ -33652,7 +35881,7 @@ func parseHistoricalEventHfLearnsSecret(p *util.XMLParser) (*HistoricalEventHfLe
 				if err != nil {
 					return nil, err
 				}
-				obj.Interaction = txt(data)
+				obj.Interaction = p.itxt(data)
 			case "student_hfid":
 				data, err := p.Value()
 				if err != nil {
@@ -33676,9 +35905,9 @@ func parseHistoricalEventHfLearnsSecret(p *util.XMLParser) (*HistoricalEventHfLe
 		}
 	}
 }
-func parseHistoricalEventHfLearnsSecretPlus(p *util.XMLParser, obj *HistoricalEventHfLearnsSecret) (*HistoricalEventHfLearnsSecret, error) {
+func parseHistoricalEventHfLearnsSecretPlus(p *parser, obj *HistoricalEventHfLearnsSecret) (*HistoricalEventHfLearnsSecret, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfLearnsSecret()
+		obj = p.newHistoricalEventHfLearnsSecret()
 	}
 
 	for {
@@ -33730,8 +35959,8 @@ func parseHistoricalEventHfLearnsSecretPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventHfNewPet(p *util.XMLParser) (*HistoricalEventHfNewPet, error) {
-	var obj = NewHistoricalEventHfNewPet()
+func parseHistoricalEventHfNewPet(p *parser) (*HistoricalEventHfNewPet, error) {
+	var obj = p.newHistoricalEventHfNewPet()
 
 	for {
 		t, n, err := p.Token()
@@ -33747,6 +35976,7 @@ func parseHistoricalEventHfNewPet(p *util.XMLParser) (*HistoricalEventHfNewPet,
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -33782,9 +36012,9 @@ func parseHistoricalEventHfNewPet(p *util.XMLParser) (*HistoricalEventHfNewPet,
 		}
 	}
 }
-func parseHistoricalEventHfNewPetPlus(p *util.XMLParser, obj *HistoricalEventHfNewPet) (*HistoricalEventHfNewPet, error) {
+func parseHistoricalEventHfNewPetPlus(p *parser, obj *HistoricalEventHfNewPet) (*HistoricalEventHfNewPet, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfNewPet()
+		obj = p.newHistoricalEventHfNewPet()
 	}
 
 	for {
@@ -33806,7 +36036,7 @@ func parseHistoricalEventHfNewPetPlus(p *util.XMLParser, obj *HistoricalEventHfN
 				if err != nil {
 					return nil, err
 				}
-				obj.Pets = txt(data)
+				obj.Pets = p.itxt(data)
 			case "site":
 				data, err := p.Value()
 				if err != nil {
@@ -33824,8 +36054,8 @@ func parseHistoricalEventHfNewPetPlus(p *util.XMLParser, obj *HistoricalEventHfN
 		}
 	}
 }
-func parseHistoricalEventHfPerformedHorribleExperiments(p *util.XMLParser) (*HistoricalEventHfPerformedHorribleExperiments, error) {
-	var obj = NewHistoricalEventHfPerformedHorribleExperiments()
+func parseHistoricalEventHfPerformedHorribleExperiments(p *parser) (*HistoricalEventHfPerformedHorribleExperiments, error) {
+	var obj = p.newHistoricalEventHfPerformedHorribleExperiments()
 
 	for {
 		t, n, err := p.Token()
@@ -33876,9 +36106,9 @@ func parseHistoricalEventHfPerformedHorribleExperiments(p *util.XMLParser) (*His
 		}
 	}
 }
-func parseHistoricalEventHfPerformedHorribleExperimentsPlus(p *util.XMLParser, obj *HistoricalEventHfPerformedHorribleExperiments) (*HistoricalEventHfPerformedHorribleExperiments, error) {
+func parseHistoricalEventHfPerformedHorribleExperimentsPlus(p *parser, obj *HistoricalEventHfPerformedHorribleExperiments) (*HistoricalEventHfPerformedHorribleExperiments, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfPerformedHorribleExperiments()
+		obj = p.newHistoricalEventHfPerformedHorribleExperiments()
 	}
 
 	for {
@@ -33900,8 +36130,8 @@ func parseHistoricalEventHfPerformedHorribleExperimentsPlus(p *util.XMLParser, o
 		}
 	}
 }
-func parseHistoricalEventHfPrayedInsideStructure(p *util.XMLParser) (*HistoricalEventHfPrayedInsideStructure, error) {
-	var obj = NewHistoricalEventHfPrayedInsideStructure()
+func parseHistoricalEventHfPrayedInsideStructure(p *parser) (*HistoricalEventHfPrayedInsideStructure, error) {
+	var obj = p.newHistoricalEventHfPrayedInsideStructure()
 
 	for {
 		t, n, err := p.Token()
@@ -33940,9 +36170,9 @@ func parseHistoricalEventHfPrayedInsideStructure(p *util.XMLParser) (*Historical
 		}
 	}
 }
-func parseHistoricalEventHfPrayedInsideStructurePlus(p *util.XMLParser, obj *HistoricalEventHfPrayedInsideStructure) (*HistoricalEventHfPrayedInsideStructure, error) {
+func parseHistoricalEventHfPrayedInsideStructurePlus(p *parser, obj *HistoricalEventHfPrayedInsideStructure) (*HistoricalEventHfPrayedInsideStructure, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfPrayedInsideStructure()
+		obj = p.newHistoricalEventHfPrayedInsideStructure()
 	}
 
 	for {
@@ -33988,8 +36218,8 @@ func parseHistoricalEventHfPrayedInsideStructurePlus(p *util.XMLParser, obj *His
 		}
 	}
 }
-func parseHistoricalEventHfPreach(p *util.XMLParser) (*HistoricalEventHfPreach, error) {
-	var obj = NewHistoricalEventHfPreach()
+func parseHistoricalEventHfPreach(p *parser) (*HistoricalEventHfPreach, error) {
+	var obj = p.newHistoricalEventHfPreach()
 
 	for {
 		t, n, err := p.Token()
@@ -34040,9 +36270,9 @@ func parseHistoricalEventHfPreach(p *util.XMLParser) (*HistoricalEventHfPreach,
 		}
 	}
 }
-func parseHistoricalEventHfPreachPlus(p *util.XMLParser, obj *HistoricalEventHfPreach) (*HistoricalEventHfPreach, error) {
+func parseHistoricalEventHfPreachPlus(p *parser, obj *HistoricalEventHfPreach) (*HistoricalEventHfPreach, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfPreach()
+		obj = p.newHistoricalEventHfPreach()
 	}
 
 	for {
@@ -34064,8 +36294,8 @@ func parseHistoricalEventHfPreachPlus(p *util.XMLParser, obj *HistoricalEventHfP
 		}
 	}
 }
-func parseHistoricalEventHfProfanedStructure(p *util.XMLParser) (*HistoricalEventHfProfanedStructure, error) {
-	var obj = NewHistoricalEventHfProfanedStructure()
+func parseHistoricalEventHfProfanedStructure(p *parser) (*HistoricalEventHfProfanedStructure, error) {
+	var obj = p.newHistoricalEventHfProfanedStructure()
 
 	for {
 		t, n, err := p.Token()
@@ -34104,9 +36334,9 @@ func parseHistoricalEventHfProfanedStructure(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventHfProfanedStructurePlus(p *util.XMLParser, obj *HistoricalEventHfProfanedStructure) (*HistoricalEventHfProfanedStructure, error) {
+func parseHistoricalEventHfProfanedStructurePlus(p *parser, obj *HistoricalEventHfProfanedStructure) (*HistoricalEventHfProfanedStructure, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfProfanedStructure()
+		obj = p.newHistoricalEventHfProfanedStructure()
 	}
 
 	for {
@@ -34152,8 +36382,8 @@ func parseHistoricalEventHfProfanedStructurePlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventHfRansomed(p *util.XMLParser) (*HistoricalEventHfRansomed, error) {
-	var obj = NewHistoricalEventHfRansomed()
+func parseHistoricalEventHfRansomed(p *parser) (*HistoricalEventHfRansomed, error) {
+	var obj = p.newHistoricalEventHfRansomed()
 
 	for {
 		t, n, err := p.Token()
@@ -34204,9 +36434,9 @@ func parseHistoricalEventHfRansomed(p *util.XMLParser) (*HistoricalEventHfRansom
 		}
 	}
 }
-func parseHistoricalEventHfRansomedPlus(p *util.XMLParser, obj *HistoricalEventHfRansomed) (*HistoricalEventHfRansomed, error) {
+func parseHistoricalEventHfRansomedPlus(p *parser, obj *HistoricalEventHfRansomed) (*HistoricalEventHfRansomed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfRansomed()
+		obj = p.newHistoricalEventHfRansomed()
 	}
 
 	for {
@@ -34228,8 +36458,8 @@ func parseHistoricalEventHfRansomedPlus(p *util.XMLParser, obj *HistoricalEventH
 		}
 	}
 }
-func parseHistoricalEventHfReachSummit(p *util.XMLParser) (*HistoricalEventHfReachSummit, error) {
-	var obj = NewHistoricalEventHfReachSummit()
+func parseHistoricalEventHfReachSummit(p *parser) (*HistoricalEventHfReachSummit, error) {
+	var obj = p.newHistoricalEventHfReachSummit()
 
 	for {
 		t, n, err := p.Token()
@@ -34245,6 +36475,7 @@ func parseHistoricalEventHfReachSummit(p *util.XMLParser) (*HistoricalEventHfRea
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -34274,9 +36505,9 @@ func parseHistoricalEventHfReachSummit(p *util.XMLParser) (*HistoricalEventHfRea
 		}
 	}
 }
-func parseHistoricalEventHfReachSummitPlus(p *util.XMLParser, obj *HistoricalEventHfReachSummit) (*HistoricalEventHfReachSummit, error) {
+func parseHistoricalEventHfReachSummitPlus(p *parser, obj *HistoricalEventHfReachSummit) (*HistoricalEventHfReachSummit, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfReachSummit()
+		obj = p.newHistoricalEventHfReachSummit()
 	}
 
 	for {
@@ -34304,8 +36535,8 @@ func parseHistoricalEventHfReachSummitPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventHfRecruitedUnitTypeForEntity(p *util.XMLParser) (*HistoricalEventHfRecruitedUnitTypeForEntity, error) {
-	var obj = NewHistoricalEventHfRecruitedUnitTypeForEntity()
+func parseHistoricalEventHfRecruitedUnitTypeForEntity(p *parser) (*HistoricalEventHfRecruitedUnitTypeForEntity, error) {
+	var obj = p.newHistoricalEventHfRecruitedUnitTypeForEntity()
 
 	for {
 		t, n, err := p.Token()
@@ -34362,9 +36593,9 @@ func parseHistoricalEventHfRecruitedUnitTypeForEntity(p *util.XMLParser) (*Histo
 		}
 	}
 }
-func parseHistoricalEventHfRecruitedUnitTypeForEntityPlus(p *util.XMLParser, obj *HistoricalEventHfRecruitedUnitTypeForEntity) (*HistoricalEventHfRecruitedUnitTypeForEntity, error) {
+func parseHistoricalEventHfRecruitedUnitTypeForEntityPlus(p *parser, obj *HistoricalEventHfRecruitedUnitTypeForEntity) (*HistoricalEventHfRecruitedUnitTypeForEntity, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfRecruitedUnitTypeForEntity()
+		obj = p.newHistoricalEventHfRecruitedUnitTypeForEntity()
 	}
 
 	for {
@@ -34386,8 +36617,8 @@ func parseHistoricalEventHfRecruitedUnitTypeForEntityPlus(p *util.XMLParser, obj
 		}
 	}
 }
-func parseHistoricalEventHfRelationshipDenied(p *util.XMLParser) (*HistoricalEventHfRelationshipDenied, error) {
-	var obj = NewHistoricalEventHfRelationshipDenied()
+func parseHistoricalEventHfRelationshipDenied(p *parser) (*HistoricalEventHfRelationshipDenied, error) {
+	var obj = p.newHistoricalEventHfRelationshipDenied()
 
 	for {
 		t, n, err := p.Token()
@@ -34456,9 +36687,9 @@ func parseHistoricalEventHfRelationshipDenied(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventHfRelationshipDeniedPlus(p *util.XMLParser, obj *HistoricalEventHfRelationshipDenied) (*HistoricalEventHfRelationshipDenied, error) {
+func parseHistoricalEventHfRelationshipDeniedPlus(p *parser, obj *HistoricalEventHfRelationshipDenied) (*HistoricalEventHfRelationshipDenied, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfRelationshipDenied()
+		obj = p.newHistoricalEventHfRelationshipDenied()
 	}
 
 	for {
@@ -34480,8 +36711,8 @@ func parseHistoricalEventHfRelationshipDeniedPlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventHfReunion(p *util.XMLParser) (*HistoricalEventHfReunion, error) {
-	var obj = NewHistoricalEventHfReunion()
+func parseHistoricalEventHfReunion(p *parser) (*HistoricalEventHfReunion, error) {
+	var obj = p.newHistoricalEventHfReunion()
 
 	for {
 		t, n, err := p.Token()
@@ -34532,9 +36763,9 @@ func parseHistoricalEventHfReunion(p *util.XMLParser) (*HistoricalEventHfReunion
 		}
 	}
 }
-func parseHistoricalEventHfReunionPlus(p *util.XMLParser, obj *HistoricalEventHfReunion) (*HistoricalEventHfReunion, error) {
+func parseHistoricalEventHfReunionPlus(p *parser, obj *HistoricalEventHfReunion) (*HistoricalEventHfReunion, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfReunion()
+		obj = p.newHistoricalEventHfReunion()
 	}
 
 	for {
@@ -34556,8 +36787,8 @@ func parseHistoricalEventHfReunionPlus(p *util.XMLParser, obj *HistoricalEventHf
 		}
 	}
 }
-func parseHistoricalEventHfRevived(p *util.XMLParser) (*HistoricalEventHfRevived, error) {
-	var obj = NewHistoricalEventHfRevived()
+func parseHistoricalEventHfRevived(p *parser) (*HistoricalEventHfRevived, error) {
+	var obj = p.newHistoricalEventHfRevived()
 
 	for {
 		t, n, err := p.Token()
@@ -34626,9 +36857,9 @@ func parseHistoricalEventHfRevived(p *util.XMLParser) (*HistoricalEventHfRevived
 		}
 	}
 }
-func parseHistoricalEventHfRevivedPlus(p *util.XMLParser, obj *HistoricalEventHfRevived) (*HistoricalEventHfRevived, error) {
+func parseHistoricalEventHfRevivedPlus(p *parser, obj *HistoricalEventHfRevived) (*HistoricalEventHfRevived, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfRevived()
+		obj = p.newHistoricalEventHfRevived()
 	}
 
 	for {
@@ -34650,8 +36881,8 @@ func parseHistoricalEventHfRevivedPlus(p *util.XMLParser, obj *HistoricalEventHf
 		}
 	}
 }
-func parseHistoricalEventHfSimpleBattleEvent(p *util.XMLParser) (*HistoricalEventHfSimpleBattleEvent, error) {
-	var obj = NewHistoricalEventHfSimpleBattleEvent()
+func parseHistoricalEventHfSimpleBattleEvent(p *parser) (*HistoricalEventHfSimpleBattleEvent, error) {
+	var obj = p.newHistoricalEventHfSimpleBattleEvent()
 
 	for {
 		t, n, err := p.Token()
@@ -34708,9 +36939,9 @@ func parseHistoricalEventHfSimpleBattleEvent(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventHfSimpleBattleEventPlus(p *util.XMLParser, obj *HistoricalEventHfSimpleBattleEvent) (*HistoricalEventHfSimpleBattleEvent, error) {
+func parseHistoricalEventHfSimpleBattleEventPlus(p *parser, obj *HistoricalEventHfSimpleBattleEvent) (*HistoricalEventHfSimpleBattleEvent, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfSimpleBattleEvent()
+		obj = p.newHistoricalEventHfSimpleBattleEvent()
 	}
 
 	for {
@@ -34732,8 +36963,8 @@ func parseHistoricalEventHfSimpleBattleEventPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventHfTravel(p *util.XMLParser) (*HistoricalEventHfTravel, error) {
-	var obj = NewHistoricalEventHfTravel()
+func parseHistoricalEventHfTravel(p *parser) (*HistoricalEventHfTravel, error) {
+	var obj = p.newHistoricalEventHfTravel()
 
 	for {
 		t, n, err := p.Token()
@@ -34749,6 +36980,7 @@ func parseHistoricalEventHfTravel(p *util.XMLParser) (*HistoricalEventHfTravel,
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "feature_layer_id":
 				data, err := p.Value()
 				if err != nil {
@@ -34790,9 +37022,9 @@ func parseHistoricalEventHfTravel(p *util.XMLParser) (*HistoricalEventHfTravel,
 		}
 	}
 }
-func parseHistoricalEventHfTravelPlus(p *util.XMLParser, obj *HistoricalEventHfTravel) (*HistoricalEventHfTravel, error) {
+func parseHistoricalEventHfTravelPlus(p *parser, obj *HistoricalEventHfTravel) (*HistoricalEventHfTravel, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfTravel()
+		obj = p.newHistoricalEventHfTravel()
 	}
 
 	for {
@@ -34814,8 +37046,8 @@ func parseHistoricalEventHfTravelPlus(p *util.XMLParser, obj *HistoricalEventHfT
 		}
 	}
 }
-func parseHistoricalEventHfViewedArtifact(p *util.XMLParser) (*HistoricalEventHfViewedArtifact, error) {
-	var obj = NewHistoricalEventHfViewedArtifact()
+func parseHistoricalEventHfViewedArtifact(p *parser) (*HistoricalEventHfViewedArtifact, error) {
+	var obj = p.newHistoricalEventHfViewedArtifact()
 
 	for {
 		t, n, err := p.Token()
@@ -34860,9 +37092,9 @@ func parseHistoricalEventHfViewedArtifact(p *util.XMLParser) (*HistoricalEventHf
 		}
 	}
 }
-func parseHistoricalEventHfViewedArtifactPlus(p *util.XMLParser, obj *HistoricalEventHfViewedArtifact) (*HistoricalEventHfViewedArtifact, error) {
+func parseHistoricalEventHfViewedArtifactPlus(p *parser, obj *HistoricalEventHfViewedArtifact) (*HistoricalEventHfViewedArtifact, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfViewedArtifact()
+		obj = p.newHistoricalEventHfViewedArtifact()
 	}
 
 	for {
@@ -34884,8 +37116,8 @@ func parseHistoricalEventHfViewedArtifactPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventHfWounded(p *util.XMLParser) (*HistoricalEventHfWounded, error) {
-	var obj = NewHistoricalEventHfWounded()
+func parseHistoricalEventHfWounded(p *parser) (*HistoricalEventHfWounded, error) {
+	var obj = p.newHistoricalEventHfWounded()
 
 	for {
 		t, n, err := p.Token()
@@ -34942,9 +37174,9 @@ func parseHistoricalEventHfWounded(p *util.XMLParser) (*HistoricalEventHfWounded
 		}
 	}
 }
-func parseHistoricalEventHfWoundedPlus(p *util.XMLParser, obj *HistoricalEventHfWounded) (*HistoricalEventHfWounded, error) {
+func parseHistoricalEventHfWoundedPlus(p *parser, obj *HistoricalEventHfWounded) (*HistoricalEventHfWounded, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfWounded()
+		obj = p.newHistoricalEventHfWounded()
 	}
 
 	for {
@@ -35014,8 +37246,8 @@ func parseHistoricalEventHfWoundedPlus(p *util.XMLParser, obj *HistoricalEventHf
 		}
 	}
 }
-func parseHistoricalEventHfsFormedIntrigueRelationship(p *util.XMLParser) (*HistoricalEventHfsFormedIntrigueRelationship, error) {
-	var obj = NewHistoricalEventHfsFormedIntrigueRelationship()
+func parseHistoricalEventHfsFormedIntrigueRelationship(p *parser) (*HistoricalEventHfsFormedIntrigueRelationship, error) {
+	var obj = p.newHistoricalEventHfsFormedIntrigueRelationship()
 
 	for {
 		t, n, err := p.Token()
@@ -35216,9 +37448,9 @@ func parseHistoricalEventHfsFormedIntrigueRelationship(p *util.XMLParser) (*Hist
 		}
 	}
 }
-func parseHistoricalEventHfsFormedIntrigueRelationshipPlus(p *util.XMLParser, obj *HistoricalEventHfsFormedIntrigueRelationship) (*HistoricalEventHfsFormedIntrigueRelationship, error) {
+func parseHistoricalEventHfsFormedIntrigueRelationshipPlus(p *parser, obj *HistoricalEventHfsFormedIntrigueRelationship) (*HistoricalEventHfsFormedIntrigueRelationship, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfsFormedIntrigueRelationship()
+		obj = p.newHistoricalEventHfsFormedIntrigueRelationship()
 	}
 
 	for {
@@ -35240,8 +37472,8 @@ func parseHistoricalEventHfsFormedIntrigueRelationshipPlus(p *util.XMLParser, ob
 		}
 	}
 }
-func parseHistoricalEventHfsFormedReputationRelationship(p *util.XMLParser) (*HistoricalEventHfsFormedReputationRelationship, error) {
-	var obj = NewHistoricalEventHfsFormedReputationRelationship()
+func parseHistoricalEventHfsFormedReputationRelationship(p *parser) (*HistoricalEventHfsFormedReputationRelationship, error) {
+	var obj = p.newHistoricalEventHfsFormedReputationRelationship()
 
 	for {
 		t, n, err := p.Token()
@@ -35316,9 +37548,9 @@ func parseHistoricalEventHfsFormedReputationRelationship(p *util.XMLParser) (*Hi
 		}
 	}
 }
-func parseHistoricalEventHfsFormedReputationRelationshipPlus(p *util.XMLParser, obj *HistoricalEventHfsFormedReputationRelationship) (*HistoricalEventHfsFormedReputationRelationship, error) {
+func parseHistoricalEventHfsFormedReputationRelationshipPlus(p *parser, obj *HistoricalEventHfsFormedReputationRelationship) (*HistoricalEventHfsFormedReputationRelationship, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHfsFormedReputationRelationship()
+		obj = p.newHistoricalEventHfsFormedReputationRelationship()
 	}
 
 	for {
@@ -35340,8 +37572,8 @@ func parseHistoricalEventHfsFormedReputationRelationshipPlus(p *util.XMLParser,
 		}
 	}
 }
-func parseHistoricalEventHolyCityDeclaration(p *util.XMLParser) (*HistoricalEventHolyCityDeclaration, error) {
-	var obj = NewHistoricalEventHolyCityDeclaration()
+func parseHistoricalEventHolyCityDeclaration(p *parser) (*HistoricalEventHolyCityDeclaration, error) {
+	var obj = p.newHistoricalEventHolyCityDeclaration()
 
 	for {
 		t, n, err := p.Token()
@@ -35374,9 +37606,9 @@ func parseHistoricalEventHolyCityDeclaration(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventHolyCityDeclarationPlus(p *util.XMLParser, obj *HistoricalEventHolyCityDeclaration) (*HistoricalEventHolyCityDeclaration, error) {
+func parseHistoricalEventHolyCityDeclarationPlus(p *parser, obj *HistoricalEventHolyCityDeclaration) (*HistoricalEventHolyCityDeclaration, error) {
 	if obj == nil {
-		obj = NewHistoricalEventHolyCityDeclaration()
+		obj = p.newHistoricalEventHolyCityDeclaration()
 	}
 
 	for {
@@ -35398,8 +37630,8 @@ func parseHistoricalEventHolyCityDeclarationPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventInsurrectionStarted(p *util.XMLParser) (*HistoricalEventInsurrectionStarted, error) {
-	var obj = NewHistoricalEventInsurrectionStarted()
+func parseHistoricalEventInsurrectionStarted(p *parser) (*HistoricalEventInsurrectionStarted, error) {
+	var obj = p.newHistoricalEventInsurrectionStarted()
 
 	for {
 		t, n, err := p.Token()
@@ -35438,9 +37670,9 @@ func parseHistoricalEventInsurrectionStarted(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventInsurrectionStartedPlus(p *util.XMLParser, obj *HistoricalEventInsurrectionStarted) (*HistoricalEventInsurrectionStarted, error) {
+func parseHistoricalEventInsurrectionStartedPlus(p *parser, obj *HistoricalEventInsurrectionStarted) (*HistoricalEventInsurrectionStarted, error) {
 	if obj == nil {
-		obj = NewHistoricalEventInsurrectionStarted()
+		obj = p.newHistoricalEventInsurrectionStarted()
 	}
 
 	for {
@@ -35462,8 +37694,8 @@ func parseHistoricalEventInsurrectionStartedPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventItemStolen(p *util.XMLParser) (*HistoricalEventItemStolen, error) {
-	var obj = NewHistoricalEventItemStolen()
+func parseHistoricalEventItemStolen(p *parser) (*HistoricalEventItemStolen, error) {
+	var obj = p.newHistoricalEventItemStolen()
 
 	for {
 		t, n, err := p.Token()
@@ -35493,9 +37725,9 @@ func parseHistoricalEventItemStolen(p *util.XMLParser) (*HistoricalEventItemStol
 		}
 	}
 }
-func parseHistoricalEventItemStolenPlus(p *util.XMLParser, obj *HistoricalEventItemStolen) (*HistoricalEventItemStolen, error) {
+func parseHistoricalEventItemStolenPlus(p *parser, obj *HistoricalEventItemStolen) (*HistoricalEventItemStolen, error) {
 	if obj == nil {
-		obj = NewHistoricalEventItemStolen()
+		obj = p.newHistoricalEventItemStolen()
 	}
 
 	for {
@@ -35532,19 +37764,19 @@ func parseHistoricalEventItemStolenPlus(p *util.XMLParser, obj *HistoricalEventI
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemSubtype = txt(data)
+				obj.ItemSubtype = p.itxt(data)
 			case "item_type":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemType = txt(data)
+				obj.ItemType = p.itxt(data)
 			case "mat":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.Mat = txt(data)
+				obj.Mat = p.itxt(data)
 			case "matindex":
 				data, err := p.Value()
 				if err != nil {
@@ -35592,8 +37824,8 @@ func parseHistoricalEventItemStolenPlus(p *util.XMLParser, obj *HistoricalEventI
 		}
 	}
 }
-func parseHistoricalEventItemStolenCircumstance(p *util.XMLParser) (*HistoricalEventItemStolenCircumstance, error) {
-	var obj = NewHistoricalEventItemStolenCircumstance()
+func parseHistoricalEventItemStolenCircumstance(p *parser) (*HistoricalEventItemStolenCircumstance, error) {
+	var obj = p.newHistoricalEventItemStolenCircumstance()
 
 	for {
 		t, n, err := p.Token()
@@ -35614,9 +37846,9 @@ func parseHistoricalEventItemStolenCircumstance(p *util.XMLParser) (*HistoricalE
 		}
 	}
 }
-func parseHistoricalEventItemStolenCircumstancePlus(p *util.XMLParser, obj *HistoricalEventItemStolenCircumstance) (*HistoricalEventItemStolenCircumstance, error) {
+func parseHistoricalEventItemStolenCircumstancePlus(p *parser, obj *HistoricalEventItemStolenCircumstance) (*HistoricalEventItemStolenCircumstance, error) {
 	if obj == nil {
-		obj = NewHistoricalEventItemStolenCircumstance()
+		obj = p.newHistoricalEventItemStolenCircumstance()
 	}
 
 	for {
@@ -35662,8 +37894,8 @@ func parseHistoricalEventItemStolenCircumstancePlus(p *util.XMLParser, obj *Hist
 		}
 	}
 }
-func parseHistoricalEventKnowledgeDiscovered(p *util.XMLParser) (*HistoricalEventKnowledgeDiscovered, error) {
-	var obj = NewHistoricalEventKnowledgeDiscovered()
+func parseHistoricalEventKnowledgeDiscovered(p *parser) (*HistoricalEventKnowledgeDiscovered, error) {
+	var obj = p.newHistoricalEventKnowledgeDiscovered()
 
 	for {
 		t, n, err := p.Token()
@@ -35702,9 +37934,9 @@ func parseHistoricalEventKnowledgeDiscovered(p *util.XMLParser) (*HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventKnowledgeDiscoveredPlus(p *util.XMLParser, obj *HistoricalEventKnowledgeDiscovered) (*HistoricalEventKnowledgeDiscovered, error) {
+func parseHistoricalEventKnowledgeDiscoveredPlus(p *parser, obj *HistoricalEventKnowledgeDiscovered) (*HistoricalEventKnowledgeDiscovered, error) {
 	if obj == nil {
-		obj = NewHistoricalEventKnowledgeDiscovered()
+		obj = p.newHistoricalEventKnowledgeDiscovered()
 	}
 
 	for {
@@ -35726,8 +37958,8 @@ func parseHistoricalEventKnowledgeDiscoveredPlus(p *util.XMLParser, obj *Histori
 		}
 	}
 }
-func parseHistoricalEventMasterpieceArchConstructed(p *util.XMLParser) (*HistoricalEventMasterpieceArchConstructed, error) {
-	var obj = NewHistoricalEventMasterpieceArchConstructed()
+func parseHistoricalEventMasterpieceArchConstructed(p *parser) (*HistoricalEventMasterpieceArchConstructed, error) {
+	var obj = p.newHistoricalEventMasterpieceArchConstructed()
 
 	for {
 		t, n, err := p.Token()
@@ -35772,9 +38004,9 @@ func parseHistoricalEventMasterpieceArchConstructed(p *util.XMLParser) (*Histori
 		}
 	}
 }
-func parseHistoricalEventMasterpieceArchConstructedPlus(p *util.XMLParser, obj *HistoricalEventMasterpieceArchConstructed) (*HistoricalEventMasterpieceArchConstructed, error) {
+func parseHistoricalEventMasterpieceArchConstructedPlus(p *parser, obj *HistoricalEventMasterpieceArchConstructed) (*HistoricalEventMasterpieceArchConstructed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceArchConstructed()
+		obj = p.newHistoricalEventMasterpieceArchConstructed()
 	}
 
 	for {
@@ -35844,8 +38076,8 @@ func parseHistoricalEventMasterpieceArchConstructedPlus(p *util.XMLParser, obj *
 		}
 	}
 }
-func parseHistoricalEventMasterpieceDye(p *util.XMLParser) (*HistoricalEventMasterpieceDye, error) {
-	var obj = NewHistoricalEventMasterpieceDye()
+func parseHistoricalEventMasterpieceDye(p *parser) (*HistoricalEventMasterpieceDye, error) {
+	var obj = p.newHistoricalEventMasterpieceDye()
 
 	for {
 		t, n, err := p.Token()
@@ -35890,9 +38122,9 @@ func parseHistoricalEventMasterpieceDye(p *util.XMLParser) (*HistoricalEventMast
 		}
 	}
 }
-func parseHistoricalEventMasterpieceDyePlus(p *util.XMLParser, obj *HistoricalEventMasterpieceDye) (*HistoricalEventMasterpieceDye, error) {
+func parseHistoricalEventMasterpieceDyePlus(p *parser, obj *HistoricalEventMasterpieceDye) (*HistoricalEventMasterpieceDye, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceDye()
+		obj = p.newHistoricalEventMasterpieceDye()
 	}
 
 	for {
@@ -35908,7 +38140,7 @@ func parseHistoricalEventMasterpieceDyePlus(p *util.XMLParser, obj *HistoricalEv
 				if err != nil {
 					return nil, err
 				}
-				obj.DyeMat = txt(data)
+				obj.DyeMat = p.itxt(data)
 			case "dye_mat_index":
 				data, err := p.Value()
 				if err != nil {
@@ -35974,8 +38206,8 @@ func parseHistoricalEventMasterpieceDyePlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventMasterpieceEngraving(p *util.XMLParser) (*HistoricalEventMasterpieceEngraving, error) {
-	var obj = NewHistoricalEventMasterpieceEngraving()
+func parseHistoricalEventMasterpieceEngraving(p *parser) (*HistoricalEventMasterpieceEngraving, error) {
+	var obj = p.newHistoricalEventMasterpieceEngraving()
 
 	for {
 		t, n, err := p.Token()
@@ -36008,7 +38240,7 @@ func parseHistoricalEventMasterpieceEngraving(p *util.XMLParser) (*HistoricalEve
 				if err != nil {
 					return nil, err
 				}
-				obj.SkillAtTime = txt(data)
+				obj.SkillAtTime = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -36020,9 +38252,9 @@ func parseHistoricalEventMasterpieceEngraving(p *util.XMLParser) (*HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventMasterpieceEngravingPlus(p *util.XMLParser, obj *HistoricalEventMasterpieceEngraving) (*HistoricalEventMasterpieceEngraving, error) {
+func parseHistoricalEventMasterpieceEngravingPlus(p *parser, obj *HistoricalEventMasterpieceEngraving) (*HistoricalEventMasterpieceEngraving, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceEngraving()
+		obj = p.newHistoricalEventMasterpieceEngraving()
 	}
 
 	for {
@@ -36068,7 +38300,7 @@ func parseHistoricalEventMasterpieceEngravingPlus(p *util.XMLParser, obj *Histor
 				if err != nil {
 					return nil, err
 				}
-				obj.SkillAtTime = txt(data)
+				obj.SkillAtTime = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -36080,8 +38312,8 @@ func parseHistoricalEventMasterpieceEngravingPlus(p *util.XMLParser, obj *Histor
 		}
 	}
 }
-func parseHistoricalEventMasterpieceFood(p *util.XMLParser) (*HistoricalEventMasterpieceFood, error) {
-	var obj = NewHistoricalEventMasterpieceFood()
+func parseHistoricalEventMasterpieceFood(p *parser) (*HistoricalEventMasterpieceFood, error) {
+	var obj = p.newHistoricalEventMasterpieceFood()
 
 	for {
 		t, n, err := p.Token()
@@ -36126,9 +38358,9 @@ func parseHistoricalEventMasterpieceFood(p *util.XMLParser) (*HistoricalEventMas
 		}
 	}
 }
-func parseHistoricalEventMasterpieceFoodPlus(p *util.XMLParser, obj *HistoricalEventMasterpieceFood) (*HistoricalEventMasterpieceFood, error) {
+func parseHistoricalEventMasterpieceFoodPlus(p *parser, obj *HistoricalEventMasterpieceFood) (*HistoricalEventMasterpieceFood, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceFood()
+		obj = p.newHistoricalEventMasterpieceFood()
 	}
 
 	for {
@@ -36192,8 +38424,8 @@ func parseHistoricalEventMasterpieceFoodPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventMasterpieceItem(p *util.XMLParser) (*HistoricalEventMasterpieceItem, error) {
-	var obj = NewHistoricalEventMasterpieceItem()
+func parseHistoricalEventMasterpieceItem(p *parser) (*HistoricalEventMasterpieceItem, error) {
+	var obj = p.newHistoricalEventMasterpieceItem()
 
 	for {
 		t, n, err := p.Token()
@@ -36226,7 +38458,7 @@ func parseHistoricalEventMasterpieceItem(p *util.XMLParser) (*HistoricalEventMas
 				if err != nil {
 					return nil, err
 				}
-				obj.SkillAtTime = txt(data)
+				obj.SkillAtTime = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -36238,9 +38470,9 @@ func parseHistoricalEventMasterpieceItem(p *util.XMLParser) (*HistoricalEventMas
 		}
 	}
 }
-func parseHistoricalEventMasterpieceItemPlus(p *util.XMLParser, obj *HistoricalEventMasterpieceItem) (*HistoricalEventMasterpieceItem, error) {
+func parseHistoricalEventMasterpieceItemPlus(p *parser, obj *HistoricalEventMasterpieceItem) (*HistoricalEventMasterpieceItem, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceItem()
+		obj = p.newHistoricalEventMasterpieceItem()
 	}
 
 	for {
@@ -36262,13 +38494,13 @@ func parseHistoricalEventMasterpieceItemPlus(p *util.XMLParser, obj *HistoricalE
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemSubtype = txt(data)
+				obj.ItemSubtype = p.itxt(data)
 			case "item_type":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemType = txt(data)
+				obj.ItemType = p.itxt(data)
 			case "maker":
 				data, err := p.Value()
 				if err != nil {
@@ -36286,7 +38518,7 @@ func parseHistoricalEventMasterpieceItemPlus(p *util.XMLParser, obj *HistoricalE
 				if err != nil {
 					return nil, err
 				}
-				obj.Mat = txt(data)
+				obj.Mat = p.itxt(data)
 			case "mat_index":
 				data, err := p.Value()
 				if err != nil {
@@ -36310,7 +38542,7 @@ func parseHistoricalEventMasterpieceItemPlus(p *util.XMLParser, obj *HistoricalE
 				if err != nil {
 					return nil, err
 				}
-				obj.SkillAtTime = txt(data)
+				obj.SkillAtTime = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -36322,8 +38554,8 @@ func parseHistoricalEventMasterpieceItemPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventMasterpieceItemImprovement(p *util.XMLParser) (*HistoricalEventMasterpieceItemImprovement, error) {
-	var obj = NewHistoricalEventMasterpieceItemImprovement()
+func parseHistoricalEventMasterpieceItemImprovement(p *parser) (*HistoricalEventMasterpieceItemImprovement, error) {
+	var obj = p.newHistoricalEventMasterpieceItemImprovement()
 
 	for {
 		t, n, err := p.Token()
@@ -36368,9 +38600,9 @@ func parseHistoricalEventMasterpieceItemImprovement(p *util.XMLParser) (*Histori
 		}
 	}
 }
-func parseHistoricalEventMasterpieceItemImprovementPlus(p *util.XMLParser, obj *HistoricalEventMasterpieceItemImprovement) (*HistoricalEventMasterpieceItemImprovement, error) {
+func parseHistoricalEventMasterpieceItemImprovementPlus(p *parser, obj *HistoricalEventMasterpieceItemImprovement) (*HistoricalEventMasterpieceItemImprovement, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceItemImprovement()
+		obj = p.newHistoricalEventMasterpieceItemImprovement()
 	}
 
 	for {
@@ -36398,7 +38630,7 @@ func parseHistoricalEventMasterpieceItemImprovementPlus(p *util.XMLParser, obj *
 				if err != nil {
 					return nil, err
 				}
-				obj.ImpMat = txt(data)
+				obj.ImpMat = p.itxt(data)
 			case "improvement_subtype":
 				data, err := p.Value()
 				if err != nil {
@@ -36416,13 +38648,13 @@ func parseHistoricalEventMasterpieceItemImprovementPlus(p *util.XMLParser, obj *
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemSubtype = txt(data)
+				obj.ItemSubtype = p.itxt(data)
 			case "item_type":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.ItemType = txt(data)
+				obj.ItemType = p.itxt(data)
 			case "maker":
 				data, err := p.Value()
 				if err != nil {
@@ -36440,7 +38672,7 @@ func parseHistoricalEventMasterpieceItemImprovementPlus(p *util.XMLParser, obj *
 				if err != nil {
 					return nil, err
 				}
-				obj.Mat = txt(data)
+				obj.Mat = p.itxt(data)
 			case "site":
 				data, err := p.Value()
 				if err != nil {
@@ -36470,8 +38702,8 @@ func parseHistoricalEventMasterpieceItemImprovementPlus(p *util.XMLParser, obj *
 		}
 	}
 }
-func parseHistoricalEventMasterpieceLost(p *util.XMLParser) (*HistoricalEventMasterpieceLost, error) {
-	var obj = NewHistoricalEventMasterpieceLost()
+func parseHistoricalEventMasterpieceLost(p *parser) (*HistoricalEventMasterpieceLost, error) {
+	var obj = p.newHistoricalEventMasterpieceLost()
 
 	for {
 		t, n, err := p.Token()
@@ -36492,9 +38724,9 @@ func parseHistoricalEventMasterpieceLost(p *util.XMLParser) (*HistoricalEventMas
 		}
 	}
 }
-func parseHistoricalEventMasterpieceLostPlus(p *util.XMLParser, obj *HistoricalEventMasterpieceLost) (*HistoricalEventMasterpieceLost, error) {
+func parseHistoricalEventMasterpieceLostPlus(p *parser, obj *HistoricalEventMasterpieceLost) (*HistoricalEventMasterpieceLost, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMasterpieceLost()
+		obj = p.newHistoricalEventMasterpieceLost()
 	}
 
 	for {
@@ -36522,7 +38754,7 @@ func parseHistoricalEventMasterpieceLostPlus(p *util.XMLParser, obj *HistoricalE
 				if err != nil {
 					return nil, err
 				}
-				obj.Method = txt(data)
+				obj.Method = p.itxt(data)
 			case "site":
 				data, err := p.Value()
 				if err != nil {
@@ -36540,8 +38772,8 @@ func parseHistoricalEventMasterpieceLostPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventMerchant(p *util.XMLParser) (*HistoricalEventMerchant, error) {
-	var obj = NewHistoricalEventMerchant()
+func parseHistoricalEventMerchant(p *parser) (*HistoricalEventMerchant, error) {
+	var obj = p.newHistoricalEventMerchant()
 
 	for {
 		t, n, err := p.Token()
@@ -36604,9 +38836,9 @@ func parseHistoricalEventMerchant(p *util.XMLParser) (*HistoricalEventMerchant,
 		}
 	}
 }
-func parseHistoricalEventMerchantPlus(p *util.XMLParser, obj *HistoricalEventMerchant) (*HistoricalEventMerchant, error) {
+func parseHistoricalEventMerchantPlus(p *parser, obj *HistoricalEventMerchant) (*HistoricalEventMerchant, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMerchant()
+		obj = p.newHistoricalEventMerchant()
 	}
 
 	for {
@@ -36646,8 +38878,8 @@ func parseHistoricalEventMerchantPlus(p *util.XMLParser, obj *HistoricalEventMer
 		}
 	}
 }
-func parseHistoricalEventModifiedBuilding(p *util.XMLParser) (*HistoricalEventModifiedBuilding, error) {
-	var obj = NewHistoricalEventModifiedBuilding()
+func parseHistoricalEventModifiedBuilding(p *parser) (*HistoricalEventModifiedBuilding, error) {
+	var obj = p.newHistoricalEventModifiedBuilding()
 
 	for {
 		t, n, err := p.Token()
@@ -36692,9 +38924,9 @@ func parseHistoricalEventModifiedBuilding(p *util.XMLParser) (*HistoricalEventMo
 		}
 	}
 }
-func parseHistoricalEventModifiedBuildingPlus(p *util.XMLParser, obj *HistoricalEventModifiedBuilding) (*HistoricalEventModifiedBuilding, error) {
+func parseHistoricalEventModifiedBuildingPlus(p *parser, obj *HistoricalEventModifiedBuilding) (*HistoricalEventModifiedBuilding, error) {
 	if obj == nil {
-		obj = NewHistoricalEventModifiedBuilding()
+		obj = p.newHistoricalEventModifiedBuilding()
 	}
 
 	for {
@@ -36716,8 +38948,8 @@ func parseHistoricalEventModifiedBuildingPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventMusicalFormCreated(p *util.XMLParser) (*HistoricalEventMusicalFormCreated, error) {
-	var obj = NewHistoricalEventMusicalFormCreated()
+func parseHistoricalEventMusicalFormCreated(p *parser) (*HistoricalEventMusicalFormCreated, error) {
+	var obj = p.newHistoricalEventMusicalFormCreated()
 
 	for {
 		t, n, err := p.Token()
@@ -36780,9 +39012,9 @@ func parseHistoricalEventMusicalFormCreated(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventMusicalFormCreatedPlus(p *util.XMLParser, obj *HistoricalEventMusicalFormCreated) (*HistoricalEventMusicalFormCreated, error) {
+func parseHistoricalEventMusicalFormCreatedPlus(p *parser, obj *HistoricalEventMusicalFormCreated) (*HistoricalEventMusicalFormCreated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventMusicalFormCreated()
+		obj = p.newHistoricalEventMusicalFormCreated()
 	}
 
 	for {
@@ -36804,8 +39036,8 @@ func parseHistoricalEventMusicalFormCreatedPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventNewSiteLeader(p *util.XMLParser) (*HistoricalEventNewSiteLeader, error) {
-	var obj = NewHistoricalEventNewSiteLeader()
+func parseHistoricalEventNewSiteLeader(p *parser) (*HistoricalEventNewSiteLeader, error) {
+	var obj = p.newHistoricalEventNewSiteLeader()
 
 	for {
 		t, n, err := p.Token()
@@ -36862,9 +39094,9 @@ func parseHistoricalEventNewSiteLeader(p *util.XMLParser) (*HistoricalEventNewSi
 		}
 	}
 }
-func parseHistoricalEventNewSiteLeaderPlus(p *util.XMLParser, obj *HistoricalEventNewSiteLeader) (*HistoricalEventNewSiteLeader, error) {
+func parseHistoricalEventNewSiteLeaderPlus(p *parser, obj *HistoricalEventNewSiteLeader) (*HistoricalEventNewSiteLeader, error) {
 	if obj == nil {
-		obj = NewHistoricalEventNewSiteLeader()
+		obj = p.newHistoricalEventNewSiteLeader()
 	}
 
 	for {
@@ -36886,8 +39118,8 @@ func parseHistoricalEventNewSiteLeaderPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventPeaceAccepted(p *util.XMLParser) (*HistoricalEventPeaceAccepted, error) {
-	var obj = NewHistoricalEventPeaceAccepted()
+func parseHistoricalEventPeaceAccepted(p *parser) (*HistoricalEventPeaceAccepted, error) {
+	var obj = p.newHistoricalEventPeaceAccepted()
 
 	for {
 		t, n, err := p.Token()
@@ -36914,9 +39146,9 @@ func parseHistoricalEventPeaceAccepted(p *util.XMLParser) (*HistoricalEventPeace
 		}
 	}
 }
-func parseHistoricalEventPeaceAcceptedPlus(p *util.XMLParser, obj *HistoricalEventPeaceAccepted) (*HistoricalEventPeaceAccepted, error) {
+func parseHistoricalEventPeaceAcceptedPlus(p *parser, obj *HistoricalEventPeaceAccepted) (*HistoricalEventPeaceAccepted, error) {
 	if obj == nil {
-		obj = NewHistoricalEventPeaceAccepted()
+		obj = p.newHistoricalEventPeaceAccepted()
 	}
 
 	for {
@@ -36962,8 +39194,8 @@ func parseHistoricalEventPeaceAcceptedPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventPeaceRejected(p *util.XMLParser) (*HistoricalEventPeaceRejected, error) {
-	var obj = NewHistoricalEventPeaceRejected()
+func parseHistoricalEventPeaceRejected(p *parser) (*HistoricalEventPeaceRejected, error) {
+	var obj = p.newHistoricalEventPeaceRejected()
 
 	for {
 		t, n, err := p.Token()
@@ -36990,9 +39222,9 @@ func parseHistoricalEventPeaceRejected(p *util.XMLParser) (*HistoricalEventPeace
 		}
 	}
 }
-func parseHistoricalEventPeaceRejectedPlus(p *util.XMLParser, obj *HistoricalEventPeaceRejected) (*HistoricalEventPeaceRejected, error) {
+func parseHistoricalEventPeaceRejectedPlus(p *parser, obj *HistoricalEventPeaceRejected) (*HistoricalEventPeaceRejected, error) {
 	if obj == nil {
-		obj = NewHistoricalEventPeaceRejected()
+		obj = p.newHistoricalEventPeaceRejected()
 	}
 
 	for {
@@ -37038,8 +39270,8 @@ func parseHistoricalEventPeaceRejectedPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventPerformance(p *util.XMLParser) (*HistoricalEventPerformance, error) {
-	var obj = NewHistoricalEventPerformance()
+func parseHistoricalEventPerformance(p *parser) (*HistoricalEventPerformance, error) {
+	var obj = p.newHistoricalEventPerformance()
 
 	for {
 		t, n, err := p.Token()
@@ -37096,9 +39328,9 @@ func parseHistoricalEventPerformance(p *util.XMLParser) (*HistoricalEventPerform
 		}
 	}
 }
-func parseHistoricalEventPerformancePlus(p *util.XMLParser, obj *HistoricalEventPerformance) (*HistoricalEventPerformance, error) {
+func parseHistoricalEventPerformancePlus(p *parser, obj *HistoricalEventPerformance) (*HistoricalEventPerformance, error) {
 	if obj == nil {
-		obj = NewHistoricalEventPerformance()
+		obj = p.newHistoricalEventPerformance()
 	}
 
 	for {
@@ -37120,8 +39352,8 @@ func parseHistoricalEventPerformancePlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventPlunderedSite(p *util.XMLParser) (*HistoricalEventPlunderedSite, error) {
-	var obj = NewHistoricalEventPlunderedSite()
+func parseHistoricalEventPlunderedSite(p *parser) (*HistoricalEventPlunderedSite, error) {
+	var obj = p.newHistoricalEventPlunderedSite()
 
 	for {
 		t, n, err := p.Token()
@@ -37196,9 +39428,9 @@ func parseHistoricalEventPlunderedSite(p *util.XMLParser) (*HistoricalEventPlund
 		}
 	}
 }
-func parseHistoricalEventPlunderedSitePlus(p *util.XMLParser, obj *HistoricalEventPlunderedSite) (*HistoricalEventPlunderedSite, error) {
+func parseHistoricalEventPlunderedSitePlus(p *parser, obj *HistoricalEventPlunderedSite) (*HistoricalEventPlunderedSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventPlunderedSite()
+		obj = p.newHistoricalEventPlunderedSite()
 	}
 
 	for {
@@ -37220,8 +39452,8 @@ func parseHistoricalEventPlunderedSitePlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventPoeticFormCreated(p *util.XMLParser) (*HistoricalEventPoeticFormCreated, error) {
-	var obj = NewHistoricalEventPoeticFormCreated()
+func parseHistoricalEventPoeticFormCreated(p *parser) (*HistoricalEventPoeticFormCreated, error) {
+	var obj = p.newHistoricalEventPoeticFormCreated()
 
 	for {
 		t, n, err := p.Token()
@@ -37272,9 +39504,9 @@ func parseHistoricalEventPoeticFormCreated(p *util.XMLParser) (*HistoricalEventP
 		}
 	}
 }
-func parseHistoricalEventPoeticFormCreatedPlus(p *util.XMLParser, obj *HistoricalEventPoeticFormCreated) (*HistoricalEventPoeticFormCreated, error) {
+func parseHistoricalEventPoeticFormCreatedPlus(p *parser, obj *HistoricalEventPoeticFormCreated) (*HistoricalEventPoeticFormCreated, error) {
 	if obj == nil {
-		obj = NewHistoricalEventPoeticFormCreated()
+		obj = p.newHistoricalEventPoeticFormCreated()
 	}
 
 	for {
@@ -37296,8 +39528,8 @@ func parseHistoricalEventPoeticFormCreatedPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventProcession(p *util.XMLParser) (*HistoricalEventProcession, error) {
-	var obj = NewHistoricalEventProcession()
+func parseHistoricalEventProcession(p *parser) (*HistoricalEventProcession, error) {
+	var obj = p.newHistoricalEventProcession()
 
 	for {
 		t, n, err := p.Token()
@@ -37354,9 +39586,9 @@ func parseHistoricalEventProcession(p *util.XMLParser) (*HistoricalEventProcessi
 		}
 	}
 }
-func parseHistoricalEventProcessionPlus(p *util.XMLParser, obj *HistoricalEventProcession) (*HistoricalEventProcession, error) {
+func parseHistoricalEventProcessionPlus(p *parser, obj *HistoricalEventProcession) (*HistoricalEventProcession, error) {
 	if obj == nil {
-		obj = NewHistoricalEventProcession()
+		obj = p.newHistoricalEventProcession()
 	}
 
 	for {
@@ -37378,8 +39610,8 @@ func parseHistoricalEventProcessionPlus(p *util.XMLParser, obj *HistoricalEventP
 		}
 	}
 }
-func parseHistoricalEventRazedStructure(p *util.XMLParser) (*HistoricalEventRazedStructure, error) {
-	var obj = NewHistoricalEventRazedStructure()
+func parseHistoricalEventRazedStructure(p *parser) (*HistoricalEventRazedStructure, error) {
+	var obj = p.newHistoricalEventRazedStructure()
 
 	for {
 		t, n, err := p.Token()
@@ -37418,9 +39650,9 @@ func parseHistoricalEventRazedStructure(p *util.XMLParser) (*HistoricalEventRaze
 		}
 	}
 }
-func parseHistoricalEventRazedStructurePlus(p *util.XMLParser, obj *HistoricalEventRazedStructure) (*HistoricalEventRazedStructure, error) {
+func parseHistoricalEventRazedStructurePlus(p *parser, obj *HistoricalEventRazedStructure) (*HistoricalEventRazedStructure, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRazedStructure()
+		obj = p.newHistoricalEventRazedStructure()
 	}
 
 	for {
@@ -37442,8 +39674,8 @@ func parseHistoricalEventRazedStructurePlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventReclaimSite(p *util.XMLParser) (*HistoricalEventReclaimSite, error) {
-	var obj = NewHistoricalEventReclaimSite()
+func parseHistoricalEventReclaimSite(p *parser) (*HistoricalEventReclaimSite, error) {
+	var obj = p.newHistoricalEventReclaimSite()
 
 	for {
 		t, n, err := p.Token()
@@ -37488,9 +39720,9 @@ func parseHistoricalEventReclaimSite(p *util.XMLParser) (*HistoricalEventReclaim
 		}
 	}
 }
-func parseHistoricalEventReclaimSitePlus(p *util.XMLParser, obj *HistoricalEventReclaimSite) (*HistoricalEventReclaimSite, error) {
+func parseHistoricalEventReclaimSitePlus(p *parser, obj *HistoricalEventReclaimSite) (*HistoricalEventReclaimSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventReclaimSite()
+		obj = p.newHistoricalEventReclaimSite()
 	}
 
 	for {
@@ -37512,8 +39744,8 @@ func parseHistoricalEventReclaimSitePlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventRegionpopIncorporatedIntoEntity(p *util.XMLParser) (*HistoricalEventRegionpopIncorporatedIntoEntity, error) {
-	var obj = NewHistoricalEventRegionpopIncorporatedIntoEntity()
+func parseHistoricalEventRegionpopIncorporatedIntoEntity(p *parser) (*HistoricalEventRegionpopIncorporatedIntoEntity, error) {
+	var obj = p.newHistoricalEventRegionpopIncorporatedIntoEntity()
 
 	for {
 		t, n, err := p.Token()
@@ -37570,9 +39802,9 @@ func parseHistoricalEventRegionpopIncorporatedIntoEntity(p *util.XMLParser) (*Hi
 		}
 	}
 }
-func parseHistoricalEventRegionpopIncorporatedIntoEntityPlus(p *util.XMLParser, obj *HistoricalEventRegionpopIncorporatedIntoEntity) (*HistoricalEventRegionpopIncorporatedIntoEntity, error) {
+func parseHistoricalEventRegionpopIncorporatedIntoEntityPlus(p *parser, obj *HistoricalEventRegionpopIncorporatedIntoEntity) (*HistoricalEventRegionpopIncorporatedIntoEntity, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRegionpopIncorporatedIntoEntity()
+		obj = p.newHistoricalEventRegionpopIncorporatedIntoEntity()
 	}
 
 	for {
@@ -37594,8 +39826,8 @@ func parseHistoricalEventRegionpopIncorporatedIntoEntityPlus(p *util.XMLParser,
 		}
 	}
 }
-func parseHistoricalEventRelationship(p *util.XMLParser) (*HistoricalEventRelationship, error) {
-	var obj = NewHistoricalEventRelationship()
+func parseHistoricalEventRelationship(p *parser) (*HistoricalEventRelationship, error) {
+	var obj = p.newHistoricalEventRelationship()
 
 	for {
 		t, n, err := p.Token()
@@ -37616,9 +39848,9 @@ func parseHistoricalEventRelationship(p *util.XMLParser) (*HistoricalEventRelati
 		}
 	}
 }
-func parseHistoricalEventRelationshipPlus(p *util.XMLParser, obj *HistoricalEventRelationship) (*HistoricalEventRelationship, error) {
+func parseHistoricalEventRelationshipPlus(p *parser, obj *HistoricalEventRelationship) (*HistoricalEventRelationship, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRelationship()
+		obj = p.newHistoricalEventRelationship()
 	}
 
 	for {
@@ -37670,8 +39902,8 @@ func parseHistoricalEventRelationshipPlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventRelationshipSupplement(p *util.XMLParser) (*HistoricalEventRelationshipSupplement, error) {
-	var obj = NewHistoricalEventRelationshipSupplement()
+func parseHistoricalEventRelationshipSupplement(p *parser) (*HistoricalEventRelationshipSupplement, error) {
+	var obj = p.newHistoricalEventRelationshipSupplement()
 
 	for {
 		t, n, err := p.Token()
@@ -37692,9 +39924,9 @@ func parseHistoricalEventRelationshipSupplement(p *util.XMLParser) (*HistoricalE
 		}
 	}
 }
-func parseHistoricalEventRelationshipSupplementPlus(p *util.XMLParser, obj *HistoricalEventRelationshipSupplement) (*HistoricalEventRelationshipSupplement, error) {
+func parseHistoricalEventRelationshipSupplementPlus(p *parser, obj *HistoricalEventRelationshipSupplement) (*HistoricalEventRelationshipSupplement, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRelationshipSupplement()
+		obj = p.newHistoricalEventRelationshipSupplement()
 	}
 
 	for {
@@ -37740,8 +39972,8 @@ func parseHistoricalEventRelationshipSupplementPlus(p *util.XMLParser, obj *Hist
 		}
 	}
 }
-func parseHistoricalEventRemoveHfEntityLink(p *util.XMLParser) (*HistoricalEventRemoveHfEntityLink, error) {
-	var obj = NewHistoricalEventRemoveHfEntityLink()
+func parseHistoricalEventRemoveHfEntityLink(p *parser) (*HistoricalEventRemoveHfEntityLink, error) {
+	var obj = p.newHistoricalEventRemoveHfEntityLink()
 
 	for {
 		t, n, err := p.Token()
@@ -37786,9 +40018,9 @@ func parseHistoricalEventRemoveHfEntityLink(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventRemoveHfEntityLinkPlus(p *util.XMLParser, obj *HistoricalEventRemoveHfEntityLink) (*HistoricalEventRemoveHfEntityLink, error) {
+func parseHistoricalEventRemoveHfEntityLinkPlus(p *parser, obj *HistoricalEventRemoveHfEntityLink) (*HistoricalEventRemoveHfEntityLink, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRemoveHfEntityLink()
+		obj = p.newHistoricalEventRemoveHfEntityLink()
 	}
 
 	for {
@@ -37822,7 +40054,7 @@ func parseHistoricalEventRemoveHfEntityLinkPlus(p *util.XMLParser, obj *Historic
 				if err != nil {
 					return nil, err
 				}
-				obj.Position = txt(data)
+				obj.Position = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -37834,8 +40066,8 @@ func parseHistoricalEventRemoveHfEntityLinkPlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventRemoveHfHfLink(p *util.XMLParser) (*HistoricalEventRemoveHfHfLink, error) {
-	var obj = NewHistoricalEventRemoveHfHfLink()
+func parseHistoricalEventRemoveHfHfLink(p *parser) (*HistoricalEventRemoveHfHfLink, error) {
+	var obj = p.newHistoricalEventRemoveHfHfLink()
 
 	for {
 		t, n, err := p.Token()
@@ -37868,9 +40100,9 @@ func parseHistoricalEventRemoveHfHfLink(p *util.XMLParser) (*HistoricalEventRemo
 		}
 	}
 }
-func parseHistoricalEventRemoveHfHfLinkPlus(p *util.XMLParser, obj *HistoricalEventRemoveHfHfLink) (*HistoricalEventRemoveHfHfLink, error) {
+func parseHistoricalEventRemoveHfHfLinkPlus(p *parser, obj *HistoricalEventRemoveHfHfLink) (*HistoricalEventRemoveHfHfLink, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRemoveHfHfLink()
+		obj = p.newHistoricalEventRemoveHfHfLink()
 	}
 
 	for {
@@ -37892,8 +40124,8 @@ func parseHistoricalEventRemoveHfHfLinkPlus(p *util.XMLParser, obj *HistoricalEv
 		}
 	}
 }
-func parseHistoricalEventRemoveHfSiteLink(p *util.XMLParser) (*HistoricalEventRemoveHfSiteLink, error) {
-	var obj = NewHistoricalEventRemoveHfSiteLink()
+func parseHistoricalEventRemoveHfSiteLink(p *parser) (*HistoricalEventRemoveHfSiteLink, error) {
+	var obj = p.newHistoricalEventRemoveHfSiteLink()
 
 	for {
 		t, n, err := p.Token()
@@ -37920,9 +40152,9 @@ func parseHistoricalEventRemoveHfSiteLink(p *util.XMLParser) (*HistoricalEventRe
 		}
 	}
 }
-func parseHistoricalEventRemoveHfSiteLinkPlus(p *util.XMLParser, obj *HistoricalEventRemoveHfSiteLink) (*HistoricalEventRemoveHfSiteLink, error) {
+func parseHistoricalEventRemoveHfSiteLinkPlus(p *parser, obj *HistoricalEventRemoveHfSiteLink) (*HistoricalEventRemoveHfSiteLink, error) {
 	if obj == nil {
-		obj = NewHistoricalEventRemoveHfSiteLink()
+		obj = p.newHistoricalEventRemoveHfSiteLink()
 	}
 
 	for {
@@ -37974,8 +40206,8 @@ func parseHistoricalEventRemoveHfSiteLinkPlus(p *util.XMLParser, obj *Historical
 		}
 	}
 }
-func parseHistoricalEventReplacedStructure(p *util.XMLParser) (*HistoricalEventReplacedStructure, error) {
-	var obj = NewHistoricalEventReplacedStructure()
+func parseHistoricalEventReplacedStructure(p *parser) (*HistoricalEventReplacedStructure, error) {
+	var obj = p.newHistoricalEventReplacedStructure()
 
 	for {
 		t, n, err := p.Token()
@@ -38026,9 +40258,9 @@ func parseHistoricalEventReplacedStructure(p *util.XMLParser) (*HistoricalEventR
 		}
 	}
 }
-func parseHistoricalEventReplacedStructurePlus(p *util.XMLParser, obj *HistoricalEventReplacedStructure) (*HistoricalEventReplacedStructure, error) {
+func parseHistoricalEventReplacedStructurePlus(p *parser, obj *HistoricalEventReplacedStructure) (*HistoricalEventReplacedStructure, error) {
 	if obj == nil {
-		obj = NewHistoricalEventReplacedStructure()
+		obj = p.newHistoricalEventReplacedStructure()
 	}
 
 	for {
@@ -38080,8 +40312,8 @@ func parseHistoricalEventReplacedStructurePlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventSabotage(p *util.XMLParser) (*HistoricalEventSabotage, error) {
-	var obj = NewHistoricalEventSabotage()
+func parseHistoricalEventSabotage(p *parser) (*HistoricalEventSabotage, error) {
+	var obj = p.newHistoricalEventSabotage()
 
 	for {
 		t, n, err := p.Token()
@@ -38120,9 +40352,9 @@ func parseHistoricalEventSabotage(p *util.XMLParser) (*HistoricalEventSabotage,
 		}
 	}
 }
-func parseHistoricalEventSabotagePlus(p *util.XMLParser, obj *HistoricalEventSabotage) (*HistoricalEventSabotage, error) {
+func parseHistoricalEventSabotagePlus(p *parser, obj *HistoricalEventSabotage) (*HistoricalEventSabotage, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSabotage()
+		obj = p.newHistoricalEventSabotage()
 	}
 
 	for {
@@ -38144,8 +40376,8 @@ func parseHistoricalEventSabotagePlus(p *util.XMLParser, obj *HistoricalEventSab
 		}
 	}
 }
-func parseHistoricalEventSiteDied(p *util.XMLParser) (*HistoricalEventSiteDied, error) {
-	var obj = NewHistoricalEventSiteDied()
+func parseHistoricalEventSiteDied(p *parser) (*HistoricalEventSiteDied, error) {
+	var obj = p.newHistoricalEventSiteDied()
 
 	for {
 		t, n, err := p.Token()
@@ -38190,9 +40422,9 @@ func parseHistoricalEventSiteDied(p *util.XMLParser) (*HistoricalEventSiteDied,
 		}
 	}
 }
-func parseHistoricalEventSiteDiedPlus(p *util.XMLParser, obj *HistoricalEventSiteDied) (*HistoricalEventSiteDied, error) {
+func parseHistoricalEventSiteDiedPlus(p *parser, obj *HistoricalEventSiteDied) (*HistoricalEventSiteDied, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSiteDied()
+		obj = p.newHistoricalEventSiteDied()
 	}
 
 	for {
@@ -38214,8 +40446,8 @@ func parseHistoricalEventSiteDiedPlus(p *util.XMLParser, obj *HistoricalEventSit
 		}
 	}
 }
-func parseHistoricalEventSiteDispute(p *util.XMLParser) (*HistoricalEventSiteDispute, error) {
-	var obj = NewHistoricalEventSiteDispute()
+func parseHistoricalEventSiteDispute(p *parser) (*HistoricalEventSiteDispute, error) {
+	var obj = p.newHistoricalEventSiteDispute()
 
 	for {
 		t, n, err := p.Token()
@@ -38266,9 +40498,9 @@ func parseHistoricalEventSiteDispute(p *util.XMLParser) (*HistoricalEventSiteDis
 		}
 	}
 }
-func parseHistoricalEventSiteDisputePlus(p *util.XMLParser, obj *HistoricalEventSiteDispute) (*HistoricalEventSiteDispute, error) {
+func parseHistoricalEventSiteDisputePlus(p *parser, obj *HistoricalEventSiteDispute) (*HistoricalEventSiteDispute, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSiteDispute()
+		obj = p.newHistoricalEventSiteDispute()
 	}
 
 	for {
@@ -38290,8 +40522,8 @@ func parseHistoricalEventSiteDisputePlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventSiteRetired(p *util.XMLParser) (*HistoricalEventSiteRetired, error) {
-	var obj = NewHistoricalEventSiteRetired()
+func parseHistoricalEventSiteRetired(p *parser) (*HistoricalEventSiteRetired, error) {
+	var obj = p.newHistoricalEventSiteRetired()
 
 	for {
 		t, n, err := p.Token()
@@ -38336,9 +40568,9 @@ func parseHistoricalEventSiteRetired(p *util.XMLParser) (*HistoricalEventSiteRet
 		}
 	}
 }
-func parseHistoricalEventSiteRetiredPlus(p *util.XMLParser, obj *HistoricalEventSiteRetired) (*HistoricalEventSiteRetired, error) {
+func parseHistoricalEventSiteRetiredPlus(p *parser, obj *HistoricalEventSiteRetired) (*HistoricalEventSiteRetired, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSiteRetired()
+		obj = p.newHistoricalEventSiteRetired()
 	}
 
 	for {
@@ -38360,8 +40592,8 @@ func parseHistoricalEventSiteRetiredPlus(p *util.XMLParser, obj *HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventSiteSurrendered(p *util.XMLParser) (*HistoricalEventSiteSurrendered, error) {
-	var obj = NewHistoricalEventSiteSurrendered()
+func parseHistoricalEventSiteSurrendered(p *parser) (*HistoricalEventSiteSurrendered, error) {
+	var obj = p.newHistoricalEventSiteSurrendered()
 
 	for {
 		t, n, err := p.Token()
@@ -38406,9 +40638,9 @@ func parseHistoricalEventSiteSurrendered(p *util.XMLParser) (*HistoricalEventSit
 		}
 	}
 }
-func parseHistoricalEventSiteSurrenderedPlus(p *util.XMLParser, obj *HistoricalEventSiteSurrendered) (*HistoricalEventSiteSurrendered, error) {
+func parseHistoricalEventSiteSurrenderedPlus(p *parser, obj *HistoricalEventSiteSurrendered) (*HistoricalEventSiteSurrendered, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSiteSurrendered()
+		obj = p.newHistoricalEventSiteSurrendered()
 	}
 
 	for {
@@ -38430,8 +40662,8 @@ func parseHistoricalEventSiteSurrenderedPlus(p *util.XMLParser, obj *HistoricalE
 		}
 	}
 }
-func parseHistoricalEventSiteTakenOver(p *util.XMLParser) (*HistoricalEventSiteTakenOver, error) {
-	var obj = NewHistoricalEventSiteTakenOver()
+func parseHistoricalEventSiteTakenOver(p *parser) (*HistoricalEventSiteTakenOver, error) {
+	var obj = p.newHistoricalEventSiteTakenOver()
 
 	for {
 		t, n, err := p.Token()
@@ -38482,9 +40714,9 @@ func parseHistoricalEventSiteTakenOver(p *util.XMLParser) (*HistoricalEventSiteT
 		}
 	}
 }
-func parseHistoricalEventSiteTakenOverPlus(p *util.XMLParser, obj *HistoricalEventSiteTakenOver) (*HistoricalEventSiteTakenOver, error) {
+func parseHistoricalEventSiteTakenOverPlus(p *parser, obj *HistoricalEventSiteTakenOver) (*HistoricalEventSiteTakenOver, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSiteTakenOver()
+		obj = p.newHistoricalEventSiteTakenOver()
 	}
 
 	for {
@@ -38506,8 +40738,8 @@ func parseHistoricalEventSiteTakenOverPlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventSiteTributeForced(p *util.XMLParser) (*HistoricalEventSiteTributeForced, error) {
-	var obj = NewHistoricalEventSiteTributeForced()
+func parseHistoricalEventSiteTributeForced(p *parser) (*HistoricalEventSiteTributeForced, error) {
+	var obj = p.newHistoricalEventSiteTributeForced()
 
 	for {
 		t, n, err := p.Token()
@@ -38558,9 +40790,9 @@ func parseHistoricalEventSiteTributeForced(p *util.XMLParser) (*HistoricalEventS
 		}
 	}
 }
-func parseHistoricalEventSiteTributeForcedPlus(p *util.XMLParser, obj *HistoricalEventSiteTributeForced) (*HistoricalEventSiteTributeForced, error) {
+func parseHistoricalEventSiteTributeForcedPlus(p *parser, obj *HistoricalEventSiteTributeForced) (*HistoricalEventSiteTributeForced, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSiteTributeForced()
+		obj = p.newHistoricalEventSiteTributeForced()
 	}
 
 	for {
@@ -38582,8 +40814,8 @@ func parseHistoricalEventSiteTributeForcedPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventSneakIntoSite(p *util.XMLParser) (*HistoricalEventSneakIntoSite, error) {
-	var obj = NewHistoricalEventSneakIntoSite()
+func parseHistoricalEventSneakIntoSite(p *parser) (*HistoricalEventSneakIntoSite, error) {
+	var obj = p.newHistoricalEventSneakIntoSite()
 
 	for {
 		t, n, err := p.Token()
@@ -38628,9 +40860,9 @@ func parseHistoricalEventSneakIntoSite(p *util.XMLParser) (*HistoricalEventSneak
 		}
 	}
 }
-func parseHistoricalEventSneakIntoSitePlus(p *util.XMLParser, obj *HistoricalEventSneakIntoSite) (*HistoricalEventSneakIntoSite, error) {
+func parseHistoricalEventSneakIntoSitePlus(p *parser, obj *HistoricalEventSneakIntoSite) (*HistoricalEventSneakIntoSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSneakIntoSite()
+		obj = p.newHistoricalEventSneakIntoSite()
 	}
 
 	for {
@@ -38652,8 +40884,8 @@ func parseHistoricalEventSneakIntoSitePlus(p *util.XMLParser, obj *HistoricalEve
 		}
 	}
 }
-func parseHistoricalEventSpottedLeavingSite(p *util.XMLParser) (*HistoricalEventSpottedLeavingSite, error) {
-	var obj = NewHistoricalEventSpottedLeavingSite()
+func parseHistoricalEventSpottedLeavingSite(p *parser) (*HistoricalEventSpottedLeavingSite, error) {
+	var obj = p.newHistoricalEventSpottedLeavingSite()
 
 	for {
 		t, n, err := p.Token()
@@ -38698,9 +40930,9 @@ func parseHistoricalEventSpottedLeavingSite(p *util.XMLParser) (*HistoricalEvent
 		}
 	}
 }
-func parseHistoricalEventSpottedLeavingSitePlus(p *util.XMLParser, obj *HistoricalEventSpottedLeavingSite) (*HistoricalEventSpottedLeavingSite, error) {
+func parseHistoricalEventSpottedLeavingSitePlus(p *parser, obj *HistoricalEventSpottedLeavingSite) (*HistoricalEventSpottedLeavingSite, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSpottedLeavingSite()
+		obj = p.newHistoricalEventSpottedLeavingSite()
 	}
 
 	for {
@@ -38722,8 +40954,8 @@ func parseHistoricalEventSpottedLeavingSitePlus(p *util.XMLParser, obj *Historic
 		}
 	}
 }
-func parseHistoricalEventSquadVsSquad(p *util.XMLParser) (*HistoricalEventSquadVsSquad, error) {
-	var obj = NewHistoricalEventSquadVsSquad()
+func parseHistoricalEventSquadVsSquad(p *parser) (*HistoricalEventSquadVsSquad, error) {
+	var obj = p.newHistoricalEventSquadVsSquad()
 
 	for {
 		t, n, err := p.Token()
@@ -38846,9 +41078,9 @@ func parseHistoricalEventSquadVsSquad(p *util.XMLParser) (*HistoricalEventSquadV
 		}
 	}
 }
-func parseHistoricalEventSquadVsSquadPlus(p *util.XMLParser, obj *HistoricalEventSquadVsSquad) (*HistoricalEventSquadVsSquad, error) {
+func parseHistoricalEventSquadVsSquadPlus(p *parser, obj *HistoricalEventSquadVsSquad) (*HistoricalEventSquadVsSquad, error) {
 	if obj == nil {
-		obj = NewHistoricalEventSquadVsSquad()
+		obj = p.newHistoricalEventSquadVsSquad()
 	}
 
 	for {
@@ -38870,8 +41102,8 @@ func parseHistoricalEventSquadVsSquadPlus(p *util.XMLParser, obj *HistoricalEven
 		}
 	}
 }
-func parseHistoricalEventTacticalSituation(p *util.XMLParser) (*HistoricalEventTacticalSituation, error) {
-	var obj = NewHistoricalEventTacticalSituation()
+func parseHistoricalEventTacticalSituation(p *parser) (*HistoricalEventTacticalSituation, error) {
+	var obj = p.newHistoricalEventTacticalSituation()
 
 	for {
 		t, n, err := p.Token()
@@ -38952,9 +41184,9 @@ func parseHistoricalEventTacticalSituation(p *util.XMLParser) (*HistoricalEventT
 		}
 	}
 }
-func parseHistoricalEventTacticalSituationPlus(p *util.XMLParser, obj *HistoricalEventTacticalSituation) (*HistoricalEventTacticalSituation, error) {
+func parseHistoricalEventTacticalSituationPlus(p *parser, obj *HistoricalEventTacticalSituation) (*HistoricalEventTacticalSituation, error) {
 	if obj == nil {
-		obj = NewHistoricalEventTacticalSituation()
+		obj = p.newHistoricalEventTacticalSituation()
 	}
 
 	for {
@@ -38976,8 +41208,8 @@ func parseHistoricalEventTacticalSituationPlus(p *util.XMLParser, obj *Historica
 		}
 	}
 }
-func parseHistoricalEventTrade(p *util.XMLParser) (*HistoricalEventTrade, error) {
-	var obj = NewHistoricalEventTrade()
+func parseHistoricalEventTrade(p *parser) (*HistoricalEventTrade, error) {
+	var obj = p.newHistoricalEventTrade()
 
 	for {
 		t, n, err := p.Token()
@@ -39046,9 +41278,9 @@ func parseHistoricalEventTrade(p *util.XMLParser) (*HistoricalEventTrade, error)
 		}
 	}
 }
-func parseHistoricalEventTradePlus(p *util.XMLParser, obj *HistoricalEventTrade) (*HistoricalEventTrade, error) {
+func parseHistoricalEventTradePlus(p *parser, obj *HistoricalEventTrade) (*HistoricalEventTrade, error) {
 	if obj == nil {
-		obj = NewHistoricalEventTrade()
+		obj = p.newHistoricalEventTrade()
 	}
 
 	for {
@@ -39070,8 +41302,8 @@ func parseHistoricalEventTradePlus(p *util.XMLParser, obj *HistoricalEventTrade)
 		}
 	}
 }
-func parseHistoricalEventWrittenContentComposed(p *util.XMLParser) (*HistoricalEventWrittenContentComposed, error) {
-	var obj = NewHistoricalEventWrittenContentComposed()
+func parseHistoricalEventWrittenContentComposed(p *parser) (*HistoricalEventWrittenContentComposed, error) {
+	var obj = p.newHistoricalEventWrittenContentComposed()
 
 	for {
 		t, n, err := p.Token()
@@ -39140,9 +41372,9 @@ func parseHistoricalEventWrittenContentComposed(p *util.XMLParser) (*HistoricalE
 		}
 	}
 }
-func parseHistoricalEventWrittenContentComposedPlus(p *util.XMLParser, obj *HistoricalEventWrittenContentComposed) (*HistoricalEventWrittenContentComposed, error) {
+func parseHistoricalEventWrittenContentComposedPlus(p *parser, obj *HistoricalEventWrittenContentComposed) (*HistoricalEventWrittenContentComposed, error) {
 	if obj == nil {
-		obj = NewHistoricalEventWrittenContentComposed()
+		obj = p.newHistoricalEventWrittenContentComposed()
 	}
 
 	for {
@@ -39164,7 +41396,7 @@ func parseHistoricalEventWrittenContentComposedPlus(p *util.XMLParser, obj *Hist
 		}
 	}
 }
-func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
+func parseHistoricalFigure(p *parser) (*HistoricalFigure, error) {
 	var obj = NewHistoricalFigure()
 
 	for {
@@ -39180,7 +41412,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.ActiveInteraction = append(obj.ActiveInteraction, txt(data))
+				obj.ActiveInteraction = append(obj.ActiveInteraction, p.itxt(data))
 			case "adventurer":
 				_, err := p.Value()
 				if err != nil {
@@ -39198,7 +41430,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.AnimatedString = txt(data)
+				obj.AnimatedString = p.itxt(data)
 			case "appeared":
 				data, err := p.Value()
 				if err != nil {
@@ -39210,7 +41442,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.AssociatedType = txt(data)
+				obj.AssociatedType = p.itxt(data)
 			case "birth_seconds72":
 				data, err := p.Value()
 				if err != nil {
@@ -39234,7 +41466,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Caste = txt(data)
+				obj.Caste = p.itxt(data)
 			case "current_identity_id":
 				data, err := p.Value()
 				if err != nil {
@@ -39327,7 +41559,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.InteractionKnowledge = append(obj.InteractionKnowledge, txt(data))
+				obj.InteractionKnowledge = append(obj.InteractionKnowledge, p.itxt(data))
 			case "intrigue_actor":
 				v, _ := parseIntrigueActor(p)
 				obj.IntrigueActor = append(obj.IntrigueActor, v)
@@ -39339,7 +41571,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.JourneyPet = append(obj.JourneyPet, txt(data))
+				obj.JourneyPet = append(obj.JourneyPet, p.itxt(data))
 			case "name":
 				data, err := p.Value()
 				if err != nil {
@@ -39351,7 +41583,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Race = txt(data)
+				obj.Race = p.itxt(data)
 			case "relationship_profile_hf_historical":
 				v, _ := parseRelationshipProfileHfHistorical(p)
 				obj.RelationshipProfileHfHistorical = append(obj.RelationshipProfileHfHistorical, v)
@@ -39372,7 +41604,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Sphere = append(obj.Sphere, txt(data))
+				obj.Sphere = append(obj.Sphere, p.itxt(data))
 			case "used_identity_id":
 				data, err := p.Value()
 				if err != nil {
@@ -39393,7 +41625,7 @@ func parseHistoricalFigure(p *util.XMLParser) (*HistoricalFigure, error) {
 		}
 	}
 }
-func parseHistoricalFigurePlus(p *util.XMLParser, obj *HistoricalFigure) (*HistoricalFigure, error) {
+func parseHistoricalFigurePlus(p *parser, obj *HistoricalFigure) (*HistoricalFigure, error) {
 	if obj == nil {
 		obj = NewHistoricalFigure()
 	}
@@ -39417,7 +41649,7 @@ func parseHistoricalFigurePlus(p *util.XMLParser, obj *HistoricalFigure) (*Histo
 				if err != nil {
 					return nil, err
 				}
-				obj.Race = txt(data)
+				obj.Race = p.itxt(data)
 			case "sex":
 				data, err := p.Value()
 				if err != nil {
@@ -39435,7 +41667,7 @@ func parseHistoricalFigurePlus(p *util.XMLParser, obj *HistoricalFigure) (*Histo
 		}
 	}
 }
-func parseHistoricalFigureEntityLink(p *util.XMLParser) (*HistoricalFigureEntityLink, error) {
+func parseHistoricalFigureEntityLink(p *parser) (*HistoricalFigureEntityLink, error) {
 	var obj = NewHistoricalFigureEntityLink()
 
 	for {
@@ -39475,7 +41707,7 @@ func parseHistoricalFigureEntityLink(p *util.XMLParser) (*HistoricalFigureEntity
 		}
 	}
 }
-func parseHistoricalFigureEntityLinkPlus(p *util.XMLParser, obj *HistoricalFigureEntityLink) (*HistoricalFigureEntityLink, error) {
+func parseHistoricalFigureEntityLinkPlus(p *parser, obj *HistoricalFigureEntityLink) (*HistoricalFigureEntityLink, error) {
 	if obj == nil {
 		obj = NewHistoricalFigureEntityLink()
 	}
@@ -39499,7 +41731,7 @@ func parseHistoricalFigureEntityLinkPlus(p *util.XMLParser, obj *HistoricalFigur
 		}
 	}
 }
-func parseHistoricalFigureSiteProperty(p *util.XMLParser) (*HistoricalFigureSiteProperty, error) {
+func parseHistoricalFigureSiteProperty(p *parser) (*HistoricalFigureSiteProperty, error) {
 	var obj = NewHistoricalFigureSiteProperty()
 
 	for {
@@ -39533,7 +41765,7 @@ func parseHistoricalFigureSiteProperty(p *util.XMLParser) (*HistoricalFigureSite
 		}
 	}
 }
-func parseHistoricalFigureSitePropertyPlus(p *util.XMLParser, obj *HistoricalFigureSiteProperty) (*HistoricalFigureSiteProperty, error) {
+func parseHistoricalFigureSitePropertyPlus(p *parser, obj *HistoricalFigureSiteProperty) (*HistoricalFigureSiteProperty, error) {
 	if obj == nil {
 		obj = NewHistoricalFigureSiteProperty()
 	}
@@ -39557,7 +41789,7 @@ func parseHistoricalFigureSitePropertyPlus(p *util.XMLParser, obj *HistoricalFig
 		}
 	}
 }
-func parseHonor(p *util.XMLParser) (*Honor, error) {
+func parseHonor(p *parser) (*Honor, error) {
 	var obj = NewHonor()
 
 	for {
@@ -39651,7 +41883,7 @@ func parseHonor(p *util.XMLParser) (*Honor, error) {
 		}
 	}
 }
-func parseHonorPlus(p *util.XMLParser, obj *Honor) (*Honor, error) {
+func parseHonorPlus(p *parser, obj *Honor) (*Honor, error) {
 	if obj == nil {
 		obj = NewHonor()
 	}
@@ -39675,7 +41907,7 @@ func parseHonorPlus(p *util.XMLParser, obj *Honor) (*Honor, error) {
 		}
 	}
 }
-func parseHonorEntity(p *util.XMLParser) (*HonorEntity, error) {
+func parseHonorEntity(p *parser) (*HonorEntity, error) {
 	var obj = NewHonorEntity()
 
 	for {
@@ -39721,7 +41953,7 @@ func parseHonorEntity(p *util.XMLParser) (*HonorEntity, error) {
 		}
 	}
 }
-func parseHonorEntityPlus(p *util.XMLParser, obj *HonorEntity) (*HonorEntity, error) {
+func parseHonorEntityPlus(p *parser, obj *HonorEntity) (*HonorEntity, error) {
 	if obj == nil {
 		obj = NewHonorEntity()
 	}
@@ -39745,7 +41977,7 @@ func parseHonorEntityPlus(p *util.XMLParser, obj *HonorEntity) (*HonorEntity, er
 		}
 	}
 }
-func parseIdentity(p *util.XMLParser) (*Identity, error) {
+func parseIdentity(p *parser) (*Identity, error) {
 	var obj = NewIdentity()
 
 	for {
@@ -39767,7 +41999,7 @@ func parseIdentity(p *util.XMLParser) (*Identity, error) {
 		}
 	}
 }
-func parseIdentityPlus(p *util.XMLParser, obj *Identity) (*Identity, error) {
+func parseIdentityPlus(p *parser, obj *Identity) (*Identity, error) {
 	if obj == nil {
 		obj = NewIdentity()
 	}
@@ -39797,7 +42029,7 @@ func parseIdentityPlus(p *util.XMLParser, obj *Identity) (*Identity, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Caste = txt(data)
+				obj.Caste = p.itxt(data)
 			case "entity_id":
 				data, err := p.Value()
 				if err != nil {
@@ -39839,7 +42071,7 @@ func parseIdentityPlus(p *util.XMLParser, obj *Identity) (*Identity, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Race = txt(data)
+				obj.Race = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -39851,7 +42083,7 @@ func parseIdentityPlus(p *util.XMLParser, obj *Identity) (*Identity, error) {
 		}
 	}
 }
-func parseIntrigueActor(p *util.XMLParser) (*IntrigueActor, error) {
+func parseIntrigueActor(p *parser) (*IntrigueActor, error) {
 	var obj = NewIntrigueActor()
 
 	for {
@@ -39933,7 +42165,7 @@ func parseIntrigueActor(p *util.XMLParser) (*IntrigueActor, error) {
 		}
 	}
 }
-func parseIntrigueActorPlus(p *util.XMLParser, obj *IntrigueActor) (*IntrigueActor, error) {
+func parseIntrigueActorPlus(p *parser, obj *IntrigueActor) (*IntrigueActor, error) {
 	if obj == nil {
 		obj = NewIntrigueActor()
 	}
@@ -39957,7 +42189,7 @@ func parseIntrigueActorPlus(p *util.XMLParser, obj *IntrigueActor) (*IntrigueAct
 		}
 	}
 }
-func parseIntriguePlot(p *util.XMLParser) (*IntriguePlot, error) {
+func parseIntriguePlot(p *parser) (*IntriguePlot, error) {
 	var obj = NewIntriguePlot()
 
 	for {
@@ -40042,7 +42274,7 @@ func parseIntriguePlot(p *util.XMLParser) (*IntriguePlot, error) {
 		}
 	}
 }
-func parseIntriguePlotPlus(p *util.XMLParser, obj *IntriguePlot) (*IntriguePlot, error) {
+func parseIntriguePlotPlus(p *parser, obj *IntriguePlot) (*IntriguePlot, error) {
 	if obj == nil {
 		obj = NewIntriguePlot()
 	}
@@ -40066,7 +42298,7 @@ func parseIntriguePlotPlus(p *util.XMLParser, obj *IntriguePlot) (*IntriguePlot,
 		}
 	}
 }
-func parseItem(p *util.XMLParser) (*Item, error) {
+func parseItem(p *parser) (*Item, error) {
 	var obj = NewItem()
 
 	for {
@@ -40112,7 +42344,7 @@ func parseItem(p *util.XMLParser) (*Item, error) {
 		}
 	}
 }
-func parseItemPlus(p *util.XMLParser, obj *Item) (*Item, error) {
+func parseItemPlus(p *parser, obj *Item) (*Item, error) {
 	if obj == nil {
 		obj = NewItem()
 	}
@@ -40136,7 +42368,7 @@ func parseItemPlus(p *util.XMLParser, obj *Item) (*Item, error) {
 		}
 	}
 }
-func parseLandmass(p *util.XMLParser) (*Landmass, error) {
+func parseLandmass(p *parser) (*Landmass, error) {
 	var obj = NewLandmass()
 
 	for {
@@ -40158,7 +42390,7 @@ func parseLandmass(p *util.XMLParser) (*Landmass, error) {
 		}
 	}
 }
-func parseLandmassPlus(p *util.XMLParser, obj *Landmass) (*Landmass, error) {
+func parseLandmassPlus(p *parser, obj *Landmass) (*Landmass, error) {
 	if obj == nil {
 		obj = NewLandmass()
 	}
@@ -40176,13 +42408,13 @@ func parseLandmassPlus(p *util.XMLParser, obj *Landmass) (*Landmass, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Coord1 = txt(data)
+				obj.Coord1 = p.itxt(data)
 			case "coord_2":
 				data, err := p.Value()
 				if err != nil {
 					return nil, err
 				}
-				obj.Coord2 = txt(data)
+				obj.Coord2 = p.itxt(data)
 			case "id":
 				data, err := p.Value()
 				if err != nil {
@@ -40206,7 +42438,7 @@ func parseLandmassPlus(p *util.XMLParser, obj *Landmass) (*Landmass, error) {
 		}
 	}
 }
-func parseMountainPeak(p *util.XMLParser) (*MountainPeak, error) {
+func parseMountainPeak(p *parser) (*MountainPeak, error) {
 	var obj = NewMountainPeak()
 
 	for {
@@ -40228,7 +42460,7 @@ func parseMountainPeak(p *util.XMLParser) (*MountainPeak, error) {
 		}
 	}
 }
-func parseMountainPeakPlus(p *util.XMLParser, obj *MountainPeak) (*MountainPeak, error) {
+func parseMountainPeakPlus(p *parser, obj *MountainPeak) (*MountainPeak, error) {
 	if obj == nil {
 		obj = NewMountainPeak()
 	}
@@ -40247,6 +42479,7 @@ func parseMountainPeakPlus(p *util.XMLParser, obj *MountainPeak) (*MountainPeak,
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "height":
 				data, err := p.Value()
 				if err != nil {
@@ -40282,7 +42515,7 @@ func parseMountainPeakPlus(p *util.XMLParser, obj *MountainPeak) (*MountainPeak,
 		}
 	}
 }
-func parseMusicalForm(p *util.XMLParser) (*MusicalForm, error) {
+func parseMusicalForm(p *parser) (*MusicalForm, error) {
 	var obj = NewMusicalForm()
 
 	for {
@@ -40316,7 +42549,7 @@ func parseMusicalForm(p *util.XMLParser) (*MusicalForm, error) {
 		}
 	}
 }
-func parseMusicalFormPlus(p *util.XMLParser, obj *MusicalForm) (*MusicalForm, error) {
+func parseMusicalFormPlus(p *parser, obj *MusicalForm) (*MusicalForm, error) {
 	if obj == nil {
 		obj = NewMusicalForm()
 	}
@@ -40352,7 +42585,7 @@ func parseMusicalFormPlus(p *util.XMLParser, obj *MusicalForm) (*MusicalForm, er
 		}
 	}
 }
-func parseOccasion(p *util.XMLParser) (*Occasion, error) {
+func parseOccasion(p *parser) (*Occasion, error) {
 	var obj = NewOccasion()
 
 	for {
@@ -40374,7 +42607,7 @@ func parseOccasion(p *util.XMLParser) (*Occasion, error) {
 		}
 	}
 }
-func parseOccasionPlus(p *util.XMLParser, obj *Occasion) (*Occasion, error) {
+func parseOccasionPlus(p *parser, obj *Occasion) (*Occasion, error) {
 	if obj == nil {
 		obj = NewOccasion()
 	}
@@ -40419,7 +42652,7 @@ func parseOccasionPlus(p *util.XMLParser, obj *Occasion) (*Occasion, error) {
 		}
 	}
 }
-func parsePlotActor(p *util.XMLParser) (*PlotActor, error) {
+func parsePlotActor(p *parser) (*PlotActor, error) {
 	var obj = NewPlotActor()
 
 	for {
@@ -40465,7 +42698,7 @@ func parsePlotActor(p *util.XMLParser) (*PlotActor, error) {
 		}
 	}
 }
-func parsePlotActorPlus(p *util.XMLParser, obj *PlotActor) (*PlotActor, error) {
+func parsePlotActorPlus(p *parser, obj *PlotActor) (*PlotActor, error) {
 	if obj == nil {
 		obj = NewPlotActor()
 	}
@@ -40489,7 +42722,7 @@ func parsePlotActorPlus(p *util.XMLParser, obj *PlotActor) (*PlotActor, error) {
 		}
 	}
 }
-func parsePoeticForm(p *util.XMLParser) (*PoeticForm, error) {
+func parsePoeticForm(p *parser) (*PoeticForm, error) {
 	var obj = NewPoeticForm()
 
 	for {
@@ -40523,7 +42756,7 @@ func parsePoeticForm(p *util.XMLParser) (*PoeticForm, error) {
 		}
 	}
 }
-func parsePoeticFormPlus(p *util.XMLParser, obj *PoeticForm) (*PoeticForm, error) {
+func parsePoeticFormPlus(p *parser, obj *PoeticForm) (*PoeticForm, error) {
 	if obj == nil {
 		obj = NewPoeticForm()
 	}
@@ -40559,7 +42792,7 @@ func parsePoeticFormPlus(p *util.XMLParser, obj *PoeticForm) (*PoeticForm, error
 		}
 	}
 }
-func parseReference(p *util.XMLParser) (*Reference, error) {
+func parseReference(p *parser) (*Reference, error) {
 	var obj = NewReference()
 
 	for {
@@ -40581,7 +42814,7 @@ func parseReference(p *util.XMLParser) (*Reference, error) {
 		}
 	}
 }
-func parseReferencePlus(p *util.XMLParser, obj *Reference) (*Reference, error) {
+func parseReferencePlus(p *parser, obj *Reference) (*Reference, error) {
 	if obj == nil {
 		obj = NewReference()
 	}
@@ -40617,7 +42850,7 @@ func parseReferencePlus(p *util.XMLParser, obj *Reference) (*Reference, error) {
 		}
 	}
 }
-func parseRegion(p *util.XMLParser) (*Region, error) {
+func parseRegion(p *parser) (*Region, error) {
 	var obj = NewRegion()
 
 	for {
@@ -40657,7 +42890,7 @@ func parseRegion(p *util.XMLParser) (*Region, error) {
 		}
 	}
 }
-func parseRegionPlus(p *util.XMLParser, obj *Region) (*Region, error) {
+func parseRegionPlus(p *parser, obj *Region) (*Region, error) {
 	if obj == nil {
 		obj = NewRegion()
 	}
@@ -40676,6 +42909,7 @@ func parseRegionPlus(p *util.XMLParser, obj *Region) (*Region, error) {
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "evilness":
 				data, err := p.Value()
 				if err != nil {
@@ -40705,7 +42939,7 @@ func parseRegionPlus(p *util.XMLParser, obj *Region) (*Region, error) {
 		}
 	}
 }
-func parseRelationshipProfileHfHistorical(p *util.XMLParser) (*RelationshipProfileHfHistorical, error) {
+func parseRelationshipProfileHfHistorical(p *parser) (*RelationshipProfileHfHistorical, error) {
 	var obj = NewRelationshipProfileHfHistorical()
 
 	for {
@@ -40805,7 +43039,7 @@ func parseRelationshipProfileHfHistorical(p *util.XMLParser) (*RelationshipProfi
 		}
 	}
 }
-func parseRelationshipProfileHfHistoricalPlus(p *util.XMLParser, obj *RelationshipProfileHfHistorical) (*RelationshipProfileHfHistorical, error) {
+func parseRelationshipProfileHfHistoricalPlus(p *parser, obj *RelationshipProfileHfHistorical) (*RelationshipProfileHfHistorical, error) {
 	if obj == nil {
 		obj = NewRelationshipProfileHfHistorical()
 	}
@@ -40829,7 +43063,7 @@ func parseRelationshipProfileHfHistoricalPlus(p *util.XMLParser, obj *Relationsh
 		}
 	}
 }
-func parseRelationshipProfileHfIdentity(p *util.XMLParser) (*RelationshipProfileHfIdentity, error) {
+func parseRelationshipProfileHfIdentity(p *parser) (*RelationshipProfileHfIdentity, error) {
 	var obj = NewRelationshipProfileHfIdentity()
 
 	for {
@@ -40893,7 +43127,7 @@ func parseRelationshipProfileHfIdentity(p *util.XMLParser) (*RelationshipProfile
 		}
 	}
 }
-func parseRelationshipProfileHfIdentityPlus(p *util.XMLParser, obj *RelationshipProfileHfIdentity) (*RelationshipProfileHfIdentity, error) {
+func parseRelationshipProfileHfIdentityPlus(p *parser, obj *RelationshipProfileHfIdentity) (*RelationshipProfileHfIdentity, error) {
 	if obj == nil {
 		obj = NewRelationshipProfileHfIdentity()
 	}
@@ -40917,7 +43151,7 @@ func parseRelationshipProfileHfIdentityPlus(p *util.XMLParser, obj *Relationship
 		}
 	}
 }
-func parseRelationshipProfileHfVisual(p *util.XMLParser) (*RelationshipProfileHfVisual, error) {
+func parseRelationshipProfileHfVisual(p *parser) (*RelationshipProfileHfVisual, error) {
 	var obj = NewRelationshipProfileHfVisual()
 
 	for {
@@ -41065,7 +43299,7 @@ func parseRelationshipProfileHfVisual(p *util.XMLParser) (*RelationshipProfileHf
 		}
 	}
 }
-func parseRelationshipProfileHfVisualPlus(p *util.XMLParser, obj *RelationshipProfileHfVisual) (*RelationshipProfileHfVisual, error) {
+func parseRelationshipProfileHfVisualPlus(p *parser, obj *RelationshipProfileHfVisual) (*RelationshipProfileHfVisual, error) {
 	if obj == nil {
 		obj = NewRelationshipProfileHfVisual()
 	}
@@ -41089,7 +43323,7 @@ func parseRelationshipProfileHfVisualPlus(p *util.XMLParser, obj *RelationshipPr
 		}
 	}
 }
-func parseRiver(p *util.XMLParser) (*River, error) {
+func parseRiver(p *parser) (*River, error) {
 	var obj = NewRiver()
 
 	for {
@@ -41111,7 +43345,7 @@ func parseRiver(p *util.XMLParser) (*River, error) {
 		}
 	}
 }
-func parseRiverPlus(p *util.XMLParser, obj *River) (*River, error) {
+func parseRiverPlus(p *parser, obj *River) (*River, error) {
 	if obj == nil {
 		obj = NewRiver()
 	}
@@ -41129,7 +43363,7 @@ func parseRiverPlus(p *util.XMLParser, obj *River) (*River, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.EndPos = txt(data)
+				obj.EndPos = p.itxt(data)
 			case "name":
 				data, err := p.Value()
 				if err != nil {
@@ -41141,7 +43375,7 @@ func parseRiverPlus(p *util.XMLParser, obj *River) (*River, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Path = txt(data)
+				obj.Path = p.itxt(data)
 			default:
 				// fmt.Println("unknown field", n)
 				p.Skip()
@@ -41153,7 +43387,7 @@ func parseRiverPlus(p *util.XMLParser, obj *River) (*River, error) {
 		}
 	}
 }
-func parseSchedule(p *util.XMLParser) (*Schedule, error) {
+func parseSchedule(p *parser) (*Schedule, error) {
 	var obj = NewSchedule()
 
 	for {
@@ -41175,7 +43409,7 @@ func parseSchedule(p *util.XMLParser) (*Schedule, error) {
 		}
 	}
 }
-func parseSchedulePlus(p *util.XMLParser, obj *Schedule) (*Schedule, error) {
+func parseSchedulePlus(p *parser, obj *Schedule) (*Schedule, error) {
 	if obj == nil {
 		obj = NewSchedule()
 	}
@@ -41238,7 +43472,7 @@ func parseSchedulePlus(p *util.XMLParser, obj *Schedule) (*Schedule, error) {
 		}
 	}
 }
-func parseSite(p *util.XMLParser) (*Site, error) {
+func parseSite(p *parser) (*Site, error) {
 	var obj = NewSite()
 
 	for {
@@ -41255,6 +43489,7 @@ func parseSite(p *util.XMLParser) (*Site, error) {
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "id":
 				data, err := p.Value()
 				if err != nil {
@@ -41294,7 +43529,7 @@ func parseSite(p *util.XMLParser) (*Site, error) {
 		}
 	}
 }
-func parseSitePlus(p *util.XMLParser, obj *Site) (*Site, error) {
+func parseSitePlus(p *parser, obj *Site) (*Site, error) {
 	if obj == nil {
 		obj = NewSite()
 	}
@@ -41338,7 +43573,7 @@ func parseSitePlus(p *util.XMLParser, obj *Site) (*Site, error) {
 		}
 	}
 }
-func parseSiteLink(p *util.XMLParser) (*SiteLink, error) {
+func parseSiteLink(p *parser) (*SiteLink, error) {
 	var obj = NewSiteLink()
 
 	for {
@@ -41390,7 +43625,7 @@ func parseSiteLink(p *util.XMLParser) (*SiteLink, error) {
 		}
 	}
 }
-func parseSiteLinkPlus(p *util.XMLParser, obj *SiteLink) (*SiteLink, error) {
+func parseSiteLinkPlus(p *parser, obj *SiteLink) (*SiteLink, error) {
 	if obj == nil {
 		obj = NewSiteLink()
 	}
@@ -41414,7 +43649,7 @@ func parseSiteLinkPlus(p *util.XMLParser, obj *SiteLink) (*SiteLink, error) {
 		}
 	}
 }
-func parseSiteSiteProperty(p *util.XMLParser) (*SiteSiteProperty, error) {
+func parseSiteSiteProperty(p *parser) (*SiteSiteProperty, error) {
 	var obj = NewSiteSiteProperty()
 
 	for {
@@ -41460,7 +43695,7 @@ func parseSiteSiteProperty(p *util.XMLParser) (*SiteSiteProperty, error) {
 		}
 	}
 }
-func parseSiteSitePropertyPlus(p *util.XMLParser, obj *SiteSiteProperty) (*SiteSiteProperty, error) {
+func parseSiteSitePropertyPlus(p *parser, obj *SiteSiteProperty) (*SiteSiteProperty, error) {
 	if obj == nil {
 		obj = NewSiteSiteProperty()
 	}
@@ -41484,7 +43719,7 @@ func parseSiteSitePropertyPlus(p *util.XMLParser, obj *SiteSiteProperty) (*SiteS
 		}
 	}
 }
-func parseStructure(p *util.XMLParser) (*Structure, error) {
+func parseStructure(p *parser) (*Structure, error) {
 	var obj = NewStructure()
 
 	for {
@@ -41548,7 +43783,7 @@ func parseStructure(p *util.XMLParser) (*Structure, error) {
 		}
 	}
 }
-func parseStructurePlus(p *util.XMLParser, obj *Structure) (*Structure, error) {
+func parseStructurePlus(p *parser, obj *Structure) (*Structure, error) {
 	if obj == nil {
 		obj = NewStructure()
 	}
@@ -41626,7 +43861,7 @@ func parseStructurePlus(p *util.XMLParser, obj *Structure) (*Structure, error) {
 		}
 	}
 }
-func parseUndergroundRegion(p *util.XMLParser) (*UndergroundRegion, error) {
+func parseUndergroundRegion(p *parser) (*UndergroundRegion, error) {
 	var obj = NewUndergroundRegion()
 
 	for {
@@ -41666,7 +43901,7 @@ func parseUndergroundRegion(p *util.XMLParser) (*UndergroundRegion, error) {
 		}
 	}
 }
-func parseUndergroundRegionPlus(p *util.XMLParser, obj *UndergroundRegion) (*UndergroundRegion, error) {
+func parseUndergroundRegionPlus(p *parser, obj *UndergroundRegion) (*UndergroundRegion, error) {
 	if obj == nil {
 		obj = NewUndergroundRegion()
 	}
@@ -41685,6 +43920,7 @@ func parseUndergroundRegionPlus(p *util.XMLParser, obj *UndergroundRegion) (*Und
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "id":
 				data, err := p.Value()
 				if err != nil {
@@ -41702,7 +43938,7 @@ func parseUndergroundRegionPlus(p *util.XMLParser, obj *UndergroundRegion) (*Und
 		}
 	}
 }
-func parseVagueRelationship(p *util.XMLParser) (*VagueRelationship, error) {
+func parseVagueRelationship(p *parser) (*VagueRelationship, error) {
 	var obj = NewVagueRelationship()
 
 	for {
@@ -41808,7 +44044,7 @@ func parseVagueRelationship(p *util.XMLParser) (*VagueRelationship, error) {
 		}
 	}
 }
-func parseVagueRelationshipPlus(p *util.XMLParser, obj *VagueRelationship) (*VagueRelationship, error) {
+func parseVagueRelationshipPlus(p *parser, obj *VagueRelationship) (*VagueRelationship, error) {
 	if obj == nil {
 		obj = NewVagueRelationship()
 	}
@@ -41832,7 +44068,7 @@ func parseVagueRelationshipPlus(p *util.XMLParser, obj *VagueRelationship) (*Vag
 		}
 	}
 }
-func parseWorldConstruction(p *util.XMLParser) (*WorldConstruction, error) {
+func parseWorldConstruction(p *parser) (*WorldConstruction, error) {
 	var obj = NewWorldConstruction()
 
 	for {
@@ -41854,7 +44090,7 @@ func parseWorldConstruction(p *util.XMLParser) (*WorldConstruction, error) {
 		}
 	}
 }
-func parseWorldConstructionPlus(p *util.XMLParser, obj *WorldConstruction) (*WorldConstruction, error) {
+func parseWorldConstructionPlus(p *parser, obj *WorldConstruction) (*WorldConstruction, error) {
 	if obj == nil {
 		obj = NewWorldConstruction()
 	}
@@ -41873,6 +44109,7 @@ func parseWorldConstructionPlus(p *util.XMLParser, obj *WorldConstruction) (*Wor
 					return nil, err
 				}
 				obj.Coords = txt(data)
+				obj.coords = parseCoords(obj.Coords)
 			case "id":
 				data, err := p.Value()
 				if err != nil {
@@ -41902,7 +44139,7 @@ func parseWorldConstructionPlus(p *util.XMLParser, obj *WorldConstruction) (*Wor
 		}
 	}
 }
-func parseWrittenContent(p *util.XMLParser) (*WrittenContent, error) {
+func parseWrittenContent(p *parser) (*WrittenContent, error) {
 	var obj = NewWrittenContent()
 
 	for {
@@ -41948,7 +44185,7 @@ func parseWrittenContent(p *util.XMLParser) (*WrittenContent, error) {
 				if err != nil {
 					return nil, err
 				}
-				obj.Style = append(obj.Style, txt(data))
+				obj.Style = append(obj.Style, p.itxt(data))
 			case "title":
 				data, err := p.Value()
 				if err != nil {
@@ -41966,7 +44203,7 @@ func parseWrittenContent(p *util.XMLParser) (*WrittenContent, error) {
 		}
 	}
 }
-func parseWrittenContentPlus(p *util.XMLParser, obj *WrittenContent) (*WrittenContent, error) {
+func parseWrittenContentPlus(p *parser, obj *WrittenContent) (*WrittenContent, error) {
 	if obj == nil {
 		obj = NewWrittenContent()
 	}
@@ -42011,7 +44248,7 @@ func parseWrittenContentPlus(p *util.XMLParser, obj *WrittenContent) (*WrittenCo
 				if err != nil {
 					return nil, err
 				}
-				obj.Style = append(obj.Style, txt(data))
+				obj.Style = append(obj.Style, p.itxt(data))
 			case "title":
 				data, err := p.Value()
 				if err != nil {
@@ -0,0 +1,191 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+	"golang.org/x/exp/slices"
+)
+
+type Pantheon struct {
+	Entity  *Entity
+	Deities []*PantheonDeity
+	Events  []*HistoricalEvent
+}
+
+type PantheonDeity struct {
+	Hf          *HistoricalFigure
+	Worshippers []int
+	Followers   int
+	Temples     []*Structure
+	Priests     []*PantheonPriest
+}
+
+type PantheonPriest struct {
+	Hfid       int
+	EntityId   int
+	PositionId int
+}
+
+// NewPantheon collects the deities of the world or, if entity is not nil,
+// the deities worshipped by the entity and its religions.
+func NewPantheon(w *DfWorld, entity *Entity) *Pantheon {
+	p := &Pantheon{Entity: entity}
+
+	// entities whose worship counts for this pantheon
+	religions := make(map[int]bool)
+	if entity != nil {
+		religions[entity.Id_] = true
+		for _, l := range entity.EntityLink {
+			if l.Type_ == EntityEntityLinkType_RELIGIOUS {
+				religions[l.Target] = true
+			}
+		}
+	}
+
+	deities := make(map[int]*PantheonDeity)
+	deity := func(id int) *PantheonDeity {
+		if d, ok := deities[id]; ok {
+			return d
+		}
+		if hf, ok := w.Hf(id); ok {
+			d := &PantheonDeity{Hf: hf}
+			deities[id] = d
+			return d
+		}
+		return nil
+	}
+
+	if entity == nil {
+		w.EachHf(func(hf *HistoricalFigure) {
+			if hf.Deity {
+				deity(hf.Id_)
+			}
+		})
+	}
+	for _, e := range w.Entities {
+		if entity == nil || religions[e.Id_] {
+			for _, id := range e.WorshipId {
+				deity(id)
+			}
+		}
+	}
+	for _, e := range w.Entities {
+		for _, id := range e.WorshipId {
+			if d, ok := deities[id]; ok {
+				d.Worshippers = append(d.Worshippers, e.Id_)
+			}
+		}
+	}
+
+	w.EachHf(func(hf *HistoricalFigure) {
+		for _, l := range hf.HfLink {
+			if l.LinkType == HfLinkLinkType_Deity {
+				if d, ok := deities[l.Hfid]; ok {
+					d.Followers++
+				}
+			}
+		}
+		for _, l := range hf.EntityPositionLink {
+			if e, ok := w.Entities[l.EntityId]; ok && e.Type_ == EntityType_Religion {
+				for _, id := range e.WorshipId {
+					if d, ok := deities[id]; ok {
+						d.Priests = append(d.Priests, &PantheonPriest{Hfid: hf.Id_, EntityId: e.Id_, PositionId: l.PositionProfileId})
+					}
+				}
+			}
+		}
+	})
+
+	temples := make(map[*Structure]bool)
+	for _, site := range w.Sites {
+		if entity != nil && !slices.Contains(entity.Sites, site.Id_) {
+			continue
+		}
+		for _, s := range site.Structures {
+			if s.Type_ != StructureType_Temple {
+				continue
+			}
+			for _, id := range templeDeities(w, s) {
+				if d, ok := deities[id]; ok {
+					d.Temples = append(d.Temples, s)
+					temples[s] = true
+				}
+			}
+		}
+	}
+
+	for _, d := range deities {
+		sort.Ints(d.Worshippers)
+		sort.Slice(d.Temples, func(i, j int) bool {
+			a, b := d.Temples[i], d.Temples[j]
+			return a.SiteId < b.SiteId || (a.SiteId == b.SiteId && a.Id_ < b.Id_)
+		})
+		sort.Slice(d.Priests, func(i, j int) bool { return d.Priests[i].Hfid < d.Priests[j].Hfid })
+		p.Deities = append(p.Deities, d)
+	}
+	sort.Slice(p.Deities, func(i, j int) bool {
+		a, b := p.Deities[i], p.Deities[j]
+		if a.Followers != b.Followers {
+			return a.Followers > b.Followers
+		}
+		return a.Hf.Id_ < b.Hf.Id_
+	})
+
+	temple := func(siteId, structureId int) bool {
+		if site, ok := w.Sites[siteId]; ok {
+			if s, ok := site.Structures[structureId]; ok {
+				return temples[s]
+			}
+		}
+		return false
+	}
+	religion := func(id int) bool {
+		if entity != nil {
+			return religions[id]
+		}
+		e, ok := w.Entities[id]
+		return ok && e.Type_ == EntityType_Religion
+	}
+	p.Events = w.EventsMatching(func(d HistoricalEventDetails) bool {
+		switch x := d.(type) {
+		case *HistoricalEventHfPrayedInsideStructure:
+			return temple(x.SiteId, x.StructureId)
+		case *HistoricalEventHfProfanedStructure:
+			return temple(x.SiteId, x.StructureId)
+		case *HistoricalEventHfPreach:
+			return religion(x.Entity1) || religion(x.Entity2)
+		case *HistoricalEventHolyCityDeclaration:
+			return religion(x.ReligionId)
+		case *HistoricalEventCreatedStructure:
+			return temple(x.SiteId, x.StructureId)
+		}
+		return false
+	})
+
+	return p
+}
+
+func templeDeities(w *DfWorld, s *Structure) []int {
+	var ids []int
+	for _, id := range []int{s.Deity, s.WorshipHfid} {
+		if id != -1 && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if e, ok := w.Entities[s.Religion]; ok {
+		for _, id := range e.WorshipId {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+func (p *Pantheon) Title() string {
+	if p.Entity != nil {
+		return "Pantheon of " + util.Title(p.Entity.Name())
+	}
+	return "Pantheon"
+}
@@ -4,10 +4,13 @@ import (
 	"bufio"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/cheggaaa/pb/v3"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
@@ -25,14 +28,14 @@ func NewLegendsDecoder(file string) (*xml.Decoder, *os.File, *pb.ProgressBar, er
 
 	xmlFile, err := os.Open(file)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 	}
 
-	fmt.Println("Loading:", file)
+	fmt.Fprintln(Log, "Loading:", file)
 
-	converter := util.NewConvertReader(xmlFile)
-	barReader := bar.NewProxyReader(converter)
-	d := xml.NewDecoder(barReader)
+	barReader := bar.NewProxyReader(xmlFile)
+	converter := util.NewConvertReader(barReader)
+	d := xml.NewDecoder(converter)
 
 	return d, xmlFile, bar, err
 }
@@ -47,10 +50,10 @@ func NewLegendsParser(file string) (*util.XMLParser, *os.File, *pb.ProgressBar,
 
 	xmlFile, err := os.Open(file)
 	if err != nil {
-		fmt.Println(err)
+		fmt.Fprintln(Log, err)
 	}
 
-	fmt.Println("\nLoading:", file)
+	fmt.Fprintln(Log, "\nLoading:", file)
 
 	barReader := bar.NewProxyReader(xmlFile)
 	d := util.NewXMLParser(bufio.NewReader(barReader))
@@ -58,87 +61,139 @@ func NewLegendsParser(file string) (*util.XMLParser, *os.File, *pb.ProgressBar,
 	return d, xmlFile, bar, err
 }
 
+// Log receives the messages and timings of loading a world.
+var Log io.Writer = os.Stdout
+
+// LoadProgress is the file being loaded by Parse, read while it runs.
 type LoadProgress struct {
-	Message     string
-	ProgressBar *pb.ProgressBar
+	lock    sync.Mutex
+	message string
+	bar     *pb.ProgressBar
 }
 
-func Parse(file string, lp *LoadProgress) (*DfWorld, error) {
-	InitSameFields()
+func (lp *LoadProgress) set(message string, bar *pb.ProgressBar) {
+	if lp == nil {
+		return
+	}
+	lp.lock.Lock()
+	lp.message, lp.bar = message, bar
+	lp.lock.Unlock()
+}
 
-	p, xmlFile, bar, err := NewLegendsParser(file)
-	if lp != nil {
-		lp.Message = "Loading " + file
-		lp.ProgressBar = bar
+// Current returns the message and the percentage of the file being loaded.
+func (lp *LoadProgress) Current() (string, float64) {
+	lp.lock.Lock()
+	defer lp.lock.Unlock()
+	percent := 0.0
+	if lp.bar != nil && lp.bar.Total() > 0 {
+		percent = float64(lp.bar.Current()*100) / float64(lp.bar.Total())
 	}
+	return lp.message, percent
+}
+
+// Parse loads a world. With more than one cpu the legends files are split
+// into chunks that are parsed concurrently, the first chunks of the plus file
+// are split while the base file is parsed and the map is loaded in the
+// background. With StoreDir set the events and figures are moved to a store
+// chunk by chunk. Worlds can be loaded at the same time, as the strings and
+// arenas of a world belong to its parse.
+func Parse(file string, lp *LoadProgress) (world *DfWorld, err error) {
+	sameFieldsOnce.Do(InitSameFields)
+	l := newLoading()
+	timings := &Timings{}
+	total := timings.Track("total")
+	parallel := runtime.GOMAXPROCS(0) > 1
+
+	var store *Store
+	if StoreDir != "" {
+		if store, err = NewStore(StoreDir); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				store.Close()
+			}
+		}()
+		// the store is filled by the chunks
+		parallel = true
+	}
+
+	p, xmlFile, bar, err := NewLegendsParser(file)
+	lp.set("Loading "+file, bar)
 	if err != nil {
 		return nil, err
 	}
 	defer xmlFile.Close()
 
-	var world *DfWorld
-
-BaseLoop:
-	for {
-		t, n, err := p.Token()
+	plusFile := strings.Replace(file, "-legends.xml", "-legends_plus.xml", 1)
+	_, err = os.Stat(plusFile)
+	plus := err == nil
+	if !plus {
+		fmt.Fprintln(Log, "\nno legends_plus.xml found")
+	}
+	var split *plusSplit
+	if plus && parallel {
+		split, err = splitPlus(plusFile)
 		if err != nil {
 			return nil, err
 		}
-		switch t {
-		case util.StartElement:
-			if n == "df_world" {
-				world, err = parseDfWorld(p)
-				if err != nil {
-					return nil, err
-				}
-				break BaseLoop
-			}
+	}
+
+	done := timings.Track("parse legends")
+	if parallel {
+		chunks := make(chan *chunk, runtime.GOMAXPROCS(0))
+		splitErr := make(chan error, 1)
+		go func() { splitErr <- splitWorld(p, chunks) }()
+		world, err = parseChunks(chunks, store, l)
+		if err == nil {
+			err = <-splitErr
 		}
+	} else {
+		world, err = parseWorld(l.parser(p), nil)
+	}
+	if err != nil {
+		if split != nil {
+			split.discard()
+		}
+		return nil, err
 	}
 	world.FilePath = file
-
 	bar.Finish()
+	done()
 
-	plus := false
-	file = strings.Replace(file, "-legends.xml", "-legends_plus.xml", 1)
-	if _, err := os.Stat(file); err == nil {
-		plus = true
-	} else {
-		fmt.Println("\nno legends_plus.xml found")
-	}
+	mapLoaded := make(chan struct{})
+	go func() {
+		defer close(mapLoaded)
+		defer timings.Track("load map")()
+		world.LoadMap()
+	}()
 
 	if plus {
-		p, xmlFile, bar, err = NewLegendsParser(file)
-		if lp != nil {
-			lp.Message = "Loading " + file
-			lp.ProgressBar = bar
-		}
-		if err != nil {
-			return nil, err
-		}
-		defer xmlFile.Close()
-
-	PlusLoop:
-		for {
-			t, n, err := p.Token()
+		done := timings.Track("parse plus")
+		if parallel {
+			lp.set("Loading "+plusFile, split.bar)
+			split.bar.Start()
+			err = parsePlusChunks(world, split.chunks, l)
+			if splitErr := <-split.err; err == nil {
+				err = splitErr
+			}
+			split.bar.Finish()
+		} else {
+			p, xmlFile, bar, err := NewLegendsParser(plusFile)
+			lp.set("Loading "+plusFile, bar)
 			if err != nil {
 				return nil, err
 			}
-			switch t {
-			case util.StartElement:
-				if n == "df_world" {
-					world, err = parseDfWorldPlus(p, world)
-					if err != nil {
-						return nil, err
-					}
-					break PlusLoop
-				}
-			}
+			defer xmlFile.Close()
+			_, err = parseWorld(l.parser(p), world)
+			bar.Finish()
+		}
+		if err != nil {
+			return nil, err
 		}
 		world.Plus = true
-		world.PlusFilePath = file
-
-		bar.Finish()
+		world.PlusFilePath = plusFile
+		done()
 	}
 
 	// same, err := json.MarshalIndent(exportSameFields(), "", "  ")
@@ -147,15 +202,89 @@ BaseLoop:
 	// }
 	// ioutil.WriteFile("same.json", same, 0644)
 
-	world.LoadMap()
+	done = timings.Track("load history")
 	world.LoadHistory()
+	done()
+	<-mapLoaded
 
-	world.process()
+	world.process(timings)
 
+	if store != nil {
+		done := timings.Track("index store")
+		if err := world.finishStore(); err != nil {
+			return nil, err
+		}
+		done()
+	}
+	world.InternedStrings = l.interned.Len()
+	total()
+
+	fmt.Fprint(Log, "\n", timings)
 	return world, nil
 }
 
-func parseArray[T any](p *util.XMLParser, dest *[]T, creator func(*util.XMLParser) (T, error)) {
+// parseWorld parses a legends file, or a legends_plus file into plus if not
+// nil.
+func parseWorld(p *parser, plus *DfWorld) (*DfWorld, error) {
+	for {
+		t, n, err := p.Token()
+		if err != nil {
+			return nil, err
+		}
+		if t == util.StartElement && n == "df_world" {
+			if plus != nil {
+				return parseDfWorldPlus(p, plus)
+			}
+			return parseDfWorld(p)
+		}
+	}
+}
+
+// plusSplit is a legends_plus file being split into chunks.
+type plusSplit struct {
+	chunks chan *chunk
+	bar    *pb.ProgressBar
+	err    chan error
+}
+
+// splitPlus starts splitting a legends_plus file. Only a few chunks are
+// buffered, the rest of the file is read while they are parsed.
+func splitPlus(file string) (*plusSplit, error) {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	xmlFile, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(Log, "\nLoading:", file)
+
+	// not started, the bar of the base file is shown
+	bar := pb.New64(fi.Size())
+	p := util.NewXMLParser(bufio.NewReader(bar.NewProxyReader(xmlFile)))
+	split := &plusSplit{
+		chunks: make(chan *chunk, runtime.GOMAXPROCS(0)),
+		bar:    bar,
+		err:    make(chan error, 1),
+	}
+	go func() {
+		defer xmlFile.Close()
+		split.err <- splitWorld(p, split.chunks)
+	}()
+	return split, nil
+}
+
+// discard drops the remaining chunks, so the file is closed.
+func (s *plusSplit) discard() {
+	go func() {
+		for range s.chunks {
+		}
+	}()
+}
+
+func parseArray[T any](p *parser, dest *[]T, creator func(*parser) (T, error)) {
 	for {
 		t, _, err := p.Token()
 		if err != nil {
@@ -172,7 +301,7 @@ func parseArray[T any](p *util.XMLParser, dest *[]T, creator func(*util.XMLParse
 	}
 }
 
-func parseArrayPlus[T any](p *util.XMLParser, dest *[]T, creator func(*util.XMLParser, T) (T, error)) {
+func parseArrayPlus[T any](p *parser, dest *[]T, creator func(*parser, T) (T, error)) {
 	for {
 		t, _, err := p.Token()
 		if err != nil {
@@ -190,7 +319,7 @@ func parseArrayPlus[T any](p *util.XMLParser, dest *[]T, creator func(*util.XMLP
 	}
 }
 
-func parseMap[T Identifiable](p *util.XMLParser, dest *map[int]T, creator func(*util.XMLParser) (T, error)) {
+func parseMap[T Identifiable](p *parser, dest *map[int]T, creator func(*parser) (T, error)) {
 	for {
 		t, _, err := p.Token()
 		if err != nil {
@@ -207,7 +336,7 @@ func parseMap[T Identifiable](p *util.XMLParser, dest *map[int]T, creator func(*
 	}
 }
 
-func parseMapPlus[T Identifiable](p *util.XMLParser, dest *map[int]T, creator func(*util.XMLParser, T) (T, error)) {
+func parseMapPlus[T Identifiable](p *parser, dest *map[int]T, creator func(*parser, T) (T, error)) {
 	for {
 		t, _, err := p.Token()
 		if err != nil {
@@ -215,8 +344,9 @@ func parseMapPlus[T Identifiable](p *util.XMLParser, dest *map[int]T, creator fu
 		}
 		switch t {
 		case util.StartElement:
-			id, err := parseId(p)
+			id, err := parseId(p.XMLParser)
 			if err != nil {
+				CloseStores()
 				log.Fatal(err)
 			}
 			x, err := creator(p, (*dest)[id])
@@ -261,7 +391,25 @@ func txt(b []byte) string {
 	return util.ConvertCp473(b)
 }
 
-var sameFields map[string]map[string]map[string]bool
+// the fields that held the same values in all worlds loaded
+var (
+	sameFields     map[string]map[string]map[string]bool
+	sameFieldsLock sync.RWMutex
+	sameFieldsOnce sync.Once
+)
+
+// fieldsDiffer records that two fields of an object type do not always hold
+// the same value. Objects are parsed concurrently.
+func fieldsDiffer(objectType, field, field2 string) {
+	sameFieldsLock.RLock()
+	same := sameFields[objectType][field][field2]
+	sameFieldsLock.RUnlock()
+	if same {
+		sameFieldsLock.Lock()
+		sameFields[objectType][field][field2] = false
+		sameFieldsLock.Unlock()
+	}
+}
 
 func exportSameFields() map[string]map[string]string {
 	export := make(map[string]map[string]string)
@@ -0,0 +1,274 @@
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// size of the chunks the sections of a legends file are split into
+var chunkSize = 4 << 20
+
+// Timings records the duration of the phases of loading a world.
+type Timings struct {
+	lock   sync.Mutex
+	phases []*Timing
+}
+
+type Timing struct {
+	Phase    string
+	Duration time.Duration
+}
+
+// Track starts timing a phase, the returned function stops it.
+func (t *Timings) Track(phase string) func() {
+	start := time.Now()
+	return func() {
+		t.lock.Lock()
+		t.phases = append(t.phases, &Timing{Phase: phase, Duration: time.Since(start)})
+		t.lock.Unlock()
+	}
+}
+
+func (t *Timings) Phases() []*Timing {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return append([]*Timing{}, t.phases...)
+}
+
+func (t *Timings) String() string {
+	var b strings.Builder
+	for _, p := range t.Phases() {
+		fmt.Fprintf(&b, "%-24s %8.3fs\n", p.Phase, p.Duration.Seconds())
+	}
+	return b.String()
+}
+
+// chunk is a complete df_world document holding a part of one section of a
+// legends file.
+type chunk struct {
+	seq     int
+	section string
+	data    []byte
+}
+
+// splitWorld splits the sections of the df_world element into chunks, that
+// can be parsed independently. Chunks are sent in document order.
+func splitWorld(p *util.XMLParser, out chan<- *chunk) error {
+	defer close(out)
+
+	for {
+		t, n, err := p.Token()
+		if err != nil {
+			return err
+		}
+		if t == util.StartElement && n == "df_world" {
+			break
+		}
+	}
+
+	seq := 0
+	for {
+		t, section, err := p.Token()
+		if err != nil {
+			return err
+		}
+		if t == util.EndElement {
+			return nil
+		}
+
+		head := []byte("<df_world><" + section + ">")
+		tail := []byte("</" + section + "></df_world>")
+		p.Capture(head, chunkSize+64*1024)
+		for {
+			t, _, err := p.Token()
+			if err != nil {
+				return err
+			}
+			if t == util.EndElement {
+				// cut the end element of the section, which is part of the
+				// capture unless the section was self-closing
+				data := p.StopCapture()
+				if i := bytes.LastIndex(data, []byte("</")); i >= len(head) {
+					data = data[:i]
+				}
+				out <- &chunk{seq: seq, section: section, data: append(data, tail...)}
+				seq++
+				break
+			}
+			if err := p.Skip(); err != nil {
+				return err
+			}
+			if len(p.Captured()) >= chunkSize {
+				out <- &chunk{seq: seq, section: section, data: append(p.StopCapture(), tail...)}
+				seq++
+				p.Capture(head, chunkSize+64*1024)
+			}
+		}
+	}
+}
+
+func chunkParser(c *chunk) (*util.XMLParser, error) {
+	p := util.NewXMLParser(bufio.NewReader(bytes.NewReader(c.data)))
+	if _, _, err := p.Token(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type parsedChunk struct {
+	seq     int
+	world   *DfWorld
+	events  []*storeBlock
+	figures []*storeBlock
+	err     error
+}
+
+// parseChunks parses the chunks of a legends file concurrently and merges
+// them in document order. The events and figures of each chunk are moved to
+// the store, if not nil.
+func parseChunks(chunks <-chan *chunk, store *Store, l *loading) (*DfWorld, error) {
+	results := make(chan *parsedChunk, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chunks {
+				r := &parsedChunk{seq: c.seq}
+				p, err := chunkParser(c)
+				if err == nil {
+					r.world, err = parseDfWorld(l.parser(p))
+				}
+				if err == nil && store != nil {
+					r.events, r.figures, err = store.spill(r.world)
+				}
+				r.err = err
+				results <- r
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	world := NewDfWorld()
+	world.Store = store
+	pending := make(map[int]*parsedChunk)
+	next := 0
+	var err error
+	for r := range results {
+		pending[r.seq] = r
+		for r, ok := pending[next]; ok; r, ok = pending[next] {
+			delete(pending, next)
+			next++
+			if r.err != nil && err == nil {
+				err = r.err
+			}
+			if err == nil {
+				if store != nil {
+					store.events.append(r.events)
+					store.figures.append(r.figures)
+				}
+				mergeWorld(world, r.world)
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return world, nil
+}
+
+// mergeWorld adds the objects of src to dst. Maps are merged, slices
+// appended and other fields copied if set.
+func mergeWorld(dst, src *DfWorld) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < d.NumField(); i++ {
+		df, sf := d.Field(i), s.Field(i)
+		switch df.Kind() {
+		case reflect.Map:
+			if sf.Len() == 0 {
+				continue
+			}
+			if df.Len() == 0 {
+				df.Set(sf)
+				continue
+			}
+			iter := sf.MapRange()
+			for iter.Next() {
+				df.SetMapIndex(iter.Key(), iter.Value())
+			}
+		case reflect.Slice:
+			if sf.Len() > 0 {
+				df.Set(reflect.AppendSlice(df, sf))
+			}
+		default:
+			if !sf.IsZero() {
+				df.Set(sf)
+			}
+		}
+	}
+}
+
+// parsePlusChunks adds the chunks of a legends_plus file to the world as
+// they arrive. The sections are parsed concurrently, as each only changes its
+// own objects, the chunks of a section one after another. Events and figures
+// are added to the store of the world, if any.
+func parsePlusChunks(world *DfWorld, chunks <-chan *chunk, l *loading) error {
+	var (
+		wg       sync.WaitGroup
+		errLock  sync.Mutex
+		err      error
+		sections = make(map[string]chan *chunk)
+	)
+	for c := range chunks {
+		in, ok := sections[c.section]
+		if !ok {
+			in = make(chan *chunk, 1)
+			sections[c.section] = in
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				failed := false
+				for c := range in {
+					if failed {
+						continue
+					}
+					var e error
+					if world.Store != nil && (c.section == "historical_events" || c.section == "historical_figures") {
+						e = world.Store.parsePlus(c, l)
+					} else {
+						var p *util.XMLParser
+						if p, e = chunkParser(c); e == nil {
+							_, e = parseDfWorldPlus(l.parser(p), world)
+						}
+					}
+					if e != nil {
+						failed = true
+						errLock.Lock()
+						if err == nil {
+							err = e
+						}
+						errLock.Unlock()
+					}
+				}
+			}()
+		}
+		in <- c
+	}
+	for _, in := range sections {
+		close(in)
+	}
+	wg.Wait()
+	return err
+}
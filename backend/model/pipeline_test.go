@@ -0,0 +1,109 @@
+package model
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// worldJSON lists the objects of a world, that are filled by both legends
+// files, as json.
+func worldJSON(t *testing.T, w *DfWorld) map[string]string {
+	t.Helper()
+	objects := map[string]any{
+		"artifacts":   w.Artifacts,
+		"collections": w.HistoricalEventCollections,
+		"entities":    w.Entities,
+		"events":      w.HistoricalEvents,
+		"figures":     w.HistoricalFigures,
+		"regions":     w.Regions,
+		"sites":       w.Sites,
+		"written":     w.WrittenContents,
+	}
+	r := make(map[string]string)
+	for name, o := range objects {
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r[name] = string(data)
+	}
+	return r
+}
+
+func countChunks(t *testing.T, file string) (chunks int, sections map[string]bool) {
+	t.Helper()
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	out := make(chan *chunk)
+	errs := make(chan error, 1)
+	go func() { errs <- splitWorld(util.NewXMLParser(bufio.NewReader(f)), out) }()
+	sections = make(map[string]bool)
+	for c := range out {
+		chunks++
+		sections[c.section] = true
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	return chunks, sections
+}
+
+func TestParallelParse(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	want := worldJSON(t, parseFixture(t))
+
+	defer func(size int) { chunkSize = size }(chunkSize)
+	chunkSize = 1024
+	for _, file := range []string{fixtureFile, "testdata/fixture-legends_plus.xml"} {
+		if chunks, sections := countChunks(t, file); chunks <= len(sections) {
+			t.Errorf("%s: %d chunks for %d sections", file, chunks, len(sections))
+		}
+	}
+
+	runtime.GOMAXPROCS(4)
+	got := worldJSON(t, parseFixture(t))
+	for name := range want {
+		if got[name] != want[name] {
+			t.Errorf("%s differ between the parallel and the sequential parser", name)
+		}
+	}
+}
+
+func TestParseConcurrently(t *testing.T) {
+	want := worldJSON(t, parseFixture(t))
+
+	worlds := make([]*DfWorld, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range worlds {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			worlds[i], errs[i] = Parse(fixtureFile, nil)
+		}(i)
+	}
+	wg.Wait()
+	for i, w := range worlds {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		got := worldJSON(t, w)
+		for name := range want {
+			if got[name] != want[name] {
+				t.Errorf("%s differ in world %d loaded at the same time", name, i)
+			}
+		}
+		if w.InternedStrings <= 0 {
+			t.Errorf("world %d has %d interned strings", i, w.InternedStrings)
+		}
+	}
+}
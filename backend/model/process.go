@@ -13,8 +13,10 @@ import (
 
 var CheckAfterLoading = false
 
-func (w *DfWorld) process() {
-	fmt.Println("\nprocessing...")
+func (w *DfWorld) process(timings *Timings) {
+	fmt.Fprintln(Log, "\nprocessing...")
+	defer timings.Track("process")()
+
 	for id, r := range w.Rivers {
 		r.Id_ = id
 	}
@@ -28,9 +30,17 @@ func (w *DfWorld) process() {
 		}
 	}
 
+	done := timings.Track("process events")
 	w.processEvents()
+	done()
+	w.processIdentities()
+	done = timings.Track("process collections")
 	w.processCollections()
+	done()
+	done = timings.Track("process figures")
 	w.processHistoricalFigures()
+	w.processHfValues()
+	done()
 
 	for _, e := range w.Entities {
 		if len(e.Sites) > 0 {
@@ -56,9 +66,9 @@ func (w *DfWorld) process() {
 
 	if !w.Plus {
 		trimRace := func(s string) string { return strings.Trim(strcase.ToDelimited(s, ' '), " 0123456789") }
-		for _, hf := range w.HistoricalFigures {
+		w.EachHf(func(hf *HistoricalFigure) {
 			hf.Race = trimRace(hf.Race)
-		}
+		})
 
 		for _, e := range w.Entities {
 			if len(e.Leaders) > 0 {
@@ -112,9 +122,7 @@ func (w *DfWorld) process() {
 			}
 		}
 
-		list := maps.Values(w.HistoricalEvents)
-		sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
-		for _, e := range list {
+		w.eachEventInOrder(func(e *HistoricalEvent) {
 			switch d := e.Details.(type) {
 			case *HistoricalEventCreatedSite:
 				setParent(d.SiteCivId, d.CivId)
@@ -178,7 +186,7 @@ func (w *DfWorld) process() {
 			case *HistoricalEventMasterpieceItem:
 				d.ItemType = "item"
 			}
-		}
+		})
 
 		for _, e := range w.Entities {
 			switch e.Race {
@@ -197,39 +205,46 @@ func (w *DfWorld) process() {
 		}
 	}
 
+	w.processWrittenContents()
+	done = timings.Track("process statistics")
+	w.processStatistics()
+	done()
+
 	// check events texts
 	if CheckAfterLoading {
-		for _, e := range w.HistoricalEvents {
+		w.EachEvent(func(e *HistoricalEvent) {
 			e.Details.Html(&Context{World: w})
-		}
+		})
 		for _, e := range w.HistoricalEventCollections {
 			e.Details.Html(e, &Context{World: w})
 		}
+		if Texts != nil {
+			for _, err := range Texts.Check(w) {
+				fmt.Fprintln(Log, err)
+			}
+		}
 	}
 
-	fmt.Println("world ready")
+	fmt.Fprintln(Log, "world ready")
 }
 
 func (w *DfWorld) processEvents() {
-	list := maps.Values(w.HistoricalEvents)
-	sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
-
-	for _, e := range list {
+	w.eachEventInOrder(func(e *HistoricalEvent) {
 		switch d := e.Details.(type) {
 		case *HistoricalEventHfDoesInteraction:
-			if hf, ok := w.HistoricalFigures[d.TargetHfid]; ok {
-				if strings.HasPrefix(d.Interaction, "DEITY_CURSE_WEREBEAST_") {
+			if hf, ok := w.Hf(d.TargetHfid); ok {
+				if strings.HasPrefix(d.Interaction, "DEITY_CURSE_WEREBEAST_") && !hf.Werebeast {
 					hf.Werebeast = true
 					hf.WerebeastSince = e.Year
 				}
-				if strings.HasPrefix(d.Interaction, "DEITY_CURSE_VAMPIRE_") {
+				if strings.HasPrefix(d.Interaction, "DEITY_CURSE_VAMPIRE_") && !hf.Vampire {
 					hf.Vampire = true
 					hf.VampireSince = e.Year
 				}
 			}
 		case *HistoricalEventHfLearnsSecret:
 			if strings.HasPrefix(d.Interaction, "SECRET_") {
-				if hf, ok := w.HistoricalFigures[d.StudentHfid]; ok {
+				if hf, ok := w.Hf(d.StudentHfid); ok && !hf.Necromancer {
 					hf.Necromancer = true
 					hf.NecromancerSince = e.Year
 				}
@@ -237,31 +252,41 @@ func (w *DfWorld) processEvents() {
 		case *HistoricalEventCreatedSite:
 			w.addEntitySite(d.CivId, d.SiteId)
 			w.addEntitySite(d.SiteCivId, d.SiteId)
-			w.Sites[d.SiteId].Ruin = false
-			w.Sites[d.SiteId].Owner = d.CivId
+			if site, ok := w.Sites[d.SiteId]; ok {
+				site.Ruin = false
+				site.Owner = d.CivId
+			}
 		case *HistoricalEventDestroyedSite:
 			w.addEntitySite(d.DefenderCivId, d.SiteId)
 			w.addEntitySite(d.SiteCivId, d.SiteId)
-			w.Sites[d.SiteId].Ruin = true
+			if site, ok := w.Sites[d.SiteId]; ok {
+				site.Ruin = true
+			}
 		case *HistoricalEventSiteTakenOver:
 			w.addEntitySite(d.AttackerCivId, d.SiteId)
 			w.addEntitySite(d.SiteCivId, d.SiteId)
 			w.addEntitySite(d.DefenderCivId, d.SiteId)
 			w.addEntitySite(d.NewSiteCivId, d.SiteId)
-			w.Sites[d.SiteId].Ruin = false
-			w.Sites[d.SiteId].Owner = d.AttackerCivId
+			if site, ok := w.Sites[d.SiteId]; ok {
+				site.Ruin = false
+				site.Owner = d.AttackerCivId
+			}
 		case *HistoricalEventHfDestroyedSite:
 			w.addEntitySite(d.SiteCivId, d.SiteId)
 			w.addEntitySite(d.DefenderCivId, d.SiteId)
-			w.Sites[d.SiteId].Ruin = true
+			if site, ok := w.Sites[d.SiteId]; ok {
+				site.Ruin = true
+			}
 		case *HistoricalEventReclaimSite:
 			w.addEntitySite(d.SiteCivId, d.SiteId)
 			w.addEntitySite(d.SiteCivId, d.SiteId)
-			w.Sites[d.SiteId].Ruin = false
-			w.Sites[d.SiteId].Owner = d.CivId
+			if site, ok := w.Sites[d.SiteId]; ok {
+				site.Ruin = false
+				site.Owner = d.CivId
+			}
 		case *HistoricalEventAddHfEntityLink:
 			if d.Link == HistoricalEventAddHfEntityLinkLink_Position {
-				if hf, ok := w.HistoricalFigures[d.Hfid]; ok {
+				if hf, ok := w.Hf(d.Hfid); ok {
 					for _, l := range hf.EntityPositionLink {
 						if l.EntityId == d.CivId && l.StartYear == e.Year {
 							l.PositionProfileId = d.PositionId
@@ -303,17 +328,17 @@ func (w *DfWorld) processEvents() {
 				}
 			}
 		case *HistoricalEventAssumeIdentity:
-			if hf, ok := w.HistoricalFigures[d.TricksterHfid]; ok {
+			if hf, ok := w.Hf(d.TricksterHfid); ok {
 				if id, ok := w.Identities[d.IdentityId]; ok {
 					id.HistfigId = hf.Id_
 				}
 			}
 		case *HistoricalEventHfDied:
-			if hf, ok := w.HistoricalFigures[d.SlayerHfid]; ok {
+			if hf, ok := w.Hf(d.SlayerHfid); ok {
 				hf.Kills = append(hf.Kills, d.Hfid)
 			}
 		}
-	}
+	})
 }
 
 func (w *DfWorld) processCollections() {
@@ -322,7 +347,7 @@ func (w *DfWorld) processCollections() {
 
 	for _, col := range list {
 		for _, eventId := range col.Event {
-			if e, ok := w.HistoricalEvents[eventId]; ok {
+			if e, ok := w.Event(eventId); ok {
 				e.Collection = col.Id_
 			}
 		}
@@ -331,7 +356,7 @@ func (w *DfWorld) processCollections() {
 		case *HistoricalEventCollectionAbduction:
 			targets := make(map[int]bool)
 			for _, eventId := range col.Event {
-				if e, ok := w.HistoricalEvents[eventId]; ok {
+				if e, ok := w.Event(eventId); ok {
 					switch d := e.Details.(type) {
 					case *HistoricalEventHfAbducted:
 						targets[d.TargetHfid] = true
@@ -343,7 +368,7 @@ func (w *DfWorld) processCollections() {
 		case *HistoricalEventCollectionBeastAttack:
 			attackers := make(map[int]bool)
 			for _, eventId := range col.Event {
-				if e, ok := w.HistoricalEvents[eventId]; ok {
+				if e, ok := w.Event(eventId); ok {
 					switch d := e.Details.(type) {
 					case *HistoricalEventHfSimpleBattleEvent:
 						attackers[d.Group1Hfid] = true
@@ -365,7 +390,7 @@ func (w *DfWorld) processCollections() {
 		case *HistoricalEventCollectionJourney:
 		HistoricalEventCollectionJourneyLoop:
 			for _, eventId := range col.Event {
-				if e, ok := w.HistoricalEvents[eventId]; ok {
+				if e, ok := w.Event(eventId); ok {
 					switch d := e.Details.(type) {
 					case *HistoricalEventHfTravel:
 						cd.TravellerHfIds = d.GroupHfid
@@ -408,8 +433,9 @@ func (w *DfWorld) addEntitySite(entityId, siteId int) {
 }
 
 func (w *DfWorld) addRelationshipEvents() {
+	events := make(map[int]*HistoricalEvent)
 	for _, r := range w.HistoricalEventRelationships {
-		w.HistoricalEvents[r.Event] = &HistoricalEvent{
+		events[r.Event] = &HistoricalEvent{
 			Id_:        r.Event,
 			Year:       r.Year,
 			Collection: -1,
@@ -422,9 +448,18 @@ func (w *DfWorld) addRelationshipEvents() {
 			},
 		}
 	}
+	w.addEvents(events)
 }
 
 func (w *DfWorld) processHistoricalFigures() {
+	// the lowest id by name, to link the figures in the descriptions of forms
+	w.EachHf(func(hf *HistoricalFigure) {
+		name := strings.ToLower(hf.descriptionName())
+		if id, ok := w.HfNames[name]; !ok || hf.Id_ < id {
+			w.HfNames[name] = hf.Id_
+		}
+	})
+
 	// for _, hf := range w.HistoricalFigures {
 	// 	for _, i := range hf.ActiveInteraction {
 	// 		if strings.HasPrefix(i, "DEITY_CURSE_WEREBEAST_") {
@@ -0,0 +1,183 @@
+package model
+
+import (
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// Custody is an interval of the life of an artifact during which holder and
+// location did not change.
+type Custody struct {
+	StartYear      int    `json:"startYear"`
+	EndYear        int    `json:"endYear"`
+	HolderHfid     int    `json:"holderHfid"`
+	HolderEntityId int    `json:"holderEntityId"`
+	SiteId         int    `json:"siteId"`
+	StructureId    int    `json:"structureId"`
+	SubregionId    int    `json:"subregionId"`
+	Lost           bool   `json:"lost"`
+	Change         string `json:"change"`
+	EventId        int    `json:"eventId"`
+	Events         []int  `json:"events"`
+}
+
+type Provenance struct {
+	ArtifactId int        `json:"artifactId"`
+	Custody    []*Custody `json:"custody"`
+	// EndYear is the year the artifact was destroyed or transformed, -1 if it still exists
+	EndYear int    `json:"endYear"`
+	End     string `json:"end,omitempty"`
+}
+
+func NewProvenance(w *DfWorld, a *Artifact) *Provenance {
+	p := &Provenance{ArtifactId: a.Id_, EndYear: -1}
+
+	events := w.EventsRelatedTo(a.Id_, func(d HistoricalEventDetails) bool { return d.RelatedToArtifact(a.Id_) })
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Year != events[j].Year {
+			return events[i].Year < events[j].Year
+		}
+		return events[i].Seconds72 < events[j].Seconds72
+	})
+
+	var current *Custody
+	next := func(e *HistoricalEvent, change string) *Custody {
+		c := &Custody{HolderHfid: -1, HolderEntityId: -1, SiteId: -1, StructureId: -1, SubregionId: -1}
+		if current != nil {
+			*c = *current
+			c.Events = nil
+			current.EndYear = e.Year
+		}
+		c.StartYear, c.EndYear = e.Year, -1
+		c.Lost = false
+		c.Change, c.EventId = change, e.Id_
+		p.Custody = append(p.Custody, c)
+		current = c
+		return c
+	}
+	place := func(c *Custody, siteId, structureId, subregionId int) {
+		c.SiteId, c.StructureId, c.SubregionId = siteId, structureId, subregionId
+	}
+
+	for _, e := range events {
+		switch d := e.Details.(type) {
+		case *HistoricalEventArtifactCreated:
+			c := next(e, "created")
+			c.HolderHfid, c.HolderEntityId = d.HistFigureId, d.EntityId
+			place(c, d.SiteId, -1, -1)
+		case *HistoricalEventArtifactCopied:
+			// the copy is kept by the destination entity, the original stays
+			// with its holder
+			if current != nil {
+				current.Events = append(current.Events, e.Id_)
+			}
+		case *HistoricalEventArtifactGiven:
+			c := next(e, util.If(d.Inherited, "inherited", "given"))
+			c.HolderHfid, c.HolderEntityId = d.ReceiverHistFigureId, d.ReceiverEntityId
+			if d.ReceiverHistFigureId != -1 {
+				place(c, -1, -1, -1)
+			}
+		case *HistoricalEventArtifactStored:
+			c := next(e, "stored")
+			c.HolderHfid = d.HistFigureId
+			place(c, d.SiteId, -1, -1)
+		case *HistoricalEventArtifactLost:
+			c := next(e, "lost")
+			c.HolderHfid, c.HolderEntityId = -1, -1
+			place(c, d.SiteId, -1, d.SubregionId)
+			c.Lost = true
+		case *HistoricalEventArtifactFound:
+			c := next(e, "found")
+			c.HolderHfid = d.HistFigureId
+			place(c, d.SiteId, -1, -1)
+		case *HistoricalEventArtifactPossessed:
+			c := next(e, "claimed")
+			c.HolderHfid = d.HistFigureId
+			place(c, d.SiteId, -1, d.SubregionId)
+		case *HistoricalEventArtifactRecovered:
+			c := next(e, "recovered")
+			c.HolderHfid = d.HistFigureId
+			place(c, d.SiteId, d.StructureId, d.SubregionId)
+		case *HistoricalEventItemStolen:
+			c := next(e, "stolen")
+			c.HolderHfid, c.HolderEntityId = d.Histfig, d.Entity
+			if col, ok := w.HistoricalEventCollections[e.Collection]; ok && c.HolderEntityId == -1 {
+				if t, ok := col.Details.(*HistoricalEventCollectionTheft); ok {
+					c.HolderEntityId = t.AttackingEnid
+				}
+			}
+			place(c, util.If(d.StashSite != -1, d.StashSite, d.Site), -1, -1)
+		case *HistoricalEventArtifactTransformed:
+			if d.NewArtifactId == a.Id_ {
+				c := next(e, "transformed")
+				c.HolderHfid = d.HistFigureId
+				place(c, d.SiteId, -1, -1)
+			} else if current != nil {
+				current.EndYear = e.Year
+				current.Events = append(current.Events, e.Id_)
+				p.EndYear, p.End = e.Year, "transformed"
+			}
+		case *HistoricalEventArtifactDestroyed:
+			if current != nil {
+				current.EndYear = e.Year
+				current.Events = append(current.Events, e.Id_)
+			}
+			p.EndYear, p.End = e.Year, "destroyed"
+		default:
+			if current != nil {
+				current.Events = append(current.Events, e.Id_)
+			}
+		}
+	}
+
+	if len(p.Custody) == 0 {
+		p.Custody = append(p.Custody, &Custody{
+			StartYear:      -1,
+			EndYear:        -1,
+			HolderHfid:     a.HolderHfid,
+			HolderEntityId: -1,
+			SiteId:         a.SiteId,
+			StructureId:    a.StructureLocalId,
+			SubregionId:    a.SubregionId,
+			EventId:        -1,
+		})
+	}
+
+	return p
+}
+
+// Sites lists the sites along the path of the artifact, repeated visits of
+// the same site in a row are collapsed.
+func (p *Provenance) Sites() []int {
+	var sites []int
+	for _, c := range p.Custody {
+		if c.SiteId != -1 && (len(sites) == 0 || sites[len(sites)-1] != c.SiteId) {
+			sites = append(sites, c.SiteId)
+		}
+	}
+	return sites
+}
+
+var AddMapProvenance = func(w *DfWorld, p *Provenance) template.HTML {
+	sites := p.Sites()
+	r := ""
+	var trail []string
+	for _, id := range sites {
+		if site, ok := w.Sites[id]; ok {
+			r += string(AddMapSite(w, id, false))
+			if x, y, ok := siteCenter(site); ok {
+				trail = append(trail, fmt.Sprintf(`coord(%f,%f)`, x, y))
+			}
+		}
+	}
+	if len(trail) > 1 {
+		r += "<script>"
+		r += "L.polyline([" + strings.Join(trail, ",") + "], {color: '#f80', opacity: 1, weight: 3, dashArray: '6 6'}).addTo(map);"
+		r += "</script>"
+	}
+	return template.HTML(r)
+}
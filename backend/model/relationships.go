@@ -0,0 +1,251 @@
+package model
+
+import (
+	"sort"
+	"strings"
+)
+
+// number of entries in the "most loved by" and "most feared by" lists
+const relationshipTop = 10
+
+// Relationship is the view of one historical figure on another, merged from
+// the historical, visual and identity relationship profiles and the vague
+// relationships.
+type Relationship struct {
+	Hfid       int
+	TargetHfid int
+	// TargetIdentityId is the identity the target is known as, -1 if known by its real name
+	TargetIdentityId int
+	Scores           []*RelationshipScore
+	Reputations      []*RelationshipScore
+	MeetCount        int
+	LastMeetYear     int
+	Vague            []string
+}
+
+type RelationshipScore struct {
+	Name  string
+	Value int
+}
+
+type Relationships struct {
+	Hf                *HistoricalFigure
+	Known             []*Relationship
+	KnownBy           []*Relationship
+	EntityReputations []*EntityReputationScores
+}
+
+type EntityReputationScores struct {
+	*EntityReputation
+	Reputations []*RelationshipScore
+}
+
+func NewRelationships(w *DfWorld, hf *HistoricalFigure) *Relationships {
+	r := &Relationships{Hf: hf, Known: hfRelationships(w, hf)}
+
+	w.EachHf(func(other *HistoricalFigure) {
+		if other.Id_ == hf.Id_ || !knowsHf(w, other, hf.Id_) {
+			return
+		}
+		for _, rel := range hfRelationships(w, other) {
+			if rel.TargetHfid == hf.Id_ {
+				r.KnownBy = append(r.KnownBy, rel)
+			}
+		}
+	})
+	sort.Slice(r.KnownBy, func(i, j int) bool { return r.KnownBy[i].Hfid < r.KnownBy[j].Hfid })
+
+	for _, e := range hf.EntityReputation {
+		r.EntityReputations = append(r.EntityReputations, &EntityReputationScores{
+			EntityReputation: e,
+			Reputations: relationshipScores(
+				"hero", e.RepHero, "enemy fighter", e.RepEnemyFighter, "hunter", e.RepHunter, "killer", e.RepKiller,
+				"thief", e.RepThief, "treasure hunter", e.RepTreasureHunter, "bard", e.RepBard, "poet", e.RepPoet,
+				"storyteller", e.RepStoryteller, "knowledge preserver", e.RepKnowledgePreserver,
+				"protector of the weak", e.RepProtectorOfWeak),
+		})
+	}
+
+	return r
+}
+
+// knowsHf is a quick check if any relationship of hf points to target.
+func knowsHf(w *DfWorld, hf *HistoricalFigure, target int) bool {
+	for _, p := range hf.RelationshipProfileHfHistorical {
+		if p.HfId == target {
+			return true
+		}
+	}
+	for _, p := range hf.RelationshipProfileHfVisual {
+		if p.HfId == target {
+			return true
+		}
+	}
+	for _, p := range hf.RelationshipProfileHfIdentity {
+		if i, ok := w.Identities[p.Id_]; ok && i.HistfigId == target {
+			return true
+		}
+	}
+	for _, v := range hf.VagueRelationship {
+		if v.Hfid == target {
+			return true
+		}
+	}
+	return false
+}
+
+func hfRelationships(w *DfWorld, hf *HistoricalFigure) []*Relationship {
+	type key struct{ hfid, identityId int }
+	rels := make(map[key]*Relationship)
+	var list []*Relationship
+	rel := func(hfid, identityId int) *Relationship {
+		k := key{hfid, identityId}
+		if r, ok := rels[k]; ok {
+			return r
+		}
+		r := &Relationship{Hfid: hf.Id_, TargetHfid: hfid, TargetIdentityId: identityId, MeetCount: -1, LastMeetYear: -1}
+		rels[k] = r
+		list = append(list, r)
+		return r
+	}
+
+	for _, p := range hf.RelationshipProfileHfHistorical {
+		r := rel(p.HfId, -1)
+		r.Scores = mergeScores(r.Scores, relationshipScores(
+			"love", p.Love, "respect", p.Respect, "trust", p.Trust, "loyalty", p.Loyalty, "fear", p.Fear))
+		r.Reputations = mergeScores(r.Reputations, relationshipScores(
+			"hero", p.RepHero, "enemy fighter", p.RepEnemyFighter, "hunter", p.RepHunter, "killer", p.RepKiller,
+			"psychopath", p.RepPsychopath, "violent", p.RepViolent, "storyteller", p.RepStoryteller))
+	}
+	for _, p := range hf.RelationshipProfileHfVisual {
+		r := rel(p.HfId, -1)
+		r.Scores = mergeScores(r.Scores, relationshipScores(
+			"love", p.Love, "respect", p.Respect, "trust", p.Trust, "loyalty", p.Loyalty, "fear", p.Fear))
+		r.Reputations = mergeScores(r.Reputations, relationshipScores(
+			"hero", p.RepHero, "hunter", p.RepHunter, "killer", p.RepKiller, "psychopath", p.RepPsychopath,
+			"bonded", p.RepBonded, "comrade", p.RepComrade, "flatterer", p.RepFlatterer, "friendly", p.RepFriendly,
+			"information source", p.RepInformationSource, "quarreler", p.RepQuarreler, "trade partner", p.RepTradePartner))
+		r.MeetCount, r.LastMeetYear = p.MeetCount, p.LastMeetYear
+	}
+	for _, p := range hf.RelationshipProfileHfIdentity {
+		hfid := -1
+		if i, ok := w.Identities[p.Id_]; ok {
+			hfid = i.HistfigId
+		}
+		r := rel(hfid, p.Id_)
+		r.Scores = mergeScores(r.Scores, relationshipScores(
+			"love", p.Love, "respect", p.Respect, "trust", p.Trust, "loyalty", p.Loyalty, "fear", p.Fear))
+		r.Reputations = mergeScores(r.Reputations, relationshipScores("psychopath", p.RepPsychopath))
+	}
+	for _, v := range hf.VagueRelationship {
+		r := rel(v.Hfid, -1)
+		r.Vague = append(r.Vague, v.Types()...)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].TargetHfid != list[j].TargetHfid {
+			return list[i].TargetHfid < list[j].TargetHfid
+		}
+		return list[i].TargetIdentityId < list[j].TargetIdentityId
+	})
+	return list
+}
+
+// relationshipScores builds a score list from name/value pairs, skipping
+// unset and zero values.
+func relationshipScores(pairs ...any) []*RelationshipScore {
+	var list []*RelationshipScore
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if v := pairs[i+1].(int); v > 0 {
+			list = append(list, &RelationshipScore{Name: pairs[i].(string), Value: v})
+		}
+	}
+	return list
+}
+
+func mergeScores(a, b []*RelationshipScore) []*RelationshipScore {
+	for _, s := range b {
+		found := false
+		for _, t := range a {
+			if t.Name == s.Name {
+				if s.Value > t.Value {
+					t.Value = s.Value
+				}
+				found = true
+			}
+		}
+		if !found {
+			a = append(a, s)
+		}
+	}
+	return a
+}
+
+func (r *Relationship) Score(name string) int {
+	for _, s := range r.Scores {
+		if s.Name == name {
+			return s.Value
+		}
+	}
+	return 0
+}
+
+func (r *Relationship) Grudge() bool {
+	for _, v := range r.Vague {
+		if strings.HasSuffix(v, "grudge") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Relationships) LovedBy() []*Relationship  { return r.topKnownBy("love") }
+func (r *Relationships) FearedBy() []*Relationship { return r.topKnownBy("fear") }
+
+func (r *Relationships) topKnownBy(score string) []*Relationship {
+	var list []*Relationship
+	for _, rel := range r.KnownBy {
+		if rel.Score(score) > 0 {
+			list = append(list, rel)
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Score(score) > list[j].Score(score) })
+	if len(list) > relationshipTop {
+		list = list[:relationshipTop]
+	}
+	return list
+}
+
+// Grudges lists everyone holding a grudge against the figure.
+func (r *Relationships) Grudges() []*Relationship {
+	var list []*Relationship
+	for _, rel := range r.KnownBy {
+		if rel.Grudge() {
+			list = append(list, rel)
+		}
+	}
+	return list
+}
+
+func (x *VagueRelationship) Types() []string {
+	var list []string
+	add := func(b bool, s string) {
+		if b {
+			list = append(list, s)
+		}
+	}
+	add(x.ChildhoodFriend, "childhood friend")
+	add(x.WarBuddy, "war buddy")
+	add(x.ArtisticBuddy, "artistic buddy")
+	add(x.AthleteBuddy, "athletic buddy")
+	add(x.ScholarBuddy, "scholar buddy")
+	add(x.AtheleticRival, "athletic rival")
+	add(x.BusinessRival, "business rival")
+	add(x.JealousObsession, "jealous obsession")
+	add(x.Grudge, "grudge")
+	add(x.JealousRelationshipGrudge, "jealous relationship grudge")
+	add(x.PersecutionGrudge, "persecution grudge")
+	add(x.ReligiousPersecutionGrudge, "religious persecution grudge")
+	add(x.SupernaturalGrudge, "supernatural grudge")
+	return list
+}
@@ -0,0 +1,181 @@
+package model
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+type Statistics struct {
+	Tables []*StatTable `json:"tables"`
+}
+
+type StatTable struct {
+	Name    string     `json:"name"`
+	Title   string     `json:"title"`
+	Label   string     `json:"label"`
+	Columns []string   `json:"columns"`
+	Rows    []*StatRow `json:"rows"`
+}
+
+type StatRow struct {
+	Label  string `json:"label"`
+	Values []int  `json:"values"`
+}
+
+func (s *Statistics) Table(name string) *StatTable {
+	for _, t := range s.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (r *StatRow) Total() int {
+	t := 0
+	for _, v := range r.Values {
+		t += v
+	}
+	return t
+}
+
+func (t *StatTable) Max() int {
+	m := 0
+	for _, r := range t.Rows {
+		if v := r.Total(); v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+func (t *StatTable) Total() int {
+	s := 0
+	for _, r := range t.Rows {
+		s += r.Total()
+	}
+	return s
+}
+
+func (t *StatTable) Records() [][]string {
+	records := [][]string{append([]string{t.Label}, t.Columns...)}
+	for _, r := range t.Rows {
+		records = append(records, append([]string{r.Label}, util.Map(r.Values, strconv.Itoa)...))
+	}
+	return records
+}
+
+type statCounter struct {
+	columns []string
+	counts  map[string]map[string]int
+}
+
+func newStatCounter(columns ...string) *statCounter {
+	return &statCounter{
+		columns: columns,
+		counts:  make(map[string]map[string]int),
+	}
+}
+
+func (c *statCounter) add(row, column string) {
+	if _, ok := c.counts[row]; !ok {
+		c.counts[row] = make(map[string]int)
+	}
+	c.counts[row][column]++
+}
+
+func (c *statCounter) table(name, title, label string, byCount bool) *StatTable {
+	columns := c.columns
+	if columns == nil {
+		set := make(map[string]bool)
+		for _, r := range c.counts {
+			for k := range r {
+				set[k] = true
+			}
+		}
+		columns = util.Keys(set)
+		sort.Strings(columns)
+	}
+
+	t := &StatTable{Name: name, Title: title, Label: label, Columns: columns}
+	for label, r := range c.counts {
+		t.Rows = append(t.Rows, &StatRow{
+			Label:  label,
+			Values: util.Map(columns, func(c string) int { return r[c] }),
+		})
+	}
+	if byCount {
+		sort.Slice(t.Rows, func(i, j int) bool {
+			a, b := t.Rows[i].Total(), t.Rows[j].Total()
+			if a == b {
+				return t.Rows[i].Label < t.Rows[j].Label
+			}
+			return a > b
+		})
+	} else {
+		sort.Slice(t.Rows, func(i, j int) bool { return t.Rows[i].Label < t.Rows[j].Label })
+	}
+	return t
+}
+
+func (w *DfWorld) processStatistics() {
+	population := newStatCounter("alive", "dead")
+	w.EachHf(func(hf *HistoricalFigure) {
+		if hf.Deity || hf.Force {
+			return
+		}
+		population.add(util.If(hf.Race != "", hf.Race, "unknown"), util.If(hf.DeathYear == -1, "alive", "dead"))
+	})
+
+	deathsByYear := newStatCounter()
+	deathsByCause := newStatCounter("deaths")
+	events := newStatCounter("events")
+	w.EachEvent(func(e *HistoricalEvent) {
+		events.add(e.Details.Type(), "events")
+		if d, ok := e.Details.(*HistoricalEventHfDied); ok {
+			cause := d.Cause.String()
+			deathsByYear.add(strconv.Itoa(e.Year), cause)
+			deathsByCause.add(cause, "deaths")
+		}
+	})
+	deaths := deathsByYear.table("deaths-by-year", "Deaths per Year", "year", false)
+	sort.Slice(deaths.Rows, func(i, j int) bool {
+		a, _ := strconv.Atoi(deaths.Rows[i].Label)
+		b, _ := strconv.Atoi(deaths.Rows[j].Label)
+		return a < b
+	})
+
+	sitesByType := newStatCounter("active", "ruin")
+	sitesByOwner := newStatCounter("active", "ruin")
+	for _, s := range w.Sites {
+		state := util.If(s.Ruin, "ruin", "active")
+		sitesByType.add(s.Type(), state)
+		owner := "none"
+		if e, ok := w.Entities[s.Owner]; ok {
+			owner = util.Title(e.Name())
+		}
+		sitesByOwner.add(owner, state)
+	}
+
+	artifactsByType := newStatCounter()
+	artifactsByMaterial := newStatCounter("artifacts")
+	for _, a := range w.Artifacts {
+		artifactsByType.add(a.Type(), util.If(a.ItemType != "", a.ItemType, "unknown"))
+		artifactsByMaterial.add(util.If(a.Mat != "", a.Mat, "unknown"), "artifacts")
+	}
+
+	w.Statistics = &Statistics{
+		Tables: []*StatTable{
+			population.table("population", "Population by Race", "race", true),
+			deaths,
+			deathsByCause.table("deaths-by-cause", "Deaths by Cause", "cause", true),
+			sitesByType.table("sites-by-type", "Sites by Type", "type", true),
+			sitesByOwner.table("sites-by-owner", "Sites by Owner", "owner", true),
+			artifactsByType.table("artifacts-by-type", "Artifacts by Type", "type", true),
+			artifactsByMaterial.table("artifacts-by-material", "Artifacts by Material", "material", true),
+			events.table("event-types", "Events by Type", "type", true),
+		},
+	}
+}
@@ -0,0 +1,752 @@
+package model
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// StoreDir is the directory the events and figures of loaded worlds are
+// kept in, they are kept in memory if empty.
+var StoreDir string
+
+// number of objects encoded together
+const storeBlockSize = 256
+
+// number of decoded blocks kept in memory per section
+var storeCacheSize = 64
+
+// Store keeps the historical events and figures of a world in a file. They
+// are written to it chunk by chunk while the world is parsed, only the
+// positions of the objects and the indexes built after loading stay in
+// memory.
+//
+// While the world is loaded, changes to the objects are written back to the
+// file when their block leaves the cache. Afterwards the store is read only.
+type Store struct {
+	file *os.File
+	// unlinked is set if the file was removed right after it was created,
+	// it is freed by the system once it is closed
+	unlinked bool
+
+	lock sync.Mutex
+	size int64
+
+	loading bool
+	events  *storeSection[HistoricalEvent]
+	figures *storeSection[HistoricalFigure]
+
+	// related maps ids to the events that have a field with that value
+	related map[int][]int32
+	// types maps the types of events to their ids
+	types map[string][]int
+	// years maps years to the ids of the events in it
+	years    map[int][]int
+	listings []*HfListing
+}
+
+type storeSection[T any] struct {
+	store  *Store
+	lock   sync.Mutex
+	blocks []*storeBlock
+	// index finds the objects while the world is loaded, ids and refs
+	// afterwards
+	index map[int]storeRef
+	ids   []int
+	refs  []storeRef
+	// ordered is set if the blocks hold the objects in id order
+	ordered bool
+	cache   map[int32]*cachedBlock[T]
+	// cached lists the cached blocks, the least recently used first
+	cached []int32
+}
+
+type storeBlock struct {
+	offset   int64
+	length   int
+	capacity int
+	ids      []int
+}
+
+type storeRef struct {
+	block, slot int32
+}
+
+type cachedBlock[T any] struct {
+	objects []*T
+	data    []byte
+	pins    int
+}
+
+var (
+	openStores     = make(map[*Store]bool)
+	openStoresLock sync.Mutex
+	registerOnce   sync.Once
+)
+
+// NewStore creates a store in dir.
+func NewStore(dir string) (*Store, error) {
+	registerOnce.Do(func() {
+		for _, create := range historicalEventTypes {
+			gob.Register(create())
+		}
+	})
+
+	file, err := os.CreateTemp(dir, "legends-*.store")
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		file:     file,
+		unlinked: os.Remove(file.Name()) == nil,
+		loading:  true,
+		related:  make(map[int][]int32),
+		types:    make(map[string][]int),
+		years:    make(map[int][]int),
+	}
+	s.events = newStoreSection[HistoricalEvent](s)
+	s.figures = newStoreSection[HistoricalFigure](s)
+	if !s.unlinked {
+		openStoresLock.Lock()
+		openStores[s] = true
+		openStoresLock.Unlock()
+	}
+	return s, nil
+}
+
+func newStoreSection[T any](s *Store) *storeSection[T] {
+	return &storeSection[T]{
+		store: s,
+		index: make(map[int]storeRef),
+		cache: make(map[int32]*cachedBlock[T]),
+	}
+}
+
+// Close removes the file of the store.
+func (s *Store) Close() error {
+	openStoresLock.Lock()
+	delete(openStores, s)
+	openStoresLock.Unlock()
+
+	err := s.file.Close()
+	if !s.unlinked {
+		err = os.Remove(s.file.Name())
+	}
+	return err
+}
+
+// CloseStores removes the files of all stores, that could not be removed
+// while open. It is called before the program exits.
+func CloseStores() {
+	openStoresLock.Lock()
+	stores := make([]*Store, 0, len(openStores))
+	for s := range openStores {
+		stores = append(stores, s)
+	}
+	openStoresLock.Unlock()
+	for _, s := range stores {
+		s.Close()
+	}
+}
+
+// write writes the data of a block, in place if it fits.
+func (s *Store) write(b *storeBlock, data []byte) error {
+	if len(data) > b.capacity {
+		s.lock.Lock()
+		b.offset, b.capacity = s.size, len(data)
+		s.size += int64(len(data))
+		s.lock.Unlock()
+	}
+	b.length = len(data)
+	_, err := s.file.WriteAt(data, b.offset)
+	return err
+}
+
+// encode writes the objects to new blocks in id order, that are added to
+// the section with append.
+func (s *storeSection[T]) encode(objects map[int]*T) ([]*storeBlock, error) {
+	ids := make([]int, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var blocks []*storeBlock
+	var buf bytes.Buffer
+	for i := 0; i < len(ids); i += storeBlockSize {
+		end := i + storeBlockSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		list := make([]*T, 0, end-i)
+		for _, id := range ids[i:end] {
+			list = append(list, objects[id])
+		}
+
+		buf.Reset()
+		if err := gob.NewEncoder(&buf).Encode(list); err != nil {
+			return nil, err
+		}
+		b := &storeBlock{ids: ids[i:end]}
+		if err := s.store.write(b, buf.Bytes()); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
+
+func (s *storeSection[T]) append(blocks []*storeBlock) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, b := range blocks {
+		n := int32(len(s.blocks))
+		s.blocks = append(s.blocks, b)
+		for slot, id := range b.ids {
+			s.index[id] = storeRef{block: n, slot: int32(slot)}
+		}
+	}
+}
+
+// add writes objects to the section while the world is loaded.
+func (s *storeSection[T]) add(objects map[int]*T) error {
+	blocks, err := s.encode(objects)
+	if err != nil {
+		return err
+	}
+	s.append(blocks)
+	return nil
+}
+
+func (s *storeSection[T]) read(n int32) ([]*T, []byte, error) {
+	b := s.blocks[n]
+	data := make([]byte, b.length)
+	if _, err := s.store.file.ReadAt(data, b.offset); err != nil && err != io.EOF {
+		return nil, nil, fmt.Errorf("reading store block %d: %w", n, err)
+	}
+	var list []*T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&list); err != nil {
+		return nil, nil, fmt.Errorf("reading store block %d: %w", n, err)
+	}
+	return list, data, nil
+}
+
+// writeBack writes a cached block, if its objects were changed.
+func (s *storeSection[T]) writeBack(n int32, c *cachedBlock[T]) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(c.objects); err != nil {
+		return err
+	}
+	if bytes.Equal(buf.Bytes(), c.data) {
+		return nil
+	}
+	c.data = buf.Bytes()
+	return s.store.write(s.blocks[n], c.data)
+}
+
+// pin returns the objects of a block and keeps them in the cache until
+// unpin is called. Blocks leaving the cache are written back while the
+// world is loaded.
+func (s *storeSection[T]) pin(n int32) ([]*T, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if c, ok := s.cache[n]; ok {
+		c.pins++
+		i := slices.Index(s.cached, n)
+		s.cached = append(append(s.cached[:i], s.cached[i+1:]...), n)
+		return c.objects, nil
+	}
+
+	list, data, err := s.read(n)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(s.cached) && len(s.cached) >= storeCacheSize; {
+		m := s.cached[i]
+		c := s.cache[m]
+		if c.pins > 0 {
+			i++
+			continue
+		}
+		if s.store.loading {
+			if err := s.writeBack(m, c); err != nil {
+				return nil, err
+			}
+		}
+		delete(s.cache, m)
+		s.cached = append(s.cached[:i], s.cached[i+1:]...)
+	}
+	s.cache[n] = &cachedBlock[T]{objects: list, data: data, pins: 1}
+	s.cached = append(s.cached, n)
+	return list, nil
+}
+
+func (s *storeSection[T]) unpin(n int32) {
+	s.lock.Lock()
+	s.cache[n].pins--
+	s.lock.Unlock()
+}
+
+func (s *storeSection[T]) ref(id int) (storeRef, bool) {
+	if s.index != nil {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		r, ok := s.index[id]
+		return r, ok
+	}
+	i, ok := slices.BinarySearch(s.ids, id)
+	if !ok {
+		return storeRef{}, false
+	}
+	return s.refs[i], true
+}
+
+func (s *storeSection[T]) get(id int) (*T, bool, error) {
+	r, ok := s.ref(id)
+	if !ok {
+		return nil, false, nil
+	}
+	list, err := s.pin(r.block)
+	if err != nil {
+		return nil, false, err
+	}
+	s.unpin(r.block)
+	return list[r.slot], true, nil
+}
+
+// load returns the objects with the given ids, that are in the section. Their
+// blocks stay cached until the returned function is called.
+func (s *storeSection[T]) load(ids []int) (map[int]*T, func(), error) {
+	objects := make(map[int]*T)
+	var pinned []int32
+	release := func() {
+		for _, n := range pinned {
+			s.unpin(n)
+		}
+	}
+	for _, id := range ids {
+		r, ok := s.ref(id)
+		if !ok {
+			continue
+		}
+		if !slices.Contains(pinned, r.block) {
+			if _, err := s.pin(r.block); err != nil {
+				release()
+				return nil, nil, err
+			}
+			pinned = append(pinned, r.block)
+		}
+		s.lock.Lock()
+		objects[id] = s.cache[r.block].objects[r.slot]
+		s.lock.Unlock()
+	}
+	return objects, release, nil
+}
+
+// each calls f for all objects, in id order once the world is loaded.
+// Blocks are only cached while the world is loaded, so that changes are
+// written back.
+func (s *storeSection[T]) each(f func(*T)) error {
+	if !s.store.loading && !s.ordered {
+		for _, id := range s.ids {
+			x, _, err := s.get(id)
+			if err != nil {
+				return err
+			}
+			f(x)
+		}
+		return nil
+	}
+
+	for n := range s.blocks {
+		n := int32(n)
+		var list []*T
+		var err error
+		if s.store.loading {
+			list, err = s.pin(n)
+		} else {
+			list, _, err = s.read(n)
+		}
+		if err != nil {
+			return err
+		}
+		for _, x := range list {
+			f(x)
+		}
+		if s.store.loading {
+			s.unpin(n)
+		}
+	}
+	return nil
+}
+
+// finish writes back the cached blocks and replaces the index by sorted
+// lists.
+func (s *storeSection[T]) finish() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for n, c := range s.cache {
+		if err := s.writeBack(n, c); err != nil {
+			return err
+		}
+	}
+	s.cache = make(map[int32]*cachedBlock[T])
+	s.cached = nil
+
+	s.ids = make([]int, 0, len(s.index))
+	s.ordered = true
+	last := -1
+	for _, b := range s.blocks {
+		for _, id := range b.ids {
+			s.ids = append(s.ids, id)
+			s.ordered = s.ordered && id > last
+			last = id
+		}
+		b.ids = nil
+	}
+	sort.Ints(s.ids)
+	s.refs = make([]storeRef, len(s.ids))
+	for i, id := range s.ids {
+		s.refs[i] = s.index[id]
+	}
+	s.index = nil
+	return nil
+}
+
+// spill moves the events and figures of a parsed chunk to the store.
+func (s *Store) spill(w *DfWorld) (events, figures []*storeBlock, err error) {
+	if events, err = s.events.encode(w.HistoricalEvents); err != nil {
+		return nil, nil, err
+	}
+	if figures, err = s.figures.encode(w.HistoricalFigures); err != nil {
+		return nil, nil, err
+	}
+	w.HistoricalEvents = make(map[int]*HistoricalEvent)
+	w.HistoricalFigures = make(map[int]*HistoricalFigure)
+	return events, figures, nil
+}
+
+// parsePlus adds a chunk of the events or figures of a legends_plus file to
+// the objects in the store.
+func (s *Store) parsePlus(c *chunk, l *loading) error {
+	switch c.section {
+	case "historical_events":
+		return parsePlusSection(s.events, c, l, func(w *DfWorld) *map[int]*HistoricalEvent { return &w.HistoricalEvents })
+	case "historical_figures":
+		return parsePlusSection(s.figures, c, l, func(w *DfWorld) *map[int]*HistoricalFigure { return &w.HistoricalFigures })
+	}
+	return fmt.Errorf("no section %s in store", c.section)
+}
+
+func parsePlusSection[T any](s *storeSection[T], c *chunk, l *loading, section func(*DfWorld) *map[int]*T) error {
+	ids, err := chunkIds(c)
+	if err != nil {
+		return err
+	}
+	objects, release, err := s.load(ids)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	w := NewDfWorld()
+	*section(w) = objects
+	p, err := chunkParser(c)
+	if err != nil {
+		return err
+	}
+	if _, err := parseDfWorldPlus(l.parser(p), w); err != nil {
+		return err
+	}
+
+	added := make(map[int]*T)
+	for id, x := range *section(w) {
+		if _, ok := s.ref(id); !ok {
+			added[id] = x
+		}
+	}
+	return s.add(added)
+}
+
+// chunkIds lists the ids of the objects in a chunk.
+func chunkIds(c *chunk) ([]int, error) {
+	p, err := chunkParser(c)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := p.Token(); err != nil {
+		return nil, err
+	}
+	var ids []int
+	for {
+		t, _, err := p.Token()
+		if err != nil {
+			return nil, err
+		}
+		if t == util.EndElement {
+			return ids, nil
+		}
+		id, err := parseId(p)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		for t != util.EndElement {
+			if t, _, err = p.Token(); err != nil {
+				return nil, err
+			}
+			if t == util.StartElement {
+				if err := p.Skip(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
+
+// finishStore ends loading the world, the store is read only afterwards.
+// The indexes of the events and the listings of the figures are built.
+func (w *DfWorld) finishStore() error {
+	s := w.Store
+	if err := s.events.finish(); err != nil {
+		return err
+	}
+	if err := s.figures.finish(); err != nil {
+		return err
+	}
+	s.loading = false
+
+	err := s.events.each(func(e *HistoricalEvent) {
+		if e.Details != nil {
+			for _, v := range relatedValues(e.Details) {
+				s.related[v] = append(s.related[v], int32(e.Id_))
+			}
+			s.types[e.Details.Type()] = append(s.types[e.Details.Type()], e.Id_)
+		}
+		s.years[e.Year] = append(s.years[e.Year], e.Id_)
+	})
+	if err != nil {
+		return err
+	}
+	for _, list := range s.related {
+		sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	}
+	return s.figures.each(func(hf *HistoricalFigure) {
+		s.listings = append(s.listings, hf.Listing())
+	})
+}
+
+// relatedValues lists the distinct ids an event refers to, a superset of
+// the ids its RelatedTo methods match.
+func relatedValues(d HistoricalEventDetails) []int {
+	var values []int
+	add := func(v int) {
+		if v >= 0 && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	x := reflect.ValueOf(d).Elem()
+	for i := 0; i < x.NumField(); i++ {
+		f := x.Field(i)
+		switch {
+		case f.Kind() == reflect.Int:
+			add(int(f.Int()))
+		case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.Int:
+			for j := 0; j < f.Len(); j++ {
+				add(int(f.Index(j).Int()))
+			}
+		}
+	}
+	return values
+}
+
+// HfListing holds the fields of a historical figure shown in lists and
+// searched for. The listings of the figures in a store stay in memory.
+type HfListing struct {
+	Id_         int
+	Name_       string
+	Race        string
+	Caste       string
+	BirthYear   int
+	DeathYear   int
+	Kills       int
+	Leader      bool
+	Deity       bool
+	Force       bool
+	Vampire     bool
+	Werebeast   bool
+	Necromancer bool
+	Ghost       bool
+	Adventurer  bool
+}
+
+func (x *HfListing) Id() int      { return x.Id_ }
+func (x *HfListing) Name() string { return x.Name_ }
+
+func (hf *HistoricalFigure) Listing() *HfListing {
+	return &HfListing{
+		Id_:         hf.Id_,
+		Name_:       hf.Name_,
+		Race:        hf.Race,
+		Caste:       hf.Caste,
+		BirthYear:   hf.BirthYear,
+		DeathYear:   hf.DeathYear,
+		Kills:       len(hf.Kills),
+		Leader:      hf.Leader,
+		Deity:       hf.Deity,
+		Force:       hf.Force,
+		Vampire:     hf.Vampire,
+		Werebeast:   hf.Werebeast,
+		Necromancer: hf.Necromancer,
+		Ghost:       hf.Ghost,
+		Adventurer:  hf.Adventurer,
+	}
+}
+
+// Close removes the store of the world, if any.
+func (w *DfWorld) Close() error {
+	if w.Store != nil {
+		return w.Store.Close()
+	}
+	return nil
+}
+
+// Event returns a historical event, loading it from the store if needed.
+func (w *DfWorld) Event(id int) (*HistoricalEvent, bool) {
+	if w.Store != nil {
+		e, ok, err := w.Store.events.get(id)
+		if err != nil {
+			fmt.Fprintln(Log, err)
+		}
+		return e, ok
+	}
+	e, ok := w.HistoricalEvents[id]
+	return e, ok
+}
+
+// Hf returns a historical figure, loading it from the store if needed.
+func (w *DfWorld) Hf(id int) (*HistoricalFigure, bool) {
+	if w.Store != nil {
+		hf, ok, err := w.Store.figures.get(id)
+		if err != nil {
+			fmt.Fprintln(Log, err)
+		}
+		return hf, ok
+	}
+	hf, ok := w.HistoricalFigures[id]
+	return hf, ok
+}
+
+// EachEvent calls f for every historical event.
+func (w *DfWorld) EachEvent(f func(*HistoricalEvent)) {
+	if w.Store != nil {
+		if err := w.Store.events.each(f); err != nil {
+			fmt.Fprintln(Log, err)
+		}
+		return
+	}
+	for _, e := range w.HistoricalEvents {
+		f(e)
+	}
+}
+
+// eachEventInOrder calls f for every historical event in id order, which
+// the events in a store are in if the legends file lists them in order.
+func (w *DfWorld) eachEventInOrder(f func(*HistoricalEvent)) {
+	if w.Store != nil {
+		w.EachEvent(f)
+		return
+	}
+	list := maps.Values(w.HistoricalEvents)
+	sort.Slice(list, func(i, j int) bool { return list[i].Id_ < list[j].Id_ })
+	for _, e := range list {
+		f(e)
+	}
+}
+
+// EachHf calls f for every historical figure.
+func (w *DfWorld) EachHf(f func(*HistoricalFigure)) {
+	if w.Store != nil {
+		if err := w.Store.figures.each(f); err != nil {
+			fmt.Fprintln(Log, err)
+		}
+		return
+	}
+	for _, hf := range w.HistoricalFigures {
+		f(hf)
+	}
+}
+
+// EachHfListing calls f for the listing of every historical figure.
+func (w *DfWorld) EachHfListing(f func(*HfListing)) {
+	if w.Store != nil && !w.Store.loading {
+		for _, l := range w.Store.listings {
+			f(l)
+		}
+		return
+	}
+	w.EachHf(func(hf *HistoricalFigure) { f(hf.Listing()) })
+}
+
+// addEvents adds events created while processing the world.
+func (w *DfWorld) addEvents(events map[int]*HistoricalEvent) {
+	if w.Store != nil {
+		if err := w.Store.events.add(events); err != nil {
+			fmt.Fprintln(Log, err)
+		}
+		return
+	}
+	for id, e := range events {
+		w.HistoricalEvents[id] = e
+	}
+}
+
+func (w *DfWorld) EventCount() int {
+	if w.Store != nil {
+		return len(w.Store.events.ids)
+	}
+	return len(w.HistoricalEvents)
+}
+
+func (w *DfWorld) HfCount() int {
+	if w.Store != nil {
+		return len(w.Store.figures.ids)
+	}
+	return len(w.HistoricalFigures)
+}
+
+// EventsRelatedTo returns the events matching f ordered by id, where f only
+// matches events related to the object with the given id. Only the events
+// referring to id are loaded from the store.
+func (w *DfWorld) EventsRelatedTo(id int, f func(HistoricalEventDetails) bool) []*HistoricalEvent {
+	if w.Store == nil {
+		return w.EventsMatching(f)
+	}
+	var list []*HistoricalEvent
+	for _, eventId := range w.Store.related[id] {
+		if e, ok := w.Event(int(eventId)); ok && e.Details != nil && f(e.Details) {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// events loads the events with the given ids.
+func (w *DfWorld) events(ids []int) []*HistoricalEvent {
+	list := make([]*HistoricalEvent, 0, len(ids))
+	for _, id := range ids {
+		if e, ok := w.Event(id); ok {
+			list = append(list, e)
+		}
+	}
+	return list
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+func eventIds(list []*HistoricalEvent) []int {
+	return util.Map(list, func(e *HistoricalEvent) int { return e.Id_ })
+}
+
+func sameJSON(t *testing.T, what string, got, want any) {
+	t.Helper()
+	g, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(g) != string(w) {
+		t.Errorf("%s differs in the store:\n%s\n%s", what, g, w)
+	}
+}
+
+func TestStore(t *testing.T) {
+	want := parseFixture(t)
+
+	// small chunks and cache, so that changes are written back
+	defer func(size, cache int) { chunkSize, storeCacheSize = size, cache }(chunkSize, storeCacheSize)
+	chunkSize, storeCacheSize = 1024, 2
+
+	dir := t.TempDir()
+	StoreDir = dir
+	defer func() { StoreDir = "" }()
+	got := parseFixture(t)
+
+	if got.Store == nil || len(got.HistoricalEvents) > 0 || len(got.HistoricalFigures) > 0 {
+		t.Fatal("events and figures not moved to the store")
+	}
+	if got.EventCount() != want.EventCount() || got.HfCount() != want.HfCount() {
+		t.Errorf("store has %d events and %d figures, want %d and %d",
+			got.EventCount(), got.HfCount(), want.EventCount(), want.HfCount())
+	}
+
+	for id, e := range want.HistoricalEvents {
+		if s, ok := got.Event(id); !ok {
+			t.Errorf("no event %d", id)
+		} else {
+			sameJSON(t, "event", s, e)
+		}
+	}
+	for id, hf := range want.HistoricalFigures {
+		s, ok := got.Hf(id)
+		if !ok {
+			t.Errorf("no figure %d", id)
+			continue
+		}
+		sameJSON(t, "figure", s, hf)
+		gl := NewEventList(got, s)
+		wl := NewEventList(want, hf)
+		if !reflect.DeepEqual(eventIds(gl.Events), eventIds(wl.Events)) {
+			t.Errorf("events of figure %d: %v, want %v", id, eventIds(gl.Events), eventIds(wl.Events))
+		}
+	}
+	for id, site := range want.Sites {
+		gl := NewEventList(got, got.Sites[id])
+		wl := NewEventList(want, site)
+		if !reflect.DeepEqual(eventIds(gl.Events), eventIds(wl.Events)) {
+			t.Errorf("events of site %d: %v, want %v", id, eventIds(gl.Events), eventIds(wl.Events))
+		}
+	}
+
+	if !reflect.DeepEqual(got.AllEventTypes(), want.AllEventTypes()) {
+		t.Errorf("event types %v, want %v", got.AllEventTypes(), want.AllEventTypes())
+	}
+	for year := range want.EventYears() {
+		if g, w := eventIds(got.EventsInYear(year)), eventIds(want.EventsInYear(year)); !reflect.DeepEqual(g, w) {
+			t.Errorf("events in %d: %v, want %v", year, g, w)
+		}
+	}
+	var listings, hfs []*HfListing
+	got.EachHfListing(func(l *HfListing) { listings = append(listings, l) })
+	want.EachHf(func(hf *HistoricalFigure) { hfs = append(hfs, hf.Listing()) })
+	if len(listings) != len(hfs) {
+		t.Errorf("%d listings, want %d", len(listings), len(hfs))
+	}
+
+	if err := got.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := os.ReadDir(dir); len(files) > 0 {
+		t.Errorf("store not removed: %v", files)
+	}
+}
+
+func TestLinkDescription(t *testing.T) {
+	memory := parseFixture(t)
+	StoreDir = t.TempDir()
+	defer func() { StoreDir = "" }()
+	stored := parseFixture(t)
+	defer stored.Close()
+
+	for _, w := range []*DfWorld{memory, stored} {
+		hf, ok := w.Hf(1)
+		if !ok {
+			t.Fatal("no figure 1")
+		}
+		desc := string(LinkDescription(w, "A form devised by "+strings.ToUpper(hf.descriptionName())+"."))
+		if !strings.Contains(desc, `href="./hf/1"`) {
+			t.Errorf("figure not linked (store %v): %s", w.Store != nil, desc)
+		}
+	}
+}
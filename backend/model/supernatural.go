@@ -0,0 +1,167 @@
+package model
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var supernaturalKinds = []string{"vampire", "werebeast", "necromancer", "ghost"}
+
+type Supernatural struct {
+	Figures []*SupernaturalFigure
+	Towers  []*SupernaturalTower
+	// Counts lists the number of newly afflicted figures per year and kind
+	Counts *StatTable
+}
+
+type SupernaturalFigure struct {
+	Hf   *HistoricalFigure
+	Kind string
+	// Since is the year of the curse, the first secret learned or the death of a ghost
+	Since int
+	// EventId is the curse or secret event, -1 if unknown
+	EventId int
+	// SourceHfid is the cursing deity or the teacher, -1 if unknown
+	SourceHfid int
+	// SourceArtifactId is the book the secret was learned from, -1 if unknown
+	SourceArtifactId int
+	Towers           []int
+}
+
+type SupernaturalTower struct {
+	SiteId      int
+	BuilderHfid int
+}
+
+func NewSupernatural(w *DfWorld) *Supernatural {
+	s := &Supernatural{}
+
+	// the first curse or secret event per figure and kind, processEvents
+	// keeps the year of the same event
+	type key struct {
+		hfid int
+		kind string
+	}
+	sources := make(map[key]*HistoricalEvent)
+	events := w.EventsMatching(func(d HistoricalEventDetails) bool {
+		switch x := d.(type) {
+		case *HistoricalEventHfDoesInteraction:
+			return strings.HasPrefix(x.Interaction, "DEITY_CURSE_WEREBEAST_") || strings.HasPrefix(x.Interaction, "DEITY_CURSE_VAMPIRE_")
+		case *HistoricalEventHfLearnsSecret:
+			return strings.HasPrefix(x.Interaction, "SECRET_")
+		case *HistoricalEventCreatedSite:
+			return true
+		}
+		return false
+	})
+	sort.Slice(events, func(i, j int) bool { return events[i].Id_ < events[j].Id_ })
+
+	towers := make(map[int][]int)
+	for _, e := range events {
+		switch d := e.Details.(type) {
+		case *HistoricalEventHfDoesInteraction:
+			kind := "werebeast"
+			if strings.HasPrefix(d.Interaction, "DEITY_CURSE_VAMPIRE_") {
+				kind = "vampire"
+			}
+			if _, ok := sources[key{d.TargetHfid, kind}]; !ok {
+				sources[key{d.TargetHfid, kind}] = e
+			}
+		case *HistoricalEventHfLearnsSecret:
+			if _, ok := sources[key{d.StudentHfid, "necromancer"}]; !ok {
+				sources[key{d.StudentHfid, "necromancer"}] = e
+			}
+		case *HistoricalEventCreatedSite:
+			if site, ok := w.Sites[d.SiteId]; ok && site.Type_ == SiteType_Tower {
+				s.Towers = append(s.Towers, &SupernaturalTower{SiteId: d.SiteId, BuilderHfid: d.BuilderHfid})
+				if d.BuilderHfid != -1 {
+					towers[d.BuilderHfid] = append(towers[d.BuilderHfid], d.SiteId)
+				}
+			}
+		}
+	}
+
+	for _, site := range w.Sites {
+		if site.Type_ != SiteType_Tower {
+			continue
+		}
+		known := false
+		for _, t := range s.Towers {
+			known = known || t.SiteId == site.Id_
+		}
+		if !known {
+			s.Towers = append(s.Towers, &SupernaturalTower{SiteId: site.Id_, BuilderHfid: -1})
+		}
+	}
+	sort.Slice(s.Towers, func(i, j int) bool { return s.Towers[i].SiteId < s.Towers[j].SiteId })
+
+	counts := newStatCounter(supernaturalKinds...)
+	add := func(hf *HistoricalFigure, kind string, since int) {
+		f := &SupernaturalFigure{Hf: hf, Kind: kind, Since: since, EventId: -1, SourceHfid: -1, SourceArtifactId: -1}
+		if e, ok := sources[key{hf.Id_, kind}]; ok {
+			f.EventId = e.Id_
+			switch d := e.Details.(type) {
+			case *HistoricalEventHfDoesInteraction:
+				f.SourceHfid = d.DoerHfid
+			case *HistoricalEventHfLearnsSecret:
+				f.SourceHfid, f.SourceArtifactId = d.TeacherHfid, d.ArtifactId
+			}
+		}
+		if kind == "necromancer" {
+			f.Towers = towers[hf.Id_]
+		}
+		s.Figures = append(s.Figures, f)
+		if since != -1 {
+			counts.add(strconv.Itoa(since), kind)
+		}
+	}
+	w.EachHf(func(hf *HistoricalFigure) {
+		if hf.Vampire {
+			add(hf, "vampire", hf.VampireSince)
+		}
+		if hf.Werebeast {
+			add(hf, "werebeast", hf.WerebeastSince)
+		}
+		if hf.Necromancer {
+			add(hf, "necromancer", hf.NecromancerSince)
+		}
+		if hf.Ghost {
+			add(hf, "ghost", hf.DeathYear)
+		}
+	})
+	sort.Slice(s.Figures, func(i, j int) bool {
+		a, b := s.Figures[i], s.Figures[j]
+		if a.Since != b.Since {
+			return a.Since < b.Since
+		}
+		if a.Hf.Id_ != b.Hf.Id_ {
+			return a.Hf.Id_ < b.Hf.Id_
+		}
+		return a.Kind < b.Kind
+	})
+
+	s.Counts = counts.table("supernatural-by-year", "Afflictions per Year", "year", false)
+	sort.Slice(s.Counts.Rows, func(i, j int) bool {
+		a, _ := strconv.Atoi(s.Counts.Rows[i].Label)
+		b, _ := strconv.Atoi(s.Counts.Rows[j].Label)
+		return a < b
+	})
+
+	return s
+}
+
+// Count returns the number of figures of the given kind.
+func (s *Supernatural) Count(kind string) int {
+	n := 0
+	for _, f := range s.Figures {
+		if f.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *Supernatural) Kinds() []string {
+	return supernaturalKinds
+}
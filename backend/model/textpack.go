@@ -0,0 +1,353 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+// Texts is the text pack used for the sentences of events and collections.
+// The built-in sentences are used if it is nil or has no text for a type.
+var Texts *TextPack
+
+// TextPack replaces the sentences of events and collections with templates,
+// to restyle or translate them without recompiling. A pack is a json file
+// with templates by event and collection type:
+//
+//	{
+//		"name": "plain",
+//		"events": {
+//			"hf died": "{{ hf .Hfid }} died{{ location .SiteId \" in\" .SubregionId \" in\" }}"
+//		},
+//		"collections": {
+//			"duel": "the {{ link \"duel\" }} of {{ hf .AttackingHfid }} and {{ hf .DefendingHfid }}"
+//		}
+//	}
+//
+// The templates get the details of the event or collection, so all their
+// fields can be used, and the functions of textFuncs to link objects and to
+// choose words by gender or number.
+type TextPack struct {
+	Name        string
+	events      map[string]*template.Template
+	collections map[string]*template.Template
+	renderers   sync.Pool
+}
+
+// textRenderer renders the templates of a text pack in one goroutine at a
+// time. Its templates are cloned once, with the functions bound to ctx and
+// collection, which are set for each sentence.
+type textRenderer struct {
+	ctx        Context
+	collection *HistoricalEventCollection
+	templates  map[*template.Template]*template.Template
+}
+
+type textPackFile struct {
+	Name        string            `json:"name"`
+	Events      map[string]string `json:"events"`
+	Collections map[string]string `json:"collections"`
+}
+
+// LoadTextPack reads and validates a text pack.
+func LoadTextPack(path string) (*TextPack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTextPack(data)
+}
+
+// ParseTextPack parses a text pack and renders every template once with
+// empty details, so unknown types, fields and functions are reported when
+// the pack is loaded.
+func ParseTextPack(data []byte) (*TextPack, error) {
+	var f textPackFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("text pack: %w", err)
+	}
+	p := &TextPack{
+		Name:        f.Name,
+		events:      make(map[string]*template.Template),
+		collections: make(map[string]*template.Template),
+	}
+
+	c := &Context{World: &DfWorld{}, HfId: -1}
+	for _, t := range util.Keys(f.Events) {
+		newDetails, ok := historicalEventTypes[t]
+		if !ok {
+			return nil, fmt.Errorf("text pack %s: unknown event type %q", p.Name, t)
+		}
+		tmpl, err := template.New(t).Funcs(textFuncs(&textRenderer{})).Parse(f.Events[t])
+		if err != nil {
+			return nil, fmt.Errorf("text pack %s: %w", p.Name, err)
+		}
+		p.events[t] = tmpl
+		if _, _, err := p.event(c, newDetails()); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range util.Keys(f.Collections) {
+		newDetails, ok := historicalEventCollectionTypes[t]
+		if !ok {
+			return nil, fmt.Errorf("text pack %s: unknown collection type %q", p.Name, t)
+		}
+		tmpl, err := template.New(t).Funcs(textFuncs(&textRenderer{})).Parse(f.Collections[t])
+		if err != nil {
+			return nil, fmt.Errorf("text pack %s: %w", p.Name, err)
+		}
+		p.collections[t] = tmpl
+		e := NewHistoricalEventCollection()
+		e.Details = newDetails()
+		if _, _, err := p.collection(c, e); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// event renders the text of an event, if the pack has one for its type.
+func (p *TextPack) event(c *Context, d HistoricalEventDetails) (string, bool, error) {
+	t, ok := p.events[d.Type()]
+	if !ok {
+		return "", false, nil
+	}
+	s, err := p.execute(t, c, nil, d)
+	return s, true, err
+}
+
+// collection renders the text of a collection, if the pack has one for its
+// type.
+func (p *TextPack) collection(c *Context, e *HistoricalEventCollection) (string, bool, error) {
+	t, ok := p.collections[e.Type()]
+	if !ok || e.Details == nil {
+		return "", false, nil
+	}
+	s, err := p.execute(t, c, e, e.Details)
+	return s, true, err
+}
+
+func (p *TextPack) execute(t *template.Template, c *Context, e *HistoricalEventCollection, data any) (string, error) {
+	r, _ := p.renderers.Get().(*textRenderer)
+	if r == nil {
+		r = &textRenderer{templates: make(map[*template.Template]*template.Template)}
+	}
+	defer func() {
+		r.ctx, r.collection = Context{}, nil
+		p.renderers.Put(r)
+	}()
+	r.ctx, r.collection = *c, e
+
+	rt, ok := r.templates[t]
+	if !ok {
+		var err error
+		if rt, err = t.Clone(); err != nil {
+			return "", err
+		}
+		rt.Funcs(textFuncs(r))
+		r.templates[t] = rt
+	}
+	var buf bytes.Buffer
+	if err := rt.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("text pack %s: %w", p.Name, err)
+	}
+	return buf.String(), nil
+}
+
+// Check renders all events and collections of the world and returns the
+// errors, one per type.
+func (p *TextPack) Check(w *DfWorld) []error {
+	c := &Context{World: w, HfId: -1}
+	errors := make(map[string]error)
+	w.EachEvent(func(e *HistoricalEvent) {
+		if _, ok := errors[e.Details.Type()]; !ok {
+			if _, _, err := p.event(c.WithEvent(e), e.Details); err != nil {
+				errors[e.Details.Type()] = fmt.Errorf("event %d: %w", e.Id_, err)
+			}
+		}
+	})
+	for _, e := range w.HistoricalEventCollections {
+		if _, ok := errors["collection "+e.Type()]; !ok {
+			if _, _, err := p.collection(c, e); err != nil {
+				errors["collection "+e.Type()] = fmt.Errorf("collection %d: %w", e.Id_, err)
+			}
+		}
+	}
+
+	var list []error
+	for _, t := range util.Keys(errors) {
+		list = append(list, errors[t])
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Error() < list[j].Error() })
+	return list
+}
+
+// textFuncs are the functions of the templates of text packs, bound to the
+// context and collection, if any, of the renderer.
+func textFuncs(r *textRenderer) template.FuncMap {
+	c := &r.ctx
+	return template.FuncMap{
+		"hf":                c.hf,
+		"hfShort":           c.hfShort,
+		"hfUnrelated":       c.hfUnrelated,
+		"hfRelated":         c.hfRelated,
+		"hfList":            c.hfList,
+		"artifact":          c.artifact,
+		"entity":            c.entity,
+		"entityList":        c.entityList,
+		"position":          c.position,
+		"site":              func(id int) string { return strings.TrimPrefix(c.site(id, ""), " ") },
+		"structure":         c.structure,
+		"property":          c.property,
+		"region":            c.region,
+		"location":          c.location,
+		"place":             c.place,
+		"mountain":          c.mountain,
+		"landmass":          c.landmass,
+		"identity":          c.identity,
+		"danceForm":         c.danceForm,
+		"musicalForm":       c.musicalForm,
+		"poeticForm":        c.poeticForm,
+		"worldConstruction": c.worldConstruction,
+		"writtenContent":    c.writtenContent,
+		"collection":        c.collection,
+		"honor": func(entityId, honorId int) string {
+			if e, ok := c.World.Entities[entityId]; ok && honorId >= 0 && honorId < len(e.Honor) {
+				return e.Honor[honorId].Name()
+			}
+			return ""
+		},
+		"occasion": func(civId, occasionId int) string {
+			if o := c.occasion(civId, occasionId); o != nil {
+				return o.Name()
+			}
+			return ""
+		},
+		"schedule": func(civId, occasionId, scheduleId int) string {
+			if s := c.occasionSchedule(civId, occasionId, scheduleId); s != nil {
+				return c.schedule(s)
+			}
+			return ""
+		},
+		// features lists what a scheduled part of an occasion featured
+		"features": func(civId, occasionId, scheduleId int) string {
+			if s := c.occasionSchedule(civId, occasionId, scheduleId); s != nil {
+				return c.andList(util.Map(s.Feature, c.feature))
+			}
+			return ""
+		},
+		"link": func(s string) string {
+			if r.collection == nil {
+				return s
+			}
+			return r.collection.Link(s)
+		},
+
+		"female": func(id int) bool {
+			hf, ok := c.World.Hf(id)
+			return ok && hf.Female()
+		},
+		"male": func(id int) bool {
+			hf, ok := c.World.Hf(id)
+			return ok && hf.Male()
+		},
+		// gender chooses the male, female or, if given, other form
+		"gender": func(id int, male, female string, other ...string) string {
+			if hf, ok := c.World.Hf(id); ok {
+				if hf.Female() {
+					return female
+				}
+				if hf.Male() || len(other) == 0 {
+					return male
+				}
+				return other[0]
+			}
+			if len(other) > 0 {
+				return other[0]
+			}
+			return male
+		},
+		"pronoun":    c.pronoun,
+		"possessive": c.posessivePronoun,
+		// plural chooses the form for one or many
+		"plural": func(n int, one, many string) string {
+			if n == 1 {
+				return one
+			}
+			return many
+		},
+		"sub": func(a, b int) int { return a - b },
+		"div": func(a, b int) int {
+			if b == 0 {
+				return 0
+			}
+			return a / b
+		},
+		"mod": func(a, b int) int {
+			if b == 0 {
+				return 0
+			}
+			return a % b
+		},
+		"story":   func() bool { return c.Story },
+		"ord":     c.ord,
+		"time":    c.time,
+		"title":   util.Title,
+		"andList": c.andList,
+		"tr":      c.t,
+	}
+}
+
+func (c *Context) occasion(civId, occasionId int) *Occasion {
+	if e, ok := c.World.Entities[civId]; ok && occasionId >= 0 && occasionId < len(e.Occasion) {
+		return e.Occasion[occasionId]
+	}
+	return nil
+}
+
+func (c *Context) occasionSchedule(civId, occasionId, scheduleId int) *Schedule {
+	if o := c.occasion(civId, occasionId); o != nil && scheduleId >= 0 && scheduleId < len(o.Schedule) {
+		return o.Schedule[scheduleId]
+	}
+	return nil
+}
+
+// texts are the text packs for the sentences, the one of the locale before
+// the one loaded by the user.
+func (c *Context) texts() []*TextPack {
+	var packs []*TextPack
+	if c.Locale != nil && c.Locale.Texts != nil {
+		packs = append(packs, c.Locale.Texts)
+	}
+	if Texts != nil {
+		packs = append(packs, Texts)
+	}
+	return packs
+}
+
+// EventHtml returns the sentence of an event from the text packs, if they
+// have one, or the built-in sentence.
+func (c *Context) EventHtml(d HistoricalEventDetails) string {
+	for _, p := range c.texts() {
+		if s, ok, err := p.event(c, d); ok && err == nil {
+			return s
+		}
+	}
+	return d.Html(c)
+}
+
+func (c *Context) collectionHtml(e *HistoricalEventCollection) string {
+	for _, p := range c.texts() {
+		if s, ok, err := p.collection(c, e); ok && err == nil {
+			return s
+		}
+	}
+	return e.Details.Html(e, c)
+}
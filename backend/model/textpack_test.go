@@ -0,0 +1,57 @@
+package model
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTextPackContext(t *testing.T) {
+	p, err := ParseTextPack([]byte(`{
+		"name": "test",
+		"events": {
+			"hf died": "{{ hf .Hfid }} died{{ if story }} in a story{{ end }}"
+		},
+		"collections": {
+			"duel": "the {{ link \"duel\" }}"
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := localeTestWorld()
+	d := NewHistoricalEventHfDied()
+	d.Hfid = 1
+
+	// the templates are reused, so each sentence must see its own context
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(story bool) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				s, ok, err := p.event(&Context{World: w, HfId: -1, Story: story}, d)
+				if !ok || err != nil {
+					t.Errorf("event not rendered: %v", err)
+					return
+				}
+				if !strings.Contains(s, "Figure 1") || strings.Contains(s, "in a story") != story {
+					t.Errorf("story %v: %q", story, s)
+					return
+				}
+			}
+		}(i%2 == 0)
+	}
+	wg.Wait()
+
+	c := &Context{World: w, HfId: -1}
+	e := NewHistoricalEventCollection()
+	e.Id_ = 7
+	e.Details = NewHistoricalEventCollectionDuel()
+	if s, _, err := p.collection(c, e); err != nil || s != "the "+e.Link("duel") {
+		t.Errorf("collection: %q, %v", s, err)
+	}
+	if s, _, err := p.event(c, d); err != nil || strings.Contains(s, "collection") {
+		t.Errorf("event after collection: %q, %v", s, err)
+	}
+}
@@ -0,0 +1,185 @@
+package model
+
+import (
+	"fmt"
+	"html/template"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Stay is an interval of the life of a historical figure spent at one
+// location.
+type Stay struct {
+	StartYear   int    `json:"startYear"`
+	EndYear     int    `json:"endYear"`
+	SiteId      int    `json:"siteId"`
+	StructureId int    `json:"structureId"`
+	SubregionId int    `json:"subregionId"`
+	Coords      string `json:"coords,omitempty"`
+	Kind        string `json:"kind"`
+	EntityId    int    `json:"entityId"`
+	EventId     int    `json:"eventId"`
+	// JourneyId is the journey collection the figure was on, -1 if none
+	JourneyId int `json:"journeyId"`
+}
+
+type TravelPath struct {
+	Hfid  int     `json:"hfid"`
+	Stays []*Stay `json:"stays"`
+}
+
+func NewTravelPath(w *DfWorld, hf *HistoricalFigure) *TravelPath {
+	p := &TravelPath{Hfid: hf.Id_}
+
+	// entities the figure is a member of move it along when relocating
+	var entities []int
+	for _, l := range hf.EntityLink {
+		if l.LinkType == HistoricalFigureEntityLinkLinkType_Member {
+			entities = append(entities, l.EntityId)
+		}
+	}
+
+	events := w.EventsMatching(func(d HistoricalEventDetails) bool {
+		switch x := d.(type) {
+		case *HistoricalEventHfTravel:
+			return slices.Contains(x.GroupHfid, hf.Id_)
+		case *HistoricalEventChangeHfState:
+			return x.Hfid == hf.Id_
+		case *HistoricalEventAddHfSiteLink:
+			return x.Histfig == hf.Id_
+		case *HistoricalEventEntityRelocate:
+			return slices.Contains(entities, x.EntityId)
+		}
+		return false
+	})
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Year != events[j].Year {
+			return events[i].Year < events[j].Year
+		}
+		if events[i].Seconds72 != events[j].Seconds72 {
+			return events[i].Seconds72 < events[j].Seconds72
+		}
+		return events[i].Id_ < events[j].Id_
+	})
+
+	var current *Stay
+	move := func(e *HistoricalEvent, kind string, siteId, structureId, subregionId int, coords string) *Stay {
+		if coords == "-1,-1" {
+			coords = ""
+		}
+		if siteId == -1 && subregionId == -1 && coords == "" {
+			return nil
+		}
+		if current != nil && current.SiteId == siteId && current.SubregionId == subregionId && current.Coords == coords {
+			if structureId != -1 {
+				current.StructureId = structureId
+			}
+			return current
+		}
+		s := &Stay{
+			StartYear:   e.Year,
+			EndYear:     -1,
+			SiteId:      siteId,
+			StructureId: structureId,
+			SubregionId: subregionId,
+			Coords:      coords,
+			Kind:        kind,
+			EntityId:    -1,
+			EventId:     e.Id_,
+			JourneyId:   -1,
+		}
+		if col, ok := w.HistoricalEventCollections[e.Collection]; ok {
+			if _, ok := col.Details.(*HistoricalEventCollectionJourney); ok {
+				s.JourneyId = col.Id_
+			}
+		}
+		if current != nil {
+			current.EndYear = e.Year
+		}
+		p.Stays = append(p.Stays, s)
+		current = s
+		return s
+	}
+
+	for _, e := range events {
+		switch d := e.Details.(type) {
+		case *HistoricalEventHfTravel:
+			kind := "travelled"
+			if d.Return {
+				kind = "returned"
+			}
+			move(e, kind, d.SiteId, -1, d.SubregionId, d.Coords)
+		case *HistoricalEventChangeHfState:
+			move(e, d.State.String(), d.SiteId, -1, d.SubregionId, d.Coords)
+		case *HistoricalEventAddHfSiteLink:
+			move(e, d.LinkType.String(), d.SiteId, d.Structure, -1, "")
+		case *HistoricalEventEntityRelocate:
+			if s := move(e, "relocated", d.SiteId, d.StructureId, -1, ""); s != nil && s.EventId == e.Id_ {
+				s.EntityId = d.EntityId
+			}
+		}
+	}
+
+	if current != nil && hf.DeathYear != -1 {
+		current.EndYear = hf.DeathYear
+	}
+	return p
+}
+
+// Sites lists the sites along the path, repeated visits of the same site in
+// a row are collapsed.
+func (p *TravelPath) Sites() []int {
+	var sites []int
+	for _, s := range p.Stays {
+		if s.SiteId != -1 && (len(sites) == 0 || sites[len(sites)-1] != s.SiteId) {
+			sites = append(sites, s.SiteId)
+		}
+	}
+	return sites
+}
+
+// siteCenter returns the center of a site in map coordinates.
+func siteCenter(site *Site) (float64, float64, bool) {
+	coords := strings.Split(site.Rectangle, ":")
+	if len(coords) != 2 {
+		return 0, 0, false
+	}
+	c1 := strings.Split(coords[0], ",")
+	c2 := strings.Split(coords[1], ",")
+	if len(c1) != 2 || len(c2) != 2 {
+		return 0, 0, false
+	}
+	x1, _ := strconv.ParseFloat(c1[0], 32)
+	y1, _ := strconv.ParseFloat(c1[1], 32)
+	x2, _ := strconv.ParseFloat(c2[0], 32)
+	y2, _ := strconv.ParseFloat(c2[1], 32)
+	return (x1 + x2) / 32.0, (y1+y2)/32.0 - 1, true
+}
+
+var AddMapTravelPath = func(w *DfWorld, p *TravelPath) template.HTML {
+	r := ""
+	added := make(map[int]bool)
+	var trail []string
+	for _, s := range p.Stays {
+		if site, ok := w.Sites[s.SiteId]; ok {
+			if !added[s.SiteId] {
+				r += string(AddMapSite(w, s.SiteId, false))
+				added[s.SiteId] = true
+			}
+			if x, y, ok := siteCenter(site); ok {
+				trail = append(trail, fmt.Sprintf(`coord(%f,%f)`, x, y))
+			}
+		} else if c := parseCoords(s.Coords); len(c) == 1 {
+			trail = append(trail, fmt.Sprintf(`coord(%f,%f)`, float64(c[0].X)+0.5, float64(c[0].Y)-0.5))
+		}
+	}
+	if len(trail) > 1 {
+		r += "<script>"
+		r += "L.polyline([" + strings.Join(trail, ",") + "], {color: '#0af', opacity: 1, weight: 3, dashArray: '6 6'}).addTo(map);"
+		r += "</script>"
+	}
+	return template.HTML(r)
+}
@@ -0,0 +1,42 @@
+//go:build parquet
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/export"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+	"github.com/spf13/cobra"
+)
+
+var exportParquetCmd = &cobra.Command{
+	Use:   "export-parquet world-legends.xml directory",
+	Short: "Export a world to parquet files",
+	Long: `Export a world to a directory with a parquet file for every type of object,
+event and collection, to be read with pandas, polars or other data frame
+libraries. The file of an event type holds the columns of all events, like
+year and seconds72, followed by the columns of the type. Nested objects
+reference their parent row with parent_id.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		world, err := model.Parse(args[0], nil)
+		if err != nil {
+			fatal(err)
+		}
+		defer world.Close()
+
+		start := time.Now()
+		if err := export.ExportParquet(world, args[1]); err != nil {
+			fatal(err)
+		}
+		fmt.Printf("exported to %s in %v\n", args[1], time.Since(start))
+	},
+}
+
+// the parquet export is only built with the tag parquet, as arrow makes the
+// binary much larger
+func init() {
+	rootCmd.AddCommand(exportParquetCmd)
+}
@@ -8,14 +8,16 @@ import (
 )
 
 type Config struct {
-	path           string
-	LastPath       string
-	LastFile       string
-	Port           int    `json:"-"`
-	ServerMode     bool   `json:"-"`
-	SubUri         string `json:"-"`
-	DebugTemplates bool   `json:"DebugTemplates,omitempty"`
-	DebugJSON      bool   `json:"DebugJSON,omitempty"`
+	path             string
+	LastPath         string
+	LastFile         string
+	Port             int    `json:"-"`
+	ServerMode       bool   `json:"-"`
+	SubUri           string `json:"-"`
+	DebugTemplates   bool   `json:"DebugTemplates,omitempty"`
+	DebugJSON        bool   `json:"DebugJSON,omitempty"`
+	RevealIdentities bool   `json:"RevealIdentities,omitempty"`
+	TextPack         string `json:"TextPack,omitempty"`
 }
 
 func LoadConfig(path string) (*Config, error) {
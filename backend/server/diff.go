@@ -0,0 +1,97 @@
+package server
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"runtime"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+)
+
+type diffPage struct {
+	Diff  *model.WorldDiff
+	Dir   string
+	Files []fs.FileInfo
+	Error string
+}
+
+// diffPage shows the last diff or a list of exports next to the loaded world
+// to compare it to.
+func (srv *DfServer) diffPage(p Parms) any {
+	world := srv.context.world()
+	srv.context.diffLock.Lock()
+	defer srv.context.diffLock.Unlock()
+	if srv.context.diffError != nil {
+		return &diffPage{Error: srv.context.diffError.Error()}
+	}
+	if srv.context.diff != nil || srv.context.config.ServerMode {
+		return &diffPage{Diff: srv.context.diff}
+	}
+
+	page := &diffPage{Dir: filepath.Dir(world.FilePath)}
+	files, err := ioutil.ReadDir(page.Dir)
+	if err != nil {
+		page.Error = err.Error()
+		return page
+	}
+	for _, f := range files {
+		if isLegendsXml(f) && filepath.Join(page.Dir, f.Name()) != world.FilePath {
+			page.Files = append(page.Files, f)
+		}
+	}
+	return page
+}
+
+// loadDiff compares the loaded world to an older export given by the old
+// parameter. The export is parsed in the background while the progress is
+// shown, like loading a world, and the diff is shown once it is done.
+func (srv *DfServer) loadDiff(w http.ResponseWriter, r *http.Request) {
+	srv.context.diffLock.Lock()
+	// read with diffLock held, so the world is not replaced while the diff
+	// is loaded
+	world := srv.context.world()
+	if world == nil {
+		srv.context.diffLock.Unlock()
+		srv.renderLoading(w, r)
+		return
+	}
+	if old := r.URL.Query().Get("old"); !srv.context.config.ServerMode && !srv.context.diffLoading && old != srv.context.diffFile {
+		srv.context.diffFile = old
+		srv.context.diff, srv.context.diffError = nil, nil
+		srv.context.diffLoading = true
+		go loadDiff(srv, world, old)
+	}
+	loading := srv.context.diffLoading
+	srv.context.diffLock.Unlock()
+
+	if loading {
+		err := srv.render(w, r, "loading.html", srv.loader.Progress())
+		if err != nil {
+			httpError(w, err)
+		}
+		return
+	}
+	http.Redirect(w, r, srv.context.config.SubUri+"/diff", http.StatusSeeOther)
+}
+
+func loadDiff(server *DfServer, world *model.DfWorld, file string) {
+	runtime.GC()
+	diff, err := diffWorld(file, world, server.context.diffProgress)
+	server.context.diffLock.Lock()
+	server.context.diff, server.context.diffError = diff, err
+	server.context.diffLoading = false
+	server.context.diffLock.Unlock()
+}
+
+// diffWorld parses an older export and compares it to world. Only the diff
+// is kept, the old world is garbage once this returns.
+func diffWorld(oldFile string, world *model.DfWorld, lp *model.LoadProgress) (*model.WorldDiff, error) {
+	old, err := model.Parse(oldFile, lp)
+	if err != nil {
+		return nil, err
+	}
+	defer old.Close()
+	return model.NewWorldDiff(old, world), nil
+}
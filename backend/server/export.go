@@ -0,0 +1,171 @@
+package server
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+type exportTable struct {
+	Columns []string
+	Rows    [][]string
+}
+
+func (t *exportTable) records() [][]string {
+	return append([][]string{t.Columns}, t.Rows...)
+}
+
+func isExportFormat(format string) bool {
+	return format == "csv" || format == "xlsx"
+}
+
+func (srv *DfServer) export(w http.ResponseWriter, r *http.Request, name string, data any, format string) {
+	table := srv.exportTable(data)
+	if table == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch format {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, name))
+		w.WriteHeader(http.StatusOK)
+		csv.NewWriter(w).WriteAll(table.records())
+	case "xlsx":
+		w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.xlsx"`, name))
+		w.WriteHeader(http.StatusOK)
+		if err := util.WriteXlsx(w, name, table.records()); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+func (srv *DfServer) exportTable(data any) *exportTable {
+	world := srv.context.world()
+	switch x := data.(type) {
+	case map[string]any:
+		if hfs, ok := x["Hfs"].([]*model.HistoricalFigure); ok {
+			return exportList(hfs, hfColumns, func(hf *model.HistoricalFigure) []string { return hfRow(world, hf) })
+		}
+	case []*model.HistoricalFigure:
+		return exportList(x, hfColumns, func(hf *model.HistoricalFigure) []string { return hfRow(world, hf) })
+	case map[string][]*model.Site:
+		return exportGroups(x, []string{"id", "name", "type", "owner", "ruin", "coords", "structures"}, func(s *model.Site) []string {
+			return []string{strconv.Itoa(s.Id_), util.Title(s.Name()), s.Type(), entityName(world, s.Owner), strconv.FormatBool(s.Ruin), s.Coords, strconv.Itoa(len(s.Structures))}
+		})
+	case map[string][]*model.Entity:
+		return exportGroups(x, []string{"id", "name", "type", "race", "parent", "sites", "members"}, func(e *model.Entity) []string {
+			return []string{strconv.Itoa(e.Id_), util.Title(e.Name()), e.Type(), e.Race, entityName(world, e.Parent), strconv.Itoa(len(e.Sites)), strconv.Itoa(len(e.HistfigId))}
+		})
+	case map[string][]*model.Artifact:
+		return exportGroups(x, []string{"id", "name", "type", "item type", "item subtype", "material", "holder", "site"}, func(a *model.Artifact) []string {
+			return []string{strconv.Itoa(a.Id_), util.Title(a.Name()), a.Type(), a.ItemType, a.ItemSubtype, a.Mat, hfName(world, a.HolderHfid), siteName(world, a.SiteId)}
+		})
+	case *model.EventTypeList:
+		return exportList(x.Events, eventColumns, func(e *model.HistoricalEvent) []string { return eventRow(world, e) })
+	case []*model.HistoricalEvent:
+		return exportList(x, eventColumns, func(e *model.HistoricalEvent) []string { return eventRow(world, e) })
+	case map[string][]*model.HistoricalEventCollection:
+		return exportGroups(x, []string{"id", "name", "start", "end", "events", "collections"}, func(c *model.HistoricalEventCollection) []string {
+			return []string{strconv.Itoa(c.Id_), util.PlainText(c.Html(&model.Context{World: world, HfId: -1})),
+				model.Time(c.StartYear, c.StartSeconds72), model.Time(c.EndYear, c.EndSeconds72), strconv.Itoa(len(c.Event)), strconv.Itoa(len(c.Eventcol))}
+		})
+	}
+	return nil
+}
+
+var hfColumns = []string{"id", "name", "race", "caste", "birth", "death", "kills", "flags"}
+
+func hfRow(world *model.DfWorld, hf *model.HistoricalFigure) []string {
+	var flags []string
+	for f, v := range map[string]bool{
+		"leader": hf.Leader, "deity": hf.Deity, "force": hf.Force, "vampire": hf.Vampire, "werebeast": hf.Werebeast,
+		"necromancer": hf.Necromancer, "ghost": hf.Ghost, "adventurer": hf.Adventurer,
+	} {
+		if v {
+			flags = append(flags, f)
+		}
+	}
+	sort.Strings(flags)
+	return []string{strconv.Itoa(hf.Id_), util.Title(hf.Name()), hf.Race, strings.ToLower(hf.Caste),
+		strconv.Itoa(hf.BirthYear), strconv.Itoa(hf.DeathYear), strconv.Itoa(len(hf.Kills)), strings.Join(flags, " ")}
+}
+
+var eventColumns = []string{"id", "year", "time", "type", "collection", "text"}
+
+func eventRow(world *model.DfWorld, e *model.HistoricalEvent) []string {
+	c := &model.Context{World: world, HfId: -1}
+	return []string{strconv.Itoa(e.Id_), strconv.Itoa(e.Year), model.Time(e.Year, e.Seconds72), e.Details.Type(),
+		strconv.Itoa(e.Collection), util.PlainText(c.WithEvent(e).EventHtml(e.Details))}
+}
+
+func exportList[T any](list []T, columns []string, row func(T) []string) *exportTable {
+	return &exportTable{Columns: columns, Rows: util.Map(list, row)}
+}
+
+func exportGroups[T any](groups map[string][]T, columns []string, row func(T) []string) *exportTable {
+	keys := util.Keys(groups)
+	sort.Strings(keys)
+
+	t := &exportTable{Columns: append([]string{"group"}, columns...)}
+	for _, k := range keys {
+		for _, v := range groups[k] {
+			t.Rows = append(t.Rows, append([]string{k}, row(v)...))
+		}
+	}
+	return t
+}
+
+func hfName(world *model.DfWorld, id int) string {
+	if x, ok := world.Hf(id); ok {
+		return util.Title(x.Name())
+	}
+	return ""
+}
+
+func entityName(world *model.DfWorld, id int) string {
+	if x, ok := world.Entities[id]; ok {
+		return util.Title(x.Name())
+	}
+	return ""
+}
+
+func siteName(world *model.DfWorld, id int) string {
+	if x, ok := world.Sites[id]; ok {
+		return util.Title(x.Name())
+	}
+	return ""
+}
+
+func (srv *DfServer) chronicleDownload(p Parms) (string, string, []byte) {
+	id, _ := strconv.Atoi(p["id"])
+	entity, ok := srv.context.world().Entities[id]
+	if !ok {
+		return "", "", nil
+	}
+	chronicle := model.NewChronicle(srv.context.world(), entity)
+	name := "chronicle-" + strcase.ToKebab(entity.Name())
+	switch p["format"] {
+	case "md":
+		return name + ".md", "text/markdown; charset=utf-8", []byte(chronicle.Markdown())
+	case "html":
+		return name + ".html", "text/html; charset=utf-8", []byte(chronicle.Html())
+	case "epub":
+		book, err := chronicle.Epub()
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil
+		}
+		return name + ".epub", "application/epub+zip", book
+	}
+	return "", "", nil
+}
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	hfPageSize    = 100
+	hfMaxPageSize = 1000
+)
+
+// hfListingFilter filters the figures by their listings, hfFilter needs the
+// whole figure, which might have to be loaded from a store.
+type hfListingFilter func(*model.HfListing) bool
+
+type hfFilter func(*model.HistoricalFigure) bool
+
+type hfComparator func(a, b *model.HfListing) int
+
+// hfSortKeys compare in the natural order of each key, a "-" prefix in the
+// sort parameter reverses it. Kills are sorted with the most kills first.
+var hfSortKeys = map[string]hfComparator{
+	"id":    func(a, b *model.HfListing) int { return compareInt(a.Id_, b.Id_) },
+	"name":  func(a, b *model.HfListing) int { return strings.Compare(a.Name_, b.Name_) },
+	"race":  func(a, b *model.HfListing) int { return strings.Compare(a.Race, b.Race) },
+	"caste": func(a, b *model.HfListing) int { return strings.Compare(a.Caste, b.Caste) },
+	"birth": func(a, b *model.HfListing) int { return compareInt(a.BirthYear, b.BirthYear) },
+	"death": func(a, b *model.HfListing) int { return compareInt(a.DeathYear, b.DeathYear) },
+	"kills": func(a, b *model.HfListing) int { return compareInt(b.Kills, a.Kills) },
+}
+
+func (srv *DfServer) searchHf(p Parms) any {
+	var list []*model.HfListing
+
+	world := srv.context.world()
+	listingFilters, filters := srv.hfFilters(p)
+	world.EachHfListing(func(l *model.HfListing) {
+		for _, f := range listingFilters {
+			if !f(l) {
+				return
+			}
+		}
+		if len(filters) > 0 {
+			hf, ok := world.Hf(l.Id_)
+			if !ok {
+				return
+			}
+			for _, f := range filters {
+				if !f(hf) {
+					return
+				}
+			}
+		}
+		list = append(list, l)
+	})
+
+	comparators := hfSort(p)
+	sort.Slice(list, func(i, j int) bool {
+		for _, c := range comparators {
+			if r := c(list[i], list[j]); r != 0 {
+				return r < 0
+			}
+		}
+		return false
+	})
+
+	total := len(list)
+	if isExportFormat(p["format"]) {
+		return map[string]any{
+			"Params": p,
+			"Hfs":    loadHfs(world, list),
+		}
+	}
+
+	size := intParam(p, "pageSize", hfPageSize)
+	if size < 1 || size > hfMaxPageSize {
+		size = hfPageSize
+	}
+	pages := (total + size - 1) / size
+	page := intParam(p, "page", 1)
+	if page > pages {
+		page = pages
+	}
+	if page < 1 {
+		page = 1
+	}
+	from := util.If((page-1)*size < total, (page-1)*size, total)
+	to := util.If(from+size < total, from+size, total)
+
+	return map[string]any{
+		"Params":    p,
+		"Hfs":       loadHfs(world, list[from:to]),
+		"Total":     total,
+		"Page":      page,
+		"Pages":     pages,
+		"PageSize":  size,
+		"PageLinks": pageLinks(page, pages),
+		"SortKeys":  []string{"name", "race", "caste", "birth", "death", "kills", "id"},
+	}
+}
+
+// loadHfs returns the figures of the listings.
+func loadHfs(world *model.DfWorld, list []*model.HfListing) []*model.HistoricalFigure {
+	hfs := make([]*model.HistoricalFigure, 0, len(list))
+	for _, l := range list {
+		if hf, ok := world.Hf(l.Id_); ok {
+			hfs = append(hfs, hf)
+		}
+	}
+	return hfs
+}
+
+func (srv *DfServer) hfFilters(p Parms) ([]hfListingFilter, []hfFilter) {
+	var listingFilters []hfListingFilter
+	var filters []hfFilter
+	flag := func(name string, f hfListingFilter) {
+		if p[name] == "1" {
+			listingFilters = append(listingFilters, f)
+		}
+	}
+	flag("leader", func(hf *model.HfListing) bool { return hf.Leader })
+	flag("deity", func(hf *model.HfListing) bool { return hf.Deity })
+	flag("force", func(hf *model.HfListing) bool { return hf.Force })
+	flag("vampire", func(hf *model.HfListing) bool { return hf.Vampire })
+	flag("werebeast", func(hf *model.HfListing) bool { return hf.Werebeast })
+	flag("necromancer", func(hf *model.HfListing) bool { return hf.Necromancer })
+	flag("alive", func(hf *model.HfListing) bool { return hf.DeathYear == -1 })
+	flag("ghost", func(hf *model.HfListing) bool { return hf.Ghost })
+	flag("adventurer", func(hf *model.HfListing) bool { return hf.Adventurer })
+
+	if race := p["race"]; race != "" {
+		listingFilters = append(listingFilters, func(hf *model.HfListing) bool { return hf.Race == race })
+	}
+	if caste := p["caste"]; caste != "" {
+		listingFilters = append(listingFilters, func(hf *model.HfListing) bool { return strings.EqualFold(hf.Caste, caste) })
+	}
+
+	from, to := intParam(p, "aliveFrom", -1), intParam(p, "aliveTo", -1)
+	if from != -1 || to != -1 {
+		listingFilters = append(listingFilters, func(hf *model.HfListing) bool {
+			if to != -1 && hf.BirthYear > to {
+				return false
+			}
+			return from == -1 || hf.DeathYear == -1 || hf.DeathYear >= from
+		})
+	}
+
+	if id := intParam(p, "entity", -1); id != -1 {
+		filters = append(filters, func(hf *model.HistoricalFigure) bool {
+			_, member := util.Find(hf.EntityLink, func(l *model.HistoricalFigureEntityLink) bool { return l.EntityId == id })
+			_, position := util.Find(hf.EntityPositionLink, func(l *model.EntityPositionLink) bool { return l.EntityId == id })
+			_, former := util.Find(hf.EntityFormerPositionLink, func(l *model.EntityFormerPositionLink) bool { return l.EntityId == id })
+			return member || position || former
+		})
+	}
+	if id := intParam(p, "site", -1); id != -1 {
+		filters = append(filters, func(hf *model.HistoricalFigure) bool {
+			_, ok := util.Find(hf.SiteLink, func(l *model.SiteLink) bool { return l.SiteId == id })
+			return ok
+		})
+	}
+	if position := strings.ToLower(p["position"]); position != "" {
+		world := srv.context.world()
+		held := func(hf *model.HistoricalFigure, entityId, positionId int) bool {
+			if e, ok := world.Entities[entityId]; ok {
+				return strings.Contains(strings.ToLower(e.Position(positionId).GenderName(hf)), position)
+			}
+			return false
+		}
+		filters = append(filters, func(hf *model.HistoricalFigure) bool {
+			for _, l := range hf.EntityPositionLink {
+				if held(hf, l.EntityId, l.PositionProfileId) {
+					return true
+				}
+			}
+			for _, l := range hf.EntityFormerPositionLink {
+				if held(hf, l.EntityId, l.PositionProfileId) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	if skill, ip := p["skill"], intParam(p, "skillIp", 0); skill != "" || ip > 0 {
+		filters = append(filters, func(hf *model.HistoricalFigure) bool {
+			_, ok := util.Find(hf.HfSkill, func(s *model.HfSkill) bool {
+				return (skill == "" || strings.EqualFold(s.Skill, skill)) && s.TotalIp >= ip
+			})
+			return ok
+		})
+	}
+	if sphere := p["sphere"]; sphere != "" {
+		filters = append(filters, func(hf *model.HistoricalFigure) bool { return slices.Contains(hf.Sphere, sphere) })
+	}
+	if goal := p["goal"]; goal != "" {
+		filters = append(filters, func(hf *model.HistoricalFigure) bool {
+			return slices.IndexFunc(hf.Goal, func(g model.HistoricalFigureGoal) bool { return g.String() == goal }) != -1
+		})
+	}
+
+	return listingFilters, filters
+}
+
+func hfSort(p Parms) []hfComparator {
+	var keys []string
+	for _, param := range []string{"sort", "sort2", "sort3"} {
+		for _, k := range strings.Split(p[param], ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				keys = append(keys, k)
+			}
+		}
+	}
+	keys = append(keys, "name", "id")
+
+	var comparators []hfComparator
+	for _, k := range keys {
+		desc := strings.HasPrefix(k, "-")
+		if c, ok := hfSortKeys[strings.TrimPrefix(k, "-")]; ok {
+			if desc {
+				comparators = append(comparators, func(a, b *model.HfListing) int { return c(b, a) })
+			} else {
+				comparators = append(comparators, c)
+			}
+		}
+	}
+	return comparators
+}
+
+func (srv *DfServer) biographyDownload(p Parms) (string, string, []byte) {
+	id, _ := strconv.Atoi(p["id"])
+	hf, ok := srv.context.world().Hf(id)
+	if !ok {
+		return "", "", nil
+	}
+	bio := model.NewBiography(srv.context.world(), hf)
+	name := strcase.ToKebab(hf.Name())
+	switch p["format"] {
+	case "md":
+		return name + ".md", "text/markdown; charset=utf-8", []byte(bio.Markdown())
+	case "txt":
+		return name + ".txt", "text/plain; charset=utf-8", []byte(bio.Text())
+	}
+	return "", "", nil
+}
+
+func pageLinks(page, pages int) []int {
+	var links []int
+	for i := 1; i <= pages; i++ {
+		if i == 1 || i == pages || (i >= page-3 && i <= page+3) {
+			links = append(links, i)
+		}
+	}
+	return links
+}
+
+func intParam(p Parms, name string, def int) int {
+	if v, err := strconv.Atoi(p[name]); err == nil {
+		return v
+	}
+	return def
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const revealCookie = "reveal"
+
+// requestReveal tells if the user chose to reveal identities, the default
+// is taken from the config.
+func (srv *DfServer) requestReveal(r *http.Request) bool {
+	if c, err := r.Cookie(revealCookie); err == nil {
+		if reveal, err := strconv.ParseBool(c.Value); err == nil {
+			return reveal
+		}
+	}
+	return srv.context.config.RevealIdentities
+}
+
+// revealIdentities remembers if the user wants to see the figures behind
+// identities and goes back to the page it was chosen on.
+func (srv *DfServer) revealIdentities(w http.ResponseWriter, r *http.Request) {
+	reveal, err := strconv.ParseBool(r.FormValue("on"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     revealCookie,
+		Value:    strconv.FormatBool(reveal),
+		Path:     srv.context.config.SubUri + "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, backTo(r, srv.context.config.SubUri+"/identities"), http.StatusSeeOther)
+}
@@ -26,16 +26,20 @@ type loadProgress struct {
 }
 
 func (h loadHandler) Progress() *loadProgress {
-	percent := 0.0
+	h.server.context.diffLock.Lock()
+	diffLoading := h.server.context.diffLoading
+	h.server.context.diffLock.Unlock()
+
 	p := h.server.context.progress
-	if p.ProgressBar != nil {
-		percent = float64(p.ProgressBar.Current()*100) / float64(p.ProgressBar.Total())
+	if diffLoading {
+		p = h.server.context.diffProgress
 	}
+	msg, percent := p.Current()
 
 	return &loadProgress{
-		Msg:      h.server.context.progress.Message,
+		Msg:      msg,
 		Progress: percent,
-		Done:     h.server.context.world != nil,
+		Done:     h.server.context.world() != nil && !diffLoading,
 	}
 }
 
@@ -49,7 +53,7 @@ func (h loadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if h.server.context.config.ServerMode {
-		err := h.server.templates.Render(w, "serverMode.html", nil)
+		err := h.server.render(w, r, "serverMode.html", nil)
 		if err != nil {
 			httpError(w, err)
 		}
@@ -91,7 +95,7 @@ func (h loadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
-			err = h.server.templates.Render(w, "load.html", p)
+			err = h.server.render(w, r, "load.html", p)
 			if err != nil {
 				httpError(w, err)
 			}
@@ -100,8 +104,14 @@ func (h loadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.server.context.config.LastFile = p.Current
 			h.server.context.config.Save()
 
-			h.server.context.isLoading = true
-			h.server.context.world = nil
+			if !h.server.context.startLoad() {
+				// a world or a diff is being loaded
+				err := h.server.render(w, r, "loading.html", h.Progress())
+				if err != nil {
+					httpError(w, err)
+				}
+				return
+			}
 			go loadWorld(h.server, p.Current)
 			http.Redirect(w, r, h.server.context.config.SubUri+"/", http.StatusSeeOther)
 			return
@@ -122,8 +132,7 @@ func isLegendsXml(f fs.FileInfo) bool {
 func loadWorld(server *DfServer, file string) {
 	runtime.GC()
 	wrld, _ := model.Parse(file, server.context.progress)
-	server.context.world = wrld
-	server.context.isLoading = false
+	server.context.finishLoad(wrld)
 }
 
 type paths struct {
@@ -133,8 +142,8 @@ type paths struct {
 }
 
 func (srv *DfServer) renderLoading(w http.ResponseWriter, r *http.Request) {
-	if srv.context.isLoading {
-		err := srv.templates.Render(w, "loading.html", srv.loader.Progress())
+	if srv.context.loading() {
+		err := srv.render(w, r, "loading.html", srv.loader.Progress())
 		if err != nil {
 			httpError(w, err)
 		}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+)
+
+const localeCookie = "locale"
+
+// requestLocale is the locale chosen by the user or else the one preferred
+// by the browser.
+func requestLocale(r *http.Request) *model.Locale {
+	if c, err := r.Cookie(localeCookie); err == nil {
+		if l := model.FindLocale(c.Value); l != nil {
+			return l
+		}
+	}
+	return model.MatchLocale(r.Header.Get("Accept-Language"))
+}
+
+func (srv *DfServer) render(w http.ResponseWriter, r *http.Request, name string, data any) error {
+	return srv.renderStatus(w, r, http.StatusOK, name, data)
+}
+
+// renderStatus renders the page in the locale of the request with the
+// status code.
+func (srv *DfServer) renderStatus(w http.ResponseWriter, r *http.Request, status int, name string, data any) error {
+	l := requestLocale(r)
+	w.Header().Set("Content-Language", l.Name)
+	// the page depends on the header and the cookies choosing the locale
+	// and revealing identities
+	w.Header().Set("Vary", "Accept-Language, Cookie")
+	w.WriteHeader(status)
+	c := &model.Context{World: srv.context.world(), Locale: l, RevealIdentities: srv.requestReveal(r)}
+	return srv.templates.Render(w, name, data, srv.requestFunctions(c))
+}
+
+// translate returns the tr function of the templates. The messages are
+// trusted, arguments are escaped unless they are html already.
+func translate(l *model.Locale) func(string, ...any) template.HTML {
+	return func(key string, args ...any) template.HTML {
+		for i, a := range args {
+			if _, ok := a.(template.HTML); !ok {
+				args[i] = template.HTMLEscapeString(fmt.Sprint(a))
+			}
+		}
+		return template.HTML(l.T(key, args...))
+	}
+}
+
+// requestFunctions replace the functions of the templates whose output
+// depends on the locale or the revealed identities of the request, both
+// taken from the context.
+func (srv *DfServer) requestFunctions(c *model.Context) template.FuncMap {
+	l := c.Locale
+	return template.FuncMap{
+		"tr":         translate(l),
+		"locale":     func() *model.Locale { return l },
+		"time":       l.Time,
+		"season":     l.Season,
+		"andList":    func(s []string) template.HTML { return template.HTML(l.AndList(s)) },
+		"hf":         func(id int) template.HTML { return model.LinkHfIn(c, id) },
+		"hfList":     func(ids []int) template.HTML { return model.LinkHfListIn(c, ids) },
+		"identity":   func(id int) template.HTML { return model.LinkIdentityIn(c, id) },
+		"collection": func(id int) template.HTML { return model.LinkCollectionIn(c, id) },
+		"events": func(obj any) *model.EventList {
+			list := model.NewEventList(srv.context.world(), obj)
+			list.Context.Locale = l
+			list.Context.RevealIdentities = c.RevealIdentities
+			return list
+		},
+		"story": func(id int) template.HTML { return srv.story(c, id) },
+		"context": func(r any) *model.Context {
+			rc := model.NewContext(srv.context.world(), r)
+			rc.Locale = l
+			rc.RevealIdentities = c.RevealIdentities
+			return rc
+		},
+		"revealIdentities": func() bool { return c.RevealIdentities },
+	}
+}
+
+// story is the sentence of an event as told in a story in the locale and
+// with the identities of the context.
+func (srv *DfServer) story(c *model.Context, id int) template.HTML {
+	if e, ok := srv.context.world().Event(id); ok {
+		s := *c
+		s.World, s.Story = srv.context.world(), true
+		return template.HTML(c.Locale.T("%s in %s", s.EventHtml(e.Details), c.Locale.Time(e.Year, e.Seconds72)))
+	}
+	return template.HTML("")
+}
+
+// chooseLocale remembers the locale chosen by the user and goes back to the
+// page it was chosen on.
+func (srv *DfServer) chooseLocale(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("name")
+	if model.FindLocale(name) != nil {
+		http.SetCookie(w, &http.Cookie{
+			Name:     localeCookie,
+			Value:    name,
+			Path:     srv.context.config.SubUri + "/",
+			MaxAge:   365 * 24 * 60 * 60,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	http.Redirect(w, r, backTo(r, srv.context.config.SubUri+"/"), http.StatusSeeOther)
+}
+
+// backTo is the page of the referer, if it is on this server, or else
+// fallback.
+func backTo(r *http.Request, fallback string) string {
+	if u, err := url.Parse(r.Referer()); err == nil && u.Host != "" && u.Host == r.Host {
+		return u.RequestURI()
+	}
+	return fallback
+}
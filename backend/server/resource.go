@@ -1,10 +1,13 @@
 package server
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
 )
@@ -13,23 +16,24 @@ type Parms map[string]string
 
 func (srv *DfServer) RegisterWorldPage(path string, template string, accessor func(Parms) any) {
 	get := func(w http.ResponseWriter, r *http.Request) {
-		if srv.context.world == nil {
+		if srv.context.world() == nil {
 			srv.renderLoading(w, r)
 			return
 		}
 
-		params := mux.Vars(r)
-		for k, v := range r.URL.Query() {
-			params[k] = v[0]
-		}
-
+		params := requestParams(r)
 		data := accessor(params)
 		if data == nil || (reflect.ValueOf(data).Kind() == reflect.Ptr && reflect.ValueOf(data).IsNil()) {
-			srv.notFound(w)
+			srv.notFound(w, r)
+			return
+		}
+
+		if format := params["format"]; isExportFormat(format) {
+			srv.export(w, r, strings.TrimSuffix(template, ".html"), data, format)
 			return
 		}
 
-		err := srv.templates.Render(w, template, data)
+		err := srv.render(w, r, template, data)
 		if err != nil {
 			fmt.Fprint(w, err)
 			fmt.Println(err)
@@ -45,3 +49,76 @@ func (srv *DfServer) RegisterWorldResourcePage(path string, template string, acc
 		return accessor(id)
 	})
 }
+
+func (srv *DfServer) RegisterWorldJson(path string, accessor func(Parms) any) {
+	get := func(w http.ResponseWriter, r *http.Request) {
+		if srv.context.world() == nil {
+			srv.renderLoading(w, r)
+			return
+		}
+
+		data := accessor(requestParams(r))
+		if data == nil || (reflect.ValueOf(data).Kind() == reflect.Ptr && reflect.ValueOf(data).IsNil()) {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(data)
+	}
+
+	srv.router.HandleFunc(path, get).Methods("GET")
+}
+
+func (srv *DfServer) RegisterWorldCsv(path string, accessor func(Parms) (string, [][]string)) {
+	get := func(w http.ResponseWriter, r *http.Request) {
+		if srv.context.world() == nil {
+			srv.renderLoading(w, r)
+			return
+		}
+
+		name, records := accessor(requestParams(r))
+		if records == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, name))
+		w.WriteHeader(http.StatusOK)
+		csv.NewWriter(w).WriteAll(records)
+	}
+
+	srv.router.HandleFunc(path, get).Methods("GET")
+}
+
+func (srv *DfServer) RegisterWorldDownload(path string, accessor func(Parms) (string, string, []byte)) {
+	get := func(w http.ResponseWriter, r *http.Request) {
+		if srv.context.world() == nil {
+			srv.renderLoading(w, r)
+			return
+		}
+
+		name, contentType, content := accessor(requestParams(r))
+		if content == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+		w.WriteHeader(http.StatusOK)
+		w.Write(content)
+	}
+
+	srv.router.HandleFunc(path, get).Methods("GET")
+}
+
+func requestParams(r *http.Request) Parms {
+	params := mux.Vars(r)
+	for k, v := range r.URL.Query() {
+		params[k] = v[0]
+	}
+	return params
+}
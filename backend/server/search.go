@@ -21,17 +21,17 @@ type SearchResult struct {
 }
 
 func (h searchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if h.server.context.world == nil {
+	if h.server.context.world() == nil {
 		h.server.renderLoading(w, r)
 		return
 	}
 
 	term := r.URL.Query().Get("term")
 
-	world := h.server.context.world
+	world := h.server.context.world()
 	if term != "" {
 		var results []SearchResult
-		results = searchMap(term, world.HistoricalFigures, results, "/hf")
+		results = searchHfs(term, world, results)
 		results = searchMap(term, world.Entities, results, "/entity")
 		results = searchMap(term, world.Sites, results, "/site")
 		for _, site := range world.Sites {
@@ -77,7 +77,7 @@ func (h searchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			MountainPeaks      []*model.MountainPeak
 		}{
 			Term:               term,
-			HistoricalFigures:  search(term, world.HistoricalFigures, nil),
+			HistoricalFigures:  loadHfs(world, searchHfList(term, world)),
 			Entities:           search(term, world.Entities, nil),
 			Sites:              search(term, world.Sites, nil),
 			Structures:         structures,
@@ -92,7 +92,7 @@ func (h searchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			MountainPeaks:      search(term, world.MountainPeaks, nil),
 		}
 
-		err := h.server.templates.Render(w, "search.html", results)
+		err := h.server.render(w, r, "search.html", results)
 		if err != nil {
 			httpError(w, err)
 		}
@@ -100,9 +100,9 @@ func (h searchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func searchMap[T model.Named](s string, input map[int]T, output []SearchResult, baseUrl string) []SearchResult {
-	s = strings.ToLower(s)
+	s = util.Fold(s)
 	for id, v := range input {
-		if strings.Contains(strings.ToLower(v.Name()), s) {
+		if strings.Contains(util.Fold(v.Name()), s) {
 			output = append(output, SearchResult{
 				Label: util.Title(v.Name()),
 				Value: fmt.Sprintf("%s/%d", baseUrl, id),
@@ -112,10 +112,34 @@ func searchMap[T model.Named](s string, input map[int]T, output []SearchResult,
 	return output
 }
 
+// searchHfs is searchMap for the historical figures, which might be kept in
+// a store.
+func searchHfs(s string, world *model.DfWorld, output []SearchResult) []SearchResult {
+	for _, hf := range searchHfList(s, world) {
+		output = append(output, SearchResult{
+			Label: util.Title(hf.Name()),
+			Value: fmt.Sprintf("/hf/%d", hf.Id_),
+		})
+	}
+	return output
+}
+
+func searchHfList(s string, world *model.DfWorld) []*model.HfListing {
+	var output []*model.HfListing
+	s = util.Fold(s)
+	world.EachHfListing(func(hf *model.HfListing) {
+		if strings.Contains(util.Fold(hf.Name()), s) {
+			output = append(output, hf)
+		}
+	})
+	sort.Slice(output, func(i, j int) bool { return output[i].Name() < output[j].Name() })
+	return output
+}
+
 func search[T model.Named](s string, input map[int]T, output []T) []T {
-	s = strings.ToLower(s)
+	s = util.Fold(s)
 	for _, v := range input {
-		if strings.Contains(strings.ToLower(v.Name()), s) {
+		if strings.Contains(util.Fold(v.Name()), s) {
 			output = append(output, v)
 		}
 	}
@@ -2,11 +2,13 @@ package server
 
 import (
 	"embed"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"sort"
 	"strconv"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
@@ -16,10 +18,62 @@ import (
 )
 
 type DfServerContext struct {
-	config    *Config
-	world     *model.DfWorld
+	config *Config
+	// current is the world, replaced by loading another one, isLoading is
+	// set meanwhile, both guarded by lock
+	lock      sync.RWMutex
+	current   *model.DfWorld
 	isLoading bool
-	progress  *model.LoadProgress
+	diff      *model.WorldDiff
+	// diffFile is the older export compared to the world, diffLoading is
+	// set while it is parsed and diffError if that failed, all guarded by
+	// diffLock together with diff. diffLock is taken before lock.
+	diffLock     sync.Mutex
+	diffFile     string
+	diffLoading  bool
+	diffError    error
+	progress     *model.LoadProgress
+	diffProgress *model.LoadProgress
+}
+
+func (c *DfServerContext) world() *model.DfWorld {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.current
+}
+
+func (c *DfServerContext) loading() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.isLoading
+}
+
+// startLoad closes the world and its diff before another world is loaded.
+// It fails while a world or a diff is loaded, as both parse into the same
+// progress and the diff compares to the world.
+func (c *DfServerContext) startLoad() bool {
+	c.diffLock.Lock()
+	defer c.diffLock.Unlock()
+	if c.diffLoading {
+		return false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.isLoading {
+		return false
+	}
+	if c.current != nil {
+		c.current.Close()
+	}
+	c.current, c.isLoading = nil, true
+	c.diff, c.diffFile, c.diffError = nil, "", nil
+	return true
+}
+
+func (c *DfServerContext) finishLoad(world *model.DfWorld) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.current, c.isLoading = world, false
 }
 
 type DfServer struct {
@@ -29,14 +83,30 @@ type DfServer struct {
 	context   *DfServerContext
 }
 
-func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
+func StartServer(config *Config, world *model.DfWorld, diff *model.WorldDiff, static embed.FS) error {
+	root := newRouter(config, world, diff, static)
+
+	if diff != nil {
+		OpenBrowser(fmt.Sprintf("http://localhost:%d%s/diff", config.Port, config.SubUri))
+	} else {
+		OpenBrowser(fmt.Sprintf("http://localhost:%d", config.Port))
+	}
+	http.ListenAndServe(fmt.Sprintf(":%d", config.Port), root)
+	return nil
+}
+
+// newRouter sets up the server and returns its root router, which has the
+// routes under the sub uri of the config.
+func newRouter(config *Config, world *model.DfWorld, diff *model.WorldDiff, static embed.FS) *mux.Router {
 	srv := &DfServer{
 		router: mux.NewRouter().StrictSlash(true),
 		context: &DfServerContext{
-			config:    config,
-			world:     world,
-			isLoading: false,
-			progress:  &model.LoadProgress{},
+			config:       config,
+			current:      world,
+			diff:         diff,
+			isLoading:    false,
+			progress:     &model.LoadProgress{},
+			diffProgress: &model.LoadProgress{},
 		},
 	}
 
@@ -48,9 +118,18 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 	srv.loader = &loadHandler{server: srv}
 	srv.LoadTemplates()
 
-	srv.RegisterWorldPage("/entities", "entities.html", func(p Parms) any { return groupByType(srv.context.world.Entities) })
-	srv.RegisterWorldResourcePage("/entity/{id}", "entity.html", func(id int) any { return srv.context.world.Entities[id] })
-	srv.RegisterWorldResourcePage("/popover/entity/{id}", "popoverEntity.html", func(id int) any { return srv.context.world.Entities[id] })
+	srv.RegisterWorldPage("/entities", "entities.html", func(p Parms) any { return groupByType(srv.context.world().Entities) })
+	srv.RegisterWorldResourcePage("/entity/{id}", "entity.html", func(id int) any { return srv.context.world().Entities[id] })
+	srv.RegisterWorldResourcePage("/popover/entity/{id}", "popoverEntity.html", func(id int) any { return srv.context.world().Entities[id] })
+	srv.RegisterWorldDownload("/entity/{id}/chronicle.{format}", srv.chronicleDownload)
+	srv.RegisterWorldResourcePage("/entity/{id}/pantheon", "pantheon.html", func(id int) any {
+		if e, ok := srv.context.world().Entities[id]; ok {
+			return model.NewPantheon(srv.context.world(), e)
+		}
+		return nil
+	})
+	srv.RegisterWorldPage("/pantheon", "pantheon.html", func(p Parms) any { return model.NewPantheon(srv.context.world(), nil) })
+	srv.RegisterWorldPage("/supernatural", "supernatural.html", func(p Parms) any { return model.NewSupernatural(srv.context.world()) })
 
 	srv.RegisterWorldPage("/geography", "geography.html", func(p Parms) any {
 		return &struct {
@@ -59,44 +138,64 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 			MountainPeaks map[string][]*model.MountainPeak
 			Rivers        map[string][]*model.River
 		}{
-			Regions:       singleGroup(srv.context.world.Regions, "region"),
-			Landmasses:    singleGroup(srv.context.world.Landmasses, "landmass"),
-			MountainPeaks: singleGroup(srv.context.world.MountainPeaks, "mountain"),
+			Regions:       singleGroup(srv.context.world().Regions, "region"),
+			Landmasses:    singleGroup(srv.context.world().Landmasses, "landmass"),
+			MountainPeaks: singleGroup(srv.context.world().MountainPeaks, "mountain"),
 			Rivers: map[string][]*model.River{
-				"rivers": srv.context.world.Rivers,
+				"rivers": srv.context.world().Rivers,
 			},
 		}
 	})
-	srv.RegisterWorldResourcePage("/landmass/{id}", "landmass.html", func(id int) any { return srv.context.world.Landmasses[id] })
-	srv.RegisterWorldResourcePage("/popover/landmass/{id}", "popoverLandmass.html", func(id int) any { return srv.context.world.Landmasses[id] })
+	srv.RegisterWorldResourcePage("/landmass/{id}", "landmass.html", func(id int) any { return srv.context.world().Landmasses[id] })
+	srv.RegisterWorldResourcePage("/popover/landmass/{id}", "popoverLandmass.html", func(id int) any { return srv.context.world().Landmasses[id] })
 
-	srv.RegisterWorldResourcePage("/mountain/{id}", "mountain.html", func(id int) any { return srv.context.world.MountainPeaks[id] })
-	srv.RegisterWorldResourcePage("/popover/mountain/{id}", "popoverMountain.html", func(id int) any { return srv.context.world.MountainPeaks[id] })
+	srv.RegisterWorldResourcePage("/mountain/{id}", "mountain.html", func(id int) any { return srv.context.world().MountainPeaks[id] })
+	srv.RegisterWorldResourcePage("/popover/mountain/{id}", "popoverMountain.html", func(id int) any { return srv.context.world().MountainPeaks[id] })
 
-	srv.RegisterWorldResourcePage("/river/{id}", "river.html", func(id int) any { return srv.context.world.Rivers[id] })
-	srv.RegisterWorldResourcePage("/popover/river/{id}", "popoverRiver.html", func(id int) any { return srv.context.world.Rivers[id] })
+	srv.RegisterWorldResourcePage("/river/{id}", "river.html", srv.findRiver)
+	srv.RegisterWorldResourcePage("/popover/river/{id}", "popoverRiver.html", srv.findRiver)
 
-	srv.RegisterWorldPage("/regions", "regions.html", func(p Parms) any { return groupByType(srv.context.world.Regions) })
-	srv.RegisterWorldResourcePage("/region/{id}", "region.html", func(id int) any { return srv.context.world.Regions[id] })
-	srv.RegisterWorldResourcePage("/popover/region/{id}", "popoverRegion.html", func(id int) any { return srv.context.world.Regions[id] })
+	srv.RegisterWorldPage("/regions", "regions.html", func(p Parms) any { return groupByType(srv.context.world().Regions) })
+	srv.RegisterWorldResourcePage("/region/{id}", "region.html", func(id int) any { return srv.context.world().Regions[id] })
+	srv.RegisterWorldResourcePage("/popover/region/{id}", "popoverRegion.html", func(id int) any { return srv.context.world().Regions[id] })
 
-	srv.RegisterWorldPage("/sites", "sites.html", func(p Parms) any { return groupByType(srv.context.world.Sites) })
-	srv.RegisterWorldResourcePage("/site/{id}", "site.html", func(id int) any { return srv.context.world.Sites[id] })
-	srv.RegisterWorldResourcePage("/popover/site/{id}", "popoverSite.html", func(id int) any { return srv.context.world.Sites[id] })
+	srv.RegisterWorldPage("/sites", "sites.html", func(p Parms) any { return groupByType(srv.context.world().Sites) })
+	srv.RegisterWorldResourcePage("/site/{id}", "site.html", func(id int) any { return srv.context.world().Sites[id] })
+	srv.RegisterWorldResourcePage("/popover/site/{id}", "popoverSite.html", func(id int) any { return srv.context.world().Sites[id] })
 
 	srv.RegisterWorldPage("/structures", "structures.html", func(p Parms) any {
-		return flatGrouped(srv.context.world.Sites, func(s *model.Site) []*model.Structure { return util.Values(s.Structures) })
+		return flatGrouped(srv.context.world().Sites, func(s *model.Site) []*model.Structure { return util.Values(s.Structures) })
+	})
+	srv.RegisterWorldResourcePage("/site/{id}/fortress", "fortress.html", func(id int) any {
+		if site, ok := srv.context.world().Sites[id]; ok {
+			return model.NewFortress(srv.context.world(), site)
+		}
+		return nil
+	})
+	srv.RegisterWorldPage("/fortress", "fortresses.html", func(p Parms) any {
+		civId, err := strconv.Atoi(p["civ"])
+		if err != nil {
+			civId = -1
+		}
+		return model.FortressSites(srv.context.world(), civId)
 	})
 	srv.RegisterWorldPage("/site/{siteId}/structure/{id}", "structure.html", srv.findStructure)
 	srv.RegisterWorldPage("/popover/site/{siteId}/structure/{id}", "popoverStructure.html", srv.findStructure)
 
-	srv.RegisterWorldPage("/worldconstructions", "worldconstructions.html", func(p Parms) any { return groupByType(srv.context.world.WorldConstructions) })
-	srv.RegisterWorldResourcePage("/worldconstruction/{id}", "worldconstruction.html", func(id int) any { return srv.context.world.WorldConstructions[id] })
-	srv.RegisterWorldResourcePage("/popover/worldconstruction/{id}", "popoverWorldconstruction.html", func(id int) any { return srv.context.world.WorldConstructions[id] })
-
-	srv.RegisterWorldPage("/artifacts", "artifacts.html", func(p Parms) any { return groupByType(srv.context.world.Artifacts) })
-	srv.RegisterWorldResourcePage("/artifact/{id}", "artifact.html", func(id int) any { return srv.context.world.Artifacts[id] })
-	srv.RegisterWorldResourcePage("/popover/artifact/{id}", "popoverArtifact.html", func(id int) any { return srv.context.world.Artifacts[id] })
+	srv.RegisterWorldPage("/worldconstructions", "worldconstructions.html", func(p Parms) any { return groupByType(srv.context.world().WorldConstructions) })
+	srv.RegisterWorldResourcePage("/worldconstruction/{id}", "worldconstruction.html", func(id int) any { return srv.context.world().WorldConstructions[id] })
+	srv.RegisterWorldResourcePage("/popover/worldconstruction/{id}", "popoverWorldconstruction.html", func(id int) any { return srv.context.world().WorldConstructions[id] })
+
+	srv.RegisterWorldPage("/artifacts", "artifacts.html", func(p Parms) any { return groupByType(srv.context.world().Artifacts) })
+	srv.RegisterWorldResourcePage("/artifact/{id}", "artifact.html", func(id int) any { return srv.context.world().Artifacts[id] })
+	srv.RegisterWorldResourcePage("/popover/artifact/{id}", "popoverArtifact.html", func(id int) any { return srv.context.world().Artifacts[id] })
+	srv.RegisterWorldJson("/artifact/{id}/provenance.json", func(p Parms) any {
+		id, _ := strconv.Atoi(p["id"])
+		if a, ok := srv.context.world().Artifacts[id]; ok {
+			return model.NewProvenance(srv.context.world(), a)
+		}
+		return nil
+	})
 
 	srv.RegisterWorldPage("/artforms", "artforms.html", func(p Parms) any {
 		return &struct {
@@ -104,54 +203,124 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 			MusicalForms map[string][]*model.MusicalForm
 			PoeticForms  map[string][]*model.PoeticForm
 		}{
-			DanceForms:   groupByType(srv.context.world.DanceForms),
-			MusicalForms: groupByType(srv.context.world.MusicalForms),
-			PoeticForms:  groupByType(srv.context.world.PoeticForms),
+			DanceForms:   groupByType(srv.context.world().DanceForms),
+			MusicalForms: groupByType(srv.context.world().MusicalForms),
+			PoeticForms:  groupByType(srv.context.world().PoeticForms),
 		}
 	})
 
-	srv.RegisterWorldResourcePage("/danceform/{id}", "artform.html", func(id int) any { return srv.context.world.DanceForms[id] })
-	srv.RegisterWorldResourcePage("/musicalform/{id}", "artform.html", func(id int) any { return srv.context.world.MusicalForms[id] })
-	srv.RegisterWorldResourcePage("/poeticform/{id}", "artform.html", func(id int) any { return srv.context.world.PoeticForms[id] })
-
-	srv.RegisterWorldPage("/writtencontents", "writtencontents.html", func(p Parms) any { return groupByType(srv.context.world.WrittenContents) })
-	srv.RegisterWorldResourcePage("/writtencontent/{id}", "writtencontent.html", func(id int) any { return srv.context.world.WrittenContents[id] })
-	srv.RegisterWorldResourcePage("/popover/writtencontent/{id}", "popoverWrittencontent.html", func(id int) any { return srv.context.world.WrittenContents[id] })
+	srv.RegisterWorldResourcePage("/danceform/{id}", "artform.html", func(id int) any { return srv.context.world().DanceForms[id] })
+	srv.RegisterWorldResourcePage("/musicalform/{id}", "artform.html", func(id int) any { return srv.context.world().MusicalForms[id] })
+	srv.RegisterWorldResourcePage("/poeticform/{id}", "artform.html", func(id int) any { return srv.context.world().PoeticForms[id] })
+
+	srv.RegisterWorldPage("/writtencontents", "writtencontents.html", func(p Parms) any { return groupByType(srv.context.world().WrittenContents) })
+	srv.RegisterWorldResourcePage("/writtencontent/{id}", "writtencontent.html", func(id int) any { return srv.context.world().WrittenContents[id] })
+	srv.RegisterWorldResourcePage("/popover/writtencontent/{id}", "popoverWrittenContent.html", func(id int) any { return srv.context.world().WrittenContents[id] })
+	srv.RegisterWorldPage("/library", "library.html", func(p Parms) any { return model.NewLibrary(srv.context.world()) })
+	srv.RegisterWorldDownload("/library/citations.{format}", func(p Parms) (string, string, []byte) {
+		graph := model.NewCitationGraph(srv.context.world())
+		switch p["format"] {
+		case "json":
+			data, err := json.Marshal(graph)
+			if err != nil {
+				fmt.Println(err)
+				return "", "", nil
+			}
+			return "citations.json", "application/json", data
+		case "dot":
+			return "citations.dot", "text/vnd.graphviz; charset=utf-8", []byte(graph.Dot())
+		}
+		return "", "", nil
+	})
 
 	srv.RegisterWorldPage("/hfs", "hfs.html", srv.searchHf)
-	srv.RegisterWorldResourcePage("/hf/{id}", "hf.html", func(id int) any { return srv.context.world.HistoricalFigures[id] })
-	srv.RegisterWorldResourcePage("/popover/hf/{id}", "popoverHf.html", func(id int) any { return srv.context.world.HistoricalFigures[id] })
-
-	srv.RegisterWorldPage("/identities", "identities.html", func(p Parms) any { return srv.context.world.Identities })
-	srv.RegisterWorldResourcePage("/identity/{id}", "identity.html", func(id int) any { return srv.context.world.Identities[id] })
-	srv.RegisterWorldResourcePage("/popover/identity/{id}", "popoverIdentity.html", func(id int) any { return srv.context.world.Identities[id] })
-
-	srv.RegisterWorldPage("/years", "years.html", func(p Parms) any {
-		return groupBy(srv.context.world.HistoricalEvents,
-			func(e *model.HistoricalEvent) int { return e.Year },
-			func(e *model.HistoricalEvent) bool { return true },
-			func(e *model.HistoricalEvent) int { return e.Id_ })
+	srv.RegisterWorldResourcePage("/hf/{id}", "hf.html", func(id int) any {
+		hf, _ := srv.context.world().Hf(id)
+		return hf
+	})
+	srv.RegisterWorldResourcePage("/popover/hf/{id}", "popoverHf.html", func(id int) any {
+		hf, _ := srv.context.world().Hf(id)
+		return hf
+	})
+	srv.RegisterWorldResourcePage("/hf/{id}/biography", "biography.html", func(id int) any {
+		if hf, ok := srv.context.world().Hf(id); ok {
+			return model.NewBiography(srv.context.world(), hf)
+		}
+		return nil
+	})
+	srv.RegisterWorldDownload("/hf/{id}/biography.{format}", srv.biographyDownload)
+	srv.RegisterWorldResourcePage("/hf/{id}/relationships", "relationships.html", func(id int) any {
+		if hf, ok := srv.context.world().Hf(id); ok {
+			return model.NewRelationships(srv.context.world(), hf)
+		}
+		return nil
+	})
+	srv.RegisterWorldResourcePage("/hf/{id}/combat", "combatRecord.html", func(id int) any {
+		if hf, ok := srv.context.world().Hf(id); ok {
+			return model.NewCombatRecord(srv.context.world(), hf)
+		}
+		return nil
+	})
+	srv.RegisterWorldResourcePage("/hf/{id}/travels", "travels.html", func(id int) any {
+		if hf, ok := srv.context.world().Hf(id); ok {
+			return model.NewTravelPath(srv.context.world(), hf)
+		}
+		return nil
 	})
+	srv.RegisterWorldJson("/hf/{id}/travels.json", func(p Parms) any {
+		id, _ := strconv.Atoi(p["id"])
+		if hf, ok := srv.context.world().Hf(id); ok {
+			return model.NewTravelPath(srv.context.world(), hf)
+		}
+		return nil
+	})
+	srv.RegisterWorldPage("/combat", "combat.html", func(p Parms) any { return model.NewCombatLeaderboard(srv.context.world()) })
+
+	srv.RegisterWorldPage("/identities", "identities.html", func(p Parms) any { return srv.context.world().Identities })
+	srv.RegisterWorldResourcePage("/identity/{id}", "identity.html", func(id int) any { return srv.context.world().Identities[id] })
+	srv.RegisterWorldResourcePage("/popover/identity/{id}", "popoverIdentity.html", func(id int) any { return srv.context.world().Identities[id] })
+
+	srv.RegisterWorldPage("/years", "years.html", func(p Parms) any { return srv.context.world().EventYears() })
 	srv.RegisterWorldResourcePage("/year/{id}", "year.html", func(id int) any {
-		return util.FilterMap(srv.context.world.HistoricalEvents,
-			func(v *model.HistoricalEvent) bool { return v.Year == id },
-			func(a, b *model.HistoricalEvent) bool { return a.Id_ < b.Id_ },
-		)
+		if list := srv.context.world().EventsInYear(id); len(list) > 0 {
+			return list
+		}
+		return nil
 	})
 
-	srv.RegisterWorldPage("/events", "eventTypes.html", func(p Parms) any { return srv.context.world.AllEventTypes() })
-	srv.RegisterWorldPage("/events/{type}", "eventType.html", func(p Parms) any { return srv.context.world.EventsOfType(p["type"]) })
-	srv.RegisterWorldResourcePage("/event/{id}", "event.html", func(id int) any { return srv.context.world.HistoricalEvents[id] })
+	srv.RegisterWorldPage("/events", "eventTypes.html", func(p Parms) any { return srv.context.world().AllEventTypes() })
+	srv.RegisterWorldPage("/events/{type}", "eventType.html", func(p Parms) any { return srv.context.world().EventsOfType(p["type"]) })
+	srv.RegisterWorldResourcePage("/event/{id}", "event.html", func(id int) any {
+		e, _ := srv.context.world().Event(id)
+		return e
+	})
 
 	srv.RegisterWorldPage("/collections", "collections.html", func(p Parms) any {
-		return groupBy(srv.context.world.HistoricalEventCollections,
+		return groupBy(srv.context.world().HistoricalEventCollections,
 			func(e *model.HistoricalEventCollection) string { return e.Type() },
 			func(e *model.HistoricalEventCollection) bool { return true },
 			func(e *model.HistoricalEventCollection) string { return model.Time(e.StartYear, e.StartSeconds72) },
 		)
 	})
-	srv.RegisterWorldResourcePage("/collection/{id}", "collection.html", func(id int) any { return srv.context.world.HistoricalEventCollections[id] })
-	srv.RegisterWorldResourcePage("/popover/collection/{id}", "popoverCollection.html", func(id int) any { return srv.context.world.HistoricalEventCollections[id] })
+	srv.RegisterWorldResourcePage("/collection/{id}", "collection.html", func(id int) any { return srv.context.world().HistoricalEventCollections[id] })
+	srv.RegisterWorldResourcePage("/popover/collection/{id}", "popoverCollection.html", func(id int) any { return srv.context.world().HistoricalEventCollections[id] })
+
+	srv.router.HandleFunc("/diff", srv.loadDiff).Queries("old", "{old}").Methods("GET")
+	srv.RegisterWorldPage("/diff", "diff.html", srv.diffPage)
+	srv.RegisterWorldJson("/diff.json", func(p Parms) any {
+		srv.context.diffLock.Lock()
+		defer srv.context.diffLock.Unlock()
+		return srv.context.diff
+	})
+
+	srv.RegisterWorldPage("/stats", "stats.html", func(p Parms) any { return srv.context.world().Statistics })
+	srv.RegisterWorldJson("/stats.json", func(p Parms) any { return srv.context.world().Statistics })
+	srv.RegisterWorldCsv("/stats/{table}.csv", func(p Parms) (string, [][]string) {
+		if t := srv.context.world().Statistics.Table(p["table"]); t != nil {
+			return t.Name, t.Records()
+		}
+		return "", nil
+	})
 
 	srv.RegisterWorldPage("/worldmap", "worldMap.html", func(p Parms) any {
 		return &struct {
@@ -162,12 +331,12 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 			WorldConstructions map[int]*model.WorldConstruction
 			Rivers             []*model.River
 		}{
-			Landmasses:         srv.context.world.Landmasses,
-			Regions:            srv.context.world.Regions,
-			Sites:              srv.context.world.Sites,
-			MountainPeaks:      srv.context.world.MountainPeaks,
-			WorldConstructions: srv.context.world.WorldConstructions,
-			Rivers:             srv.context.world.Rivers,
+			Landmasses:         srv.context.world().Landmasses,
+			Regions:            srv.context.world().Regions,
+			Sites:              srv.context.world().Sites,
+			MountainPeaks:      srv.context.world().MountainPeaks,
+			WorldConstructions: srv.context.world().WorldConstructions,
+			Rivers:             srv.context.world().Rivers,
 		}
 	})
 
@@ -175,7 +344,7 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 		return &struct {
 			Civilizations map[string][]*model.Entity
 		}{
-			Civilizations: groupBy(srv.context.world.Entities,
+			Civilizations: groupBy(srv.context.world().Entities,
 				func(e *model.Entity) string {
 					return util.If(e.Necromancer, "necromancer", util.If(e.Race == "", "unknown", e.Race))
 				},
@@ -189,9 +358,13 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 	srv.router.HandleFunc("/map", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "image/png")
 		w.WriteHeader(http.StatusOK)
-		w.Write(srv.loader.server.context.world.MapData)
+		w.Write(srv.loader.server.context.world().MapData)
 	})
 
+	srv.router.HandleFunc("/identities/reveal", srv.revealIdentities).Methods("POST")
+
+	srv.router.HandleFunc("/locale", srv.chooseLocale).Methods("POST")
+
 	srv.router.PathPrefix("/search").Handler(searchHandler{server: srv})
 
 	srv.router.PathPrefix("/load").Handler(srv.loader)
@@ -202,8 +375,13 @@ func StartServer(config *Config, world *model.DfWorld, static embed.FS) error {
 	}
 	srv.router.PathPrefix("/").Handler(spa)
 
-	OpenBrowser(fmt.Sprintf("http://localhost:%d", config.Port))
-	http.ListenAndServe(fmt.Sprintf(":%d", config.Port), root)
+	return root
+}
+
+func (srv *DfServer) findRiver(id int) any {
+	if id >= 0 && id < len(srv.context.world().Rivers) {
+		return srv.context.world().Rivers[id]
+	}
 	return nil
 }
 
@@ -216,67 +394,14 @@ func (srv *DfServer) findStructure(p Parms) any {
 	if err != nil {
 		return nil
 	}
-	if site, ok := srv.context.world.Sites[siteId]; ok {
+	if site, ok := srv.context.world().Sites[siteId]; ok {
 		return site.Structures[structureId]
 	}
 	return nil
 }
 
-func (srv *DfServer) searchHf(p Parms) any {
-	var list []*model.HistoricalFigure
-
-	for _, hf := range srv.context.world.HistoricalFigures {
-		if p["leader"] == "1" && !hf.Leader {
-			continue
-		}
-		if p["deity"] == "1" && !hf.Deity {
-			continue
-		}
-		if p["force"] == "1" && !hf.Force {
-			continue
-		}
-		if p["vampire"] == "1" && !hf.Vampire {
-			continue
-		}
-		if p["werebeast"] == "1" && !hf.Werebeast {
-			continue
-		}
-		if p["necromancer"] == "1" && !hf.Necromancer {
-			continue
-		}
-		if p["alive"] == "1" && hf.DeathYear != -1 {
-			continue
-		}
-		if p["ghost"] == "1" && false { // TODO ghost
-			continue
-		}
-		if p["adventurer"] == "1" && !hf.Adventurer {
-			continue
-		}
-		list = append(list, hf)
-	}
-
-	switch p["sort"] {
-	case "race":
-		sort.Slice(list, func(i, j int) bool { return list[i].Race < list[j].Race })
-	case "birth":
-		sort.Slice(list, func(i, j int) bool { return list[i].BirthYear < list[j].BirthYear })
-	case "death":
-		sort.Slice(list, func(i, j int) bool { return list[i].DeathYear < list[j].DeathYear })
-	case "kills":
-		sort.Slice(list, func(i, j int) bool { return len(list[i].Kills) > len(list[j].Kills) })
-	default:
-		sort.Slice(list, func(i, j int) bool { return list[i].Name_ < list[j].Name_ })
-	}
-
-	return map[string]any{
-		"Params": p,
-		"Hfs":    list,
-	}
-}
-
-func (srv *DfServer) notFound(w http.ResponseWriter) {
-	err := srv.templates.Render(w, "notFound.html", nil)
+func (srv *DfServer) notFound(w http.ResponseWriter, r *http.Request) {
+	err := srv.renderStatus(w, r, http.StatusNotFound, "notFound.html", nil)
 	if err != nil {
 		httpError(w, err)
 	}
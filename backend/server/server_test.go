@@ -0,0 +1,289 @@
+package server
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+)
+
+const fixtureFile = "../model/testdata/fixture-legends.xml"
+
+var routeVar = regexp.MustCompile(`\{(\w+)\}`)
+
+// routeQueries are the parameters for routes that show nothing without them.
+var routeQueries = map[string]string{
+	"/fortress":          "?civ=0",
+	"/identities/reveal": "?on=true",
+	"/locale":            "?name=de",
+	"/search":            "?term=figure",
+}
+
+// expand replaces the variables of a path template by every combination of
+// their values.
+func expand(path string, values map[string][]string) []string {
+	m := routeVar.FindStringSubmatchIndex(path)
+	if m == nil {
+		return []string{path}
+	}
+	var paths []string
+	for _, v := range values[path[m[2]:m[3]]] {
+		paths = append(paths, expand(path[:m[0]]+v+path[m[1]:], values)...)
+	}
+	return paths
+}
+
+func serve(router http.Handler, method, url, lang string) (rec *httptest.ResponseRecorder, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	req := httptest.NewRequest(method, url, nil)
+	req.Header.Set("Accept-Language", lang)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+// TestRoutes renders every route of the fixture world in every locale. Routes
+// may not find some of their variants, but must render at least one.
+func TestRoutes(t *testing.T) {
+	world, err := model.Parse(fixtureFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{path: filepath.Join(t.TempDir(), "config.json")}
+	router := newRouter(config, world, model.NewWorldDiff(world, world), embed.FS{})
+
+	types := make(map[string]bool)
+	for _, e := range world.HistoricalEvents {
+		types[e.Details.Type()] = true
+	}
+	values := map[string][]string{
+		"id":     {"0", "1", "10"},
+		"siteId": {"0", "1"},
+		"format": {"md", "txt", "html", "epub", "json", "dot"},
+		"type":   util.Map(util.Keys(types), url.PathEscape),
+		"table":  util.Map(world.Statistics.Tables, func(t *model.StatTable) string { return t.Name }),
+	}
+
+	err = router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		method := "GET"
+		if methods, err := route.GetMethods(); err == nil {
+			method = methods[0]
+		}
+		for _, l := range model.Locales {
+			found := false
+			for _, url := range expand(path, values) {
+				url += routeQueries[path]
+				rec, err := serve(router, method, url, l.Name)
+				if err != nil {
+					t.Errorf("%s (%s): %v", url, l.Name, err)
+					continue
+				}
+				if rec.Code >= 500 {
+					t.Errorf("%s (%s): status %d", url, l.Name, rec.Code)
+				}
+				if body := rec.Body.String(); strings.Contains(body, "template: ") {
+					t.Errorf("%s (%s): %s", url, l.Name, body[strings.Index(body, "template: "):])
+				}
+				if rec.Code < 400 {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s (%s): nothing found", path, l.Name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRevealIdentities(t *testing.T) {
+	config := &Config{path: filepath.Join(t.TempDir(), "config.json")}
+	router := newRouter(config, nil, nil, embed.FS{})
+
+	rec, err := serve(router, "GET", "/identities/reveal?on=true", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Result().Cookies()) != 0 {
+		t.Error("identities revealed by GET")
+	}
+
+	rec, err = serve(router, "POST", "/identities/reveal?on=true", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Code != http.StatusSeeOther {
+		t.Errorf("POST status %d", rec.Code)
+	}
+	if back := rec.Header().Get("Location"); back != "/identities" {
+		t.Errorf("redirected to %q", back)
+	}
+	req := httptest.NewRequest("GET", "/", nil)
+	for _, c := range rec.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	srv := &DfServer{context: &DfServerContext{config: config}}
+	if !srv.requestReveal(req) {
+		t.Error("identities not revealed by the cookie")
+	}
+	if srv.requestReveal(httptest.NewRequest("GET", "/", nil)) {
+		t.Error("identities revealed without the cookie")
+	}
+}
+
+func TestChooseLocale(t *testing.T) {
+	config := &Config{path: filepath.Join(t.TempDir(), "config.json")}
+	router := newRouter(config, nil, nil, embed.FS{})
+
+	rec, err := serve(router, "GET", "/locale?name=de", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Result().Cookies()) != 0 {
+		t.Error("locale chosen by GET")
+	}
+
+	for referer, back := range map[string]string{
+		"":                             "/",
+		"http://example.com/hf/1":      "/hf/1",
+		"http://evil.example/hf/1":     "/",
+		"//evil.example/hf/1":          "/",
+		"javascript:alert(1)":          "/",
+		"http://example.com/sites?x=1": "/sites?x=1",
+	} {
+		req := httptest.NewRequest("POST", "/locale?name=de", nil)
+		if referer != "" {
+			req.Header.Set("Referer", referer)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusSeeOther {
+			t.Errorf("POST status %d", rec.Code)
+		}
+		if got := rec.Header().Get("Location"); got != back {
+			t.Errorf("referer %q: redirected to %q, want %q", referer, got, back)
+		}
+		cookies := rec.Result().Cookies()
+		if len(cookies) != 1 || cookies[0].Value != "de" {
+			t.Errorf("locale cookie %v", cookies)
+		}
+	}
+}
+
+func TestLoadDiff(t *testing.T) {
+	world, err := model.Parse(fixtureFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{path: filepath.Join(t.TempDir(), "config.json")}
+	router := newRouter(config, world, nil, embed.FS{})
+
+	url := "/diff?old=" + url.QueryEscape(fixtureFile)
+	for i := 0; ; i++ {
+		rec, err := serve(router, "GET", url, "en")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rec.Code == http.StatusSeeOther {
+			break
+		}
+		if rec.Code != http.StatusOK || i == 100 {
+			t.Fatalf("status %d after %d requests", rec.Code, i+1)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	rec, err := serve(router, "GET", "/diff.json", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(rec.Body.String(), `"oldFile"`) {
+		t.Errorf("no diff: %s", rec.Body.String())
+	}
+}
+
+func TestLoadDuringDiff(t *testing.T) {
+	world, err := model.Parse(fixtureFile, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{path: filepath.Join(t.TempDir(), "config.json")}
+	router := newRouter(config, world, nil, embed.FS{})
+	file, err := filepath.Abs(fixtureFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(url string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec, err := serve(router, "GET", url, "en")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+	waitLoaded := func() {
+		t.Helper()
+		for i := 0; ; i++ {
+			var p loadProgress
+			if err := json.Unmarshal(get("/load/progress").Body.Bytes(), &p); err != nil {
+				t.Fatal(err)
+			}
+			if p.Done {
+				return
+			}
+			if i == 100 {
+				t.Fatal("not loaded")
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+
+	if rec := get("/diff?old=" + url.QueryEscape(file)); rec.Code != http.StatusOK {
+		t.Fatalf("diff status %d", rec.Code)
+	}
+	// refused while the diff is loaded, unless it is done already
+	rec := get("/load?p=" + url.QueryEscape(file))
+	refused := rec.Code == http.StatusOK
+	waitLoaded()
+	if refused {
+		if body := get("/diff.json").Body.String(); !strings.Contains(body, `"oldFile"`) {
+			t.Errorf("no diff: %s", body)
+		}
+		rec = get("/load?p=" + url.QueryEscape(file))
+	}
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("load status %d", rec.Code)
+	}
+
+	// the diff waits for the world
+	if rec := get("/diff?old=" + url.QueryEscape(file)); rec.Code != http.StatusOK {
+		t.Fatalf("diff status %d", rec.Code)
+	}
+	waitLoaded()
+	if rec := get("/"); rec.Code != http.StatusOK {
+		t.Errorf("world not loaded, status %d", rec.Code)
+	}
+}
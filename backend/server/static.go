@@ -32,12 +32,12 @@ func (h spaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// file does not exist, serve index.html
 		file, err := h.staticFS.Open(h.staticPath + "/" + h.indexPath)
 		if err != nil {
-			h.server.notFound(w)
+			h.server.notFound(w, r)
 			return
 		}
 		index, err := ioutil.ReadAll(file)
 		if err != nil {
-			h.server.notFound(w)
+			h.server.notFound(w, r)
 			return
 		}
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
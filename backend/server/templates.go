@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"html/template"
 	"net/url"
+	"strconv"
 
 	humanize "github.com/dustin/go-humanize"
 	"github.com/iancoleman/strcase"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/templates"
 	"github.com/robertjanetzko/LegendsBrowser2/backend/util"
+	"golang.org/x/exp/slices"
 )
 
 var DebugJSON = false
@@ -33,69 +35,82 @@ func (srv *DfServer) LoadTemplates() {
 		"kebab":   func(s string) string { return strcase.ToKebab(s) },
 		"andList": model.AndList,
 		"suburi":  func() string { return srv.context.config.SubUri },
-		"world":   func() *model.DfWorld { return srv.context.world },
-		"context": func(r any) *model.Context { return model.NewContext(srv.context.world, r) },
+		"world":   func() *model.DfWorld { return srv.context.world() },
+		"context": func(r any) *model.Context { return model.NewContext(srv.context.world(), r) },
 		"initMap": func() template.HTML {
 			return template.HTML(fmt.Sprintf(`<script>var worldWidth = %d, worldHeight = %d;</script><script src="./js/map.js"></script>`,
-				srv.context.world.Width, srv.context.world.Height))
+				srv.context.world().Width, srv.context.world().Height))
 		},
-		"hf":                   func(id int) template.HTML { return model.LinkHf(srv.context.world, id) },
-		"hfShort":              func(id int) template.HTML { return model.LinkHfShort(srv.context.world, id) },
-		"getHf":                func(id int) *model.HistoricalFigure { return srv.context.world.HistoricalFigures[id] },
-		"hfList":               func(ids []int) template.HTML { return model.LinkHfList(srv.context.world, ids) },
-		"identity":             func(id int) template.HTML { return model.LinkIdentity(srv.context.world, id) },
-		"entity":               func(id int) template.HTML { return model.LinkEntity(srv.context.world, id) },
-		"getEntity":            func(id int) *model.Entity { return srv.context.world.Entities[id] },
-		"site":                 func(id int) template.HTML { return model.LinkSite(srv.context.world, id) },
-		"getSite":              func(id int) *model.Site { return srv.context.world.Sites[id] },
-		"structure":            func(siteId, id int) template.HTML { return model.LinkStructure(srv.context.world, siteId, id) },
-		"region":               func(id int) template.HTML { return model.LinkRegion(srv.context.world, id) },
-		"getRegion":            func(id int) *model.Region { return srv.context.world.Regions[id] },
-		"worldConstruction":    func(id int) template.HTML { return model.LinkWorldConstruction(srv.context.world, id) },
-		"getWorldConstruction": func(id int) *model.WorldConstruction { return srv.context.world.WorldConstructions[id] },
-		"artifact":             func(id int) template.HTML { return model.LinkArtifact(srv.context.world, id) },
-		"getArtifact":          func(id int) *model.Artifact { return srv.context.world.Artifacts[id] },
-		"danceForm":            func(id int) template.HTML { return model.LinkDanceForm(srv.context.world, id) },
-		"musicalForm":          func(id int) template.HTML { return model.LinkMusicalForm(srv.context.world, id) },
-		"poeticForm":           func(id int) template.HTML { return model.LinkPoeticForm(srv.context.world, id) },
-		"writtenContent":       func(id int) template.HTML { return model.LinkWrittenContent(srv.context.world, id) },
-		"landmass":             func(id int) template.HTML { return model.LinkLandmass(srv.context.world, id) },
-		"mountain":             func(id int) template.HTML { return model.LinkMountain(srv.context.world, id) },
-		"river":                func(id int) template.HTML { return model.LinkRiver(srv.context.world, id) },
+		"hf":                   func(id int) template.HTML { return model.LinkHf(srv.context.world(), id) },
+		"hfShort":              func(id int) template.HTML { return model.LinkHfShort(srv.context.world(), id) },
+		"getHf":                func(id int) *model.HistoricalFigure { hf, _ := srv.context.world().Hf(id); return hf },
+		"hfList":               func(ids []int) template.HTML { return model.LinkHfList(srv.context.world(), ids) },
+		"identity":             func(id int) template.HTML { return model.LinkIdentity(srv.context.world(), id) },
+		"getIdentity":          func(id int) *model.Identity { return srv.context.world().Identities[id] },
+		"entity":               func(id int) template.HTML { return model.LinkEntity(srv.context.world(), id) },
+		"getEntity":            func(id int) *model.Entity { return srv.context.world().Entities[id] },
+		"site":                 func(id int) template.HTML { return model.LinkSite(srv.context.world(), id) },
+		"getSite":              func(id int) *model.Site { return srv.context.world().Sites[id] },
+		"structure":            func(siteId, id int) template.HTML { return model.LinkStructure(srv.context.world(), siteId, id) },
+		"region":               func(id int) template.HTML { return model.LinkRegion(srv.context.world(), id) },
+		"getRegion":            func(id int) *model.Region { return srv.context.world().Regions[id] },
+		"worldConstruction":    func(id int) template.HTML { return model.LinkWorldConstruction(srv.context.world(), id) },
+		"getWorldConstruction": func(id int) *model.WorldConstruction { return srv.context.world().WorldConstructions[id] },
+		"artifact":             func(id int) template.HTML { return model.LinkArtifact(srv.context.world(), id) },
+		"getArtifact":          func(id int) *model.Artifact { return srv.context.world().Artifacts[id] },
+		"danceForm":            func(id int) template.HTML { return model.LinkDanceForm(srv.context.world(), id) },
+		"musicalForm":          func(id int) template.HTML { return model.LinkMusicalForm(srv.context.world(), id) },
+		"poeticForm":           func(id int) template.HTML { return model.LinkPoeticForm(srv.context.world(), id) },
+		"writtenContent":       func(id int) template.HTML { return model.LinkWrittenContent(srv.context.world(), id) },
+		"landmass":             func(id int) template.HTML { return model.LinkLandmass(srv.context.world(), id) },
+		"mountain":             func(id int) template.HTML { return model.LinkMountain(srv.context.world(), id) },
+		"river":                func(id int) template.HTML { return model.LinkRiver(srv.context.world(), id) },
 
-		"addLandmass":          func(id int) template.HTML { return model.AddMapLandmass(srv.context.world, id) },
-		"addRegion":            func(id int) template.HTML { return model.AddMapRegion(srv.context.world, id) },
-		"addSite":              func(id int, color bool) template.HTML { return model.AddMapSite(srv.context.world, id, color) },
-		"addMountain":          func(id int, color bool) template.HTML { return model.AddMapMountain(srv.context.world, id, color) },
-		"addWorldConstruction": func(id int) template.HTML { return model.AddMapWorldConstruction(srv.context.world, id) },
-		"addRiver":             func(id int) template.HTML { return model.AddMapRiver(srv.context.world, id) },
-		"addCollection":        func(id int) template.HTML { return model.AddMapCollection(srv.context.world, id) },
+		"addLandmass":          func(id int) template.HTML { return model.AddMapLandmass(srv.context.world(), id) },
+		"addRegion":            func(id int) template.HTML { return model.AddMapRegion(srv.context.world(), id) },
+		"addSite":              func(id int, color bool) template.HTML { return model.AddMapSite(srv.context.world(), id, color) },
+		"addMountain":          func(id int, color bool) template.HTML { return model.AddMapMountain(srv.context.world(), id, color) },
+		"addWorldConstruction": func(id int) template.HTML { return model.AddMapWorldConstruction(srv.context.world(), id) },
+		"addRiver":             func(id int) template.HTML { return model.AddMapRiver(srv.context.world(), id) },
+		"addCollection":        func(id int) template.HTML { return model.AddMapCollection(srv.context.world(), id) },
+		"addProvenance":        func(p *model.Provenance) template.HTML { return model.AddMapProvenance(srv.context.world(), p) },
+		"addTravelPath":        func(p *model.TravelPath) template.HTML { return model.AddMapTravelPath(srv.context.world(), p) },
 
 		"events": func(obj any) *model.EventList {
-			return model.NewEventList(srv.context.world, obj)
+			return model.NewEventList(srv.context.world(), obj)
 		},
+		"provenance": func(a *model.Artifact) *model.Provenance {
+			return model.NewProvenance(srv.context.world(), a)
+		},
+		"identityTimeline": func(hf *model.HistoricalFigure) []*model.IdentityUse {
+			return srv.context.world().IdentityTimeline(hf)
+		},
+		"doubleLives":      func(entityId int) []*model.Identity { return srv.context.world().DoubleLives(entityId) },
+		"realMember":       func(i *model.Identity) bool { return srv.context.world().RealMember(i) },
+		"revealIdentities": func() bool { return false },
 		"history": func(siteId int) []*model.HistoricalEvent {
-			return srv.context.world.SiteHistory(siteId)
+			return srv.context.world().SiteHistory(siteId)
+		},
+		"collection": func(id int) template.HTML { return model.LinkCollection(srv.context.world(), id) },
+		"getCollection": func(id int) *model.HistoricalEventCollection {
+			return srv.context.world().HistoricalEventCollections[id]
 		},
-		"collection":    func(id int) template.HTML { return model.LinkCollection(srv.context.world, id) },
-		"getCollection": func(id int) *model.HistoricalEventCollection { return srv.context.world.HistoricalEventCollections[id] },
 		"getOccasion": func(civId, occasionId int) *model.Occasion {
-			if civ, ok := srv.context.world.Entities[civId]; ok {
+			if civ, ok := srv.context.world().Entities[civId]; ok {
 				return civ.Occasion[occasionId]
 			}
 			return nil
 		},
-		"story": func(id int) template.HTML {
-			if e, ok := srv.context.world.HistoricalEvents[id]; ok {
-				return template.HTML(e.Details.Html(&model.Context{World: srv.context.world, Story: true}) + " in " + model.Time(e.Year, e.Seconds72))
-			}
-			return template.HTML("")
-		},
-		"description":  func(d string) template.HTML { return model.LinkDescription(srv.context.world, d) },
+		"story":        func(id int) template.HTML { return srv.story(&model.Context{Locale: model.DefaultLocale}, id) },
+		"description":  func(d string) template.HTML { return model.LinkDescription(srv.context.world(), d) },
 		"season":       model.Season,
 		"time":         model.Time,
+		"tr":           translate(model.DefaultLocale),
+		"locale":       func() *model.Locale { return model.DefaultLocale },
+		"locales":      func() []*model.Locale { return model.Locales },
 		"url":          url.PathEscape,
 		"query":        url.QueryEscape,
+		"queryString":  queryString,
 		"isLegendsXml": isLegendsXml,
 		"html": func(value any) template.HTML {
 			return template.HTML(fmt.Sprint(value))
@@ -107,7 +122,29 @@ func (srv *DfServer) LoadTemplates() {
 		"string":          util.String,
 		"capitalize":      util.Capitalize,
 		"add":             func(a, b int) int { return a + b },
+		"mod":             func(a, b int) int { return a % b },
+		"atoi":            func(s string) int { v, _ := strconv.Atoi(s); return v },
+		"list":            func(s ...string) []string { return s },
 		"breakYearColumn": func(c, m int) bool { return (c % ((m + 2) / 4)) == 0 },
+		"percent": func(v, max int) string {
+			if max == 0 {
+				return "0"
+			}
+			return fmt.Sprintf("%.2f", float64(v)*100/float64(max))
+		},
 	}
 	srv.templates = templates.New(functions)
 }
+
+func queryString(p Parms, skip ...string) string {
+	values := url.Values{}
+	for k, v := range p {
+		if k != "format" && v != "" && !slices.Contains(skip, k) {
+			values.Set(k, v)
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return values.Encode() + "&"
+}
@@ -32,13 +32,15 @@ func NewDebug(funcMap template.FuncMap) *Template {
 
 var DebugTemplates = false
 
-func (t *Template) Render(w io.Writer, name string, data any) error {
+// Render executes a template, funcs replace functions of the function map
+// for this rendering, like the ones depending on the locale of a request.
+func (t *Template) Render(w io.Writer, name string, data any, funcs template.FuncMap) error {
 	if DebugTemplates {
-		tmpl := NewDebug(t.funcMap).templates
+		tmpl := NewDebug(t.funcMap).templates.Funcs(funcs)
 		tmpl = template.Must(tmpl.ParseFiles("templates/" + name))
 		return tmpl.ExecuteTemplate(w, name, data)
 	}
-	tmpl := template.Must(t.templates.Clone())
+	tmpl := template.Must(t.templates.Clone()).Funcs(funcs)
 	tmpl = template.Must(tmpl.ParseFS(templateFS, name))
 	return tmpl.ExecuteTemplate(w, name, data)
 }
@@ -0,0 +1,71 @@
+package templates
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/robertjanetzko/LegendsBrowser2/backend/model"
+)
+
+var trPattern = regexp.MustCompile(`\btr ("(?:[^"\\]|\\.)*")`)
+
+func TestLocalesTranslateTemplates(t *testing.T) {
+	files, err := templateFS.ReadDir(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make(map[string]string)
+	for _, f := range files {
+		data, err := templateFS.ReadFile(f.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range trPattern.FindAllStringSubmatch(string(data), -1) {
+			key, err := strconv.Unquote(m[1])
+			if err != nil {
+				t.Errorf("%s: %s: %v", f.Name(), m[1], err)
+				continue
+			}
+			keys[key] = f.Name()
+		}
+	}
+	if len(keys) == 0 {
+		t.Fatal("no messages found")
+	}
+	for _, l := range model.Locales {
+		if l == model.DefaultLocale {
+			continue
+		}
+		for key, file := range keys {
+			if !l.Has(key) {
+				t.Errorf("locale %s has no message for %q of %s", l.Name, key, file)
+			}
+		}
+	}
+}
+
+// TestLocalesTranslateStatistics checks the titles and labels of the
+// statistics, that stats.html translates as tr $t.Title and
+// tr (print "%d in %d " $t.Label "s").
+func TestLocalesTranslateStatistics(t *testing.T) {
+	world, err := model.Parse("../model/testdata/fixture-legends.xml", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(world.Statistics.Tables) == 0 {
+		t.Fatal("no statistics")
+	}
+	for _, l := range model.Locales {
+		if l == model.DefaultLocale {
+			continue
+		}
+		for _, table := range world.Statistics.Tables {
+			for _, key := range []string{table.Title, "%d in %d " + table.Label + "s"} {
+				if !l.Has(key) {
+					t.Errorf("locale %s has no message for %q of table %s", l.Name, key, table.Name)
+				}
+			}
+		}
+	}
+}
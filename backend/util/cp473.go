@@ -1,35 +1,145 @@
 package util
 
-import "io"
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
 
-var cp437 = []byte("         \t\n  \r                   !\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~ CueaaaaceeeiiiAAEaAooouuyOU    faiounN                                                                                                ")
+// glyphs of the code page 437 bytes 0x80 to 0xFF
+const cp437High = "ÇüéâäàåçêëèïîìÄÅ" +
+	"ÉæÆôöòûùÿÖÜ¢£¥₧ƒ" +
+	"áíóúñÑªº¿⌐¬½¼¡«»" +
+	"░▒▓│┤╡╢╖╕╣║╗╝╜╛┐" +
+	"└┴┬├─┼╞╟╚╔╩╦╠═╬╧" +
+	"╨╤╥╙╘╒╓╫╪┘┌█▄▌▐▀" +
+	"αßΓπΣσµτΦΘΩδ∞φε∩" +
+	"≡±≥≤⌠⌡÷≈°∙·√ⁿ²■ "
 
-// var cp437 = []byte("         \t\n  \r                   !\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~ ÇüéâäàåçêëèïîìÄÅÉæÆôöòûùÿÖÜ¢£¥₧ƒáíóúñÑ                                                                                                ")
+// cp437 maps every byte to its unicode character. Control characters other
+// than tab and line breaks are not allowed in xml and are mapped to blanks.
+var cp437 [256]rune
 
+// cp437Bytes maps the characters of code page 437 back to their bytes
+var cp437Bytes = make(map[rune]byte)
+
+func init() {
+	for i := 0; i < 0x80; i++ {
+		cp437[i] = rune(i)
+		if i < 0x20 && i != '\t' && i != '\n' && i != '\r' {
+			cp437[i] = ' '
+		}
+	}
+	cp437[0x7f] = '⌂'
+	i := 0x80
+	for _, r := range cp437High {
+		cp437[i] = r
+		cp437Bytes[r] = byte(i)
+		i++
+	}
+}
+
+// utf8Mark precedes characters of the code page 437 text that are not in
+// the code page and kept as UTF-8. It is a control character, which is not
+// allowed in xml.
+const utf8Mark = 0x01
+
+// RuneToCp437 returns the code page 437 byte of a character, '?' if there is
+// none.
+func RuneToCp437(r rune) byte {
+	if b, ok := runeToCp437(r); ok {
+		return b
+	}
+	return '?'
+}
+
+func runeToCp437(r rune) (byte, bool) {
+	if r < 0x80 {
+		return byte(r), true
+	}
+	b, ok := cp437Bytes[r]
+	return b, ok
+}
+
+// ConvertReader decodes a code page 437 stream to UTF-8. The encoding of the
+// xml declaration is replaced accordingly.
 type ConvertReader struct {
 	r    io.Reader
 	read int
+	in   []byte
+	out  []byte
+	buf  []byte
+	err  error
 }
 
 func NewConvertReader(r io.Reader) *ConvertReader {
-	return &ConvertReader{r: r}
+	return &ConvertReader{r: r, in: make([]byte, 32*1024)}
 }
 
 func (c *ConvertReader) Read(b []byte) (n int, err error) {
-	n, err = c.r.Read(b)
-	if c.read == 0 && n > 35 {
-		copy(b[30:35], []byte("UTF-8"))
+	for len(c.out) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		n, err := c.r.Read(c.in)
+		if c.read == 0 && n > 35 {
+			copy(c.in[30:35], []byte("UTF-8"))
+		}
+		c.read += n
+		c.buf = AppendCp437(c.buf[:0], c.in[:n])
+		c.out = c.buf
+		c.err = err
 	}
-	c.read += n
-	for i := range b {
-		b[i] = cp437[b[i]]
+	n = copy(b, c.out)
+	c.out = c.out[n:]
+	return n, nil
+}
+
+// AppendCp437 appends the UTF-8 encoding of the code page 437 text b to dst.
+// Characters behind a utf8Mark are copied as they are.
+func AppendCp437(dst, b []byte) []byte {
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		if c == utf8Mark {
+			if r, n := utf8.DecodeRune(b[i+1:]); r >= utf8.RuneSelf && r != utf8.RuneError {
+				dst = append(dst, b[i+1:i+1+n]...)
+				i += n
+				continue
+			}
+		}
+		if r := cp437[c]; r < utf8.RuneSelf {
+			dst = append(dst, byte(r))
+		} else {
+			dst = utf8.AppendRune(dst, r)
+		}
 	}
-	return n, err
+	return dst
 }
 
 func ConvertCp473(b []byte) string {
-	for i := range b {
-		b[i] = cp437[b[i]]
+	ascii := true
+	for _, c := range b {
+		if c >= 0x7f || (c < 0x20 && c != '\t' && c != '\n' && c != '\r') {
+			ascii = false
+			break
+		}
 	}
-	return string(b)
+	if ascii {
+		return string(b)
+	}
+	return string(AppendCp437(make([]byte, 0, len(b)+len(b)/2), b))
+}
+
+// folded spellings of the accented letters of code page 437
+var foldReplacer = strings.NewReplacer(
+	"ç", "c", "ü", "u", "é", "e", "â", "a", "ä", "a", "à", "a", "å", "a",
+	"ê", "e", "ë", "e", "è", "e", "ï", "i", "î", "i", "ì", "i",
+	"æ", "ae", "ô", "o", "ö", "o", "ò", "o", "û", "u", "ù", "u", "ÿ", "y",
+	"á", "a", "í", "i", "ó", "o", "ú", "u", "ñ", "n", "ß", "ss",
+)
+
+// Fold returns the lower case form of s without diacritics, to match names
+// regardless of how accents are typed.
+func Fold(s string) string {
+	return foldReplacer.Replace(strings.ToLower(s))
 }
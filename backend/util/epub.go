@@ -0,0 +1,89 @@
+package util
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+<rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles>
+</container>`
+
+const epubPackage = `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="id">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:identifier id="id">%s</dc:identifier>
+<dc:title>%s</dc:title>
+<dc:language>en</dc:language>
+<meta property="dcterms:modified">2000-01-01T00:00:00Z</meta>
+</metadata>
+<manifest>
+<item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+%s</manifest>
+<spine>
+%s</spine>
+</package>`
+
+const epubPage = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops">
+<head><title>%s</title></head>
+<body>
+%s
+</body>
+</html>`
+
+type epubFile struct {
+	name    string
+	content string
+}
+
+type EpubChapter struct {
+	File  string
+	Title string
+	// Body is the xhtml content of the chapter, it has to be well-formed xml
+	Body string
+}
+
+// WriteEpub writes a minimal EPUB 3 book with a navigation document listing
+// all chapters in order.
+func WriteEpub(w io.Writer, id, title string, chapters []EpubChapter) error {
+	z := zip.NewWriter(w)
+
+	// the mimetype has to be the first entry and must not be compressed
+	mw, err := z.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	io.WriteString(mw, "application/epub+zip")
+
+	var manifest, spine, nav strings.Builder
+	for i, c := range chapters {
+		fmt.Fprintf(&manifest, `<item id="c%d" href="%s" media-type="application/xhtml+xml"/>`+"\n", i, c.File)
+		fmt.Fprintf(&spine, `<itemref idref="c%d"/>`+"\n", i)
+		fmt.Fprintf(&nav, `<li><a href="%s">%s</a></li>`+"\n", c.File, EscapeXml(c.Title))
+	}
+
+	files := []epubFile{
+		{"META-INF/container.xml", epubContainer},
+		{"OEBPS/content.opf", fmt.Sprintf(epubPackage, EscapeXml(id), EscapeXml(title), manifest.String(), spine.String())},
+		{"OEBPS/nav.xhtml", fmt.Sprintf(epubPage, EscapeXml(title), `<nav epub:type="toc"><h1>`+EscapeXml(title)+"</h1><ol>\n"+nav.String()+"</ol></nav>")},
+	}
+	for _, c := range chapters {
+		files = append(files, epubFile{"OEBPS/" + c.File, fmt.Sprintf(epubPage, EscapeXml(c.Title), c.Body)})
+	}
+	for _, f := range files {
+		fw, err := z.Create(f.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fw, f.content); err != nil {
+			return err
+		}
+	}
+
+	return z.Close()
+}
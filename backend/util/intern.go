@@ -0,0 +1,63 @@
+package util
+
+import "sync"
+
+const internShards = 32
+
+// Interner deduplicates the code page 437 text of a legends file, so values
+// like races or materials that are repeated many times are stored once. It is
+// safe for concurrent use.
+type Interner struct {
+	shards [internShards]internShard
+}
+
+type internShard struct {
+	lock    sync.Mutex
+	strings map[string]string
+}
+
+func NewInterner() *Interner {
+	in := &Interner{}
+	for i := range in.shards {
+		in.shards[i].strings = make(map[string]string)
+	}
+	return in
+}
+
+// Intern returns the UTF-8 string for the text b and reports whether it was
+// seen for the first time.
+func (in *Interner) Intern(b []byte) (string, bool) {
+	// fnv-1a
+	h := uint32(2166136261)
+	for _, c := range b {
+		h ^= uint32(c)
+		h *= 16777619
+	}
+	s := &in.shards[h%internShards]
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if v, ok := s.strings[string(b)]; ok {
+		return v, false
+	}
+	v := ConvertCp473(b)
+	// plain ascii text is its own key, so only one copy is kept
+	k := v
+	if v != string(b) {
+		k = string(b)
+	}
+	s.strings[k] = v
+	return v, true
+}
+
+// Len returns the number of distinct strings.
+func (in *Interner) Len() int {
+	n := 0
+	for i := range in.shards {
+		s := &in.shards[i]
+		s.lock.Lock()
+		n += len(s.strings)
+		s.lock.Unlock()
+	}
+	return n
+}
@@ -3,11 +3,14 @@ package util
 import (
 	"encoding/json"
 	"fmt"
+	"html"
 	"html/template"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 func Keys[K comparable, V any](input map[K]V) []K {
@@ -62,7 +65,8 @@ func Capitalize(input string) string {
 	if input == "" {
 		return ""
 	}
-	return strings.ToUpper(input[:1]) + input[1:]
+	r, n := utf8.DecodeRuneInString(input)
+	return string(unicode.ToUpper(r)) + input[n:]
 }
 
 func Json(obj any) template.HTML {
@@ -134,6 +138,23 @@ func Strip(html template.HTML) string {
 	return r.ReplaceAllString(string(html), "")
 }
 
+// PlainText strips all markup from html and collapses whitespace.
+func PlainText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(Strip(template.HTML(s)))), " ")
+}
+
+var markdownLink = regexp.MustCompile(`<a [^>]*href="([^"]*)"[^>]*>(.*?)</a>`)
+
+// Markdown converts links in html to markdown links and strips everything
+// else.
+func Markdown(s string) string {
+	s = markdownLink.ReplaceAllStringFunc(s, func(a string) string {
+		m := markdownLink.FindStringSubmatch(a)
+		return "[" + PlainText(m[2]) + "](" + m[1] + ")"
+	})
+	return PlainText(s)
+}
+
 func String(html template.HTML) string {
 	return string(html)
 }
@@ -2,33 +2,37 @@ package util
 
 import (
 	"bufio"
+	"strconv"
+	"unicode/utf8"
 )
 
+// XMLParser is a fast pull parser for the subset of xml used by the legends
+// exports. Text is returned as raw bytes in the encoding of the file, entity
+// and character references are resolved to that encoding as well. Characters
+// that are not in the code page are kept as UTF-8 behind a mark, which is
+// resolved by ConvertCp473.
 type XMLParser struct {
 	reader      *bufio.Reader
 	scratch     *scratch
+	name        *scratch
+	attrs       []Attr
 	selfClose   bool
 	lastElement string
+	capturing   bool
+	capture     []byte
+}
+
+// Attr is an attribute of the last start element.
+type Attr struct {
+	Name  string
+	Value []byte
 }
 
 func NewXMLParser(r *bufio.Reader) *XMLParser {
-	x := &XMLParser{
+	return &XMLParser{
 		reader:  r,
 		scratch: &scratch{data: make([]byte, 1024)},
-	}
-	x.skipDeclerations()
-	return x
-}
-
-func (x *XMLParser) skipDeclerations() error {
-	for {
-		b, err := x.reader.ReadByte()
-		if err != nil {
-			return err
-		}
-		if b == '>' {
-			return nil
-		}
+		name:    &scratch{data: make([]byte, 64)},
 	}
 }
 
@@ -39,55 +43,66 @@ const (
 	EndElement
 )
 
+type markup int
+
+const (
+	markupStart markup = iota
+	markupSelfClose
+	markupEnd
+	markupCData
+	markupOther
+)
+
+// Token returns the next start or end element. Text, comments and processing
+// instructions between elements are skipped.
 func (x *XMLParser) Token() (TokenType, string, error) {
 	if x.selfClose {
 		x.selfClose = false
 		return EndElement, x.lastElement, nil
 	}
 
-	var (
-		f, c bool
-	)
 	for {
-		b, err := x.reader.ReadByte()
+		b, err := x.readByte()
 		if err != nil {
 			return 0, "", err
 		}
-		if b == '<' {
-			f = true
-			x.scratch.reset()
+		if b != '<' {
+			continue
+		}
+		m, err := x.markup(nil, true)
+		if err != nil {
+			return 0, "", err
+		}
+		switch m {
+		case markupStart:
+			return StartElement, string(x.name.bytes()), nil
+		case markupSelfClose:
+			x.selfClose = true
+			x.lastElement = string(x.name.bytes())
+			return StartElement, x.lastElement, nil
+		case markupEnd:
+			return EndElement, string(x.name.bytes()), nil
+		}
+	}
+}
 
-			b, err := x.reader.ReadByte()
-			if err != nil {
-				return 0, "", err
-			}
-
-			if b == '/' {
-				c = true
-			} else {
-				x.scratch.add(b)
-			}
-
-		} else if b == '>' {
-			bs := x.scratch.bytes()
-			if bs[len(bs)-1] == '/' {
-				x.selfClose = true
-				x.lastElement = string(bs[:len(bs)-1])
-				return StartElement, x.lastElement, nil
-			} else {
-				if c {
-					return EndElement, string(bs), nil
-				} else {
-					return StartElement, string(bs), nil
-				}
-			}
+// Attrs returns the attributes of the last start element.
+func (x *XMLParser) Attrs() []Attr {
+	return x.attrs
+}
 
-		} else if f {
-			x.scratch.add(b)
+// Attr returns the value of an attribute of the last start element.
+func (x *XMLParser) Attr(name string) ([]byte, bool) {
+	for _, a := range x.attrs {
+		if a.Name == name {
+			return a.Value, true
 		}
 	}
+	return nil, false
 }
 
+// Value returns the text of the current element and consumes its end
+// element. The text of nested elements is included.
 func (x *XMLParser) Value() ([]byte, error) {
 	if x.selfClose {
 		x.selfClose = false
@@ -95,36 +110,63 @@ func (x *XMLParser) Value() ([]byte, error) {
 	}
 
 	x.scratch.reset()
-	var (
-		f bool
-	)
+	depth := 0
 	for {
-		b, err := x.reader.ReadByte()
+		b, err := x.readByte()
 		if err != nil {
 			return nil, err
 		}
-		if b == '<' {
-			f = true
-		} else if f && b == '>' {
-			return x.scratch.bytes(), nil
-
-		} else if !f {
+		switch b {
+		case '<':
+			m, err := x.markup(x.scratch, false)
+			if err != nil {
+				return nil, err
+			}
+			switch m {
+			case markupStart:
+				depth++
+			case markupEnd:
+				if depth == 0 {
+					return x.scratch.bytes(), nil
+				}
+				depth--
+			}
+		case '&':
+			if err := x.reference(x.scratch); err != nil {
+				return nil, err
+			}
+		case '\r':
+			x.newline(x.scratch, '\n')
+		default:
 			x.scratch.add(b)
 		}
 	}
 }
 
+// Skip skips the current element including its children.
 func (x *XMLParser) Skip() error {
+	if x.selfClose {
+		x.selfClose = false
+		return nil
+	}
+
 	depth := 0
 	for {
-		t, _, err := x.Token()
+		b, err := x.readByte()
+		if err != nil {
+			return err
+		}
+		if b != '<' {
+			continue
+		}
+		m, err := x.markup(nil, false)
 		if err != nil {
 			return err
 		}
-		switch t {
-		case StartElement:
+		switch m {
+		case markupStart:
 			depth++
-		case EndElement:
+		case markupEnd:
 			if depth == 0 {
 				return nil
 			}
@@ -133,8 +175,330 @@ func (x *XMLParser) Skip() error {
 	}
 }
 
+// Capture starts recording the raw bytes read by the parser, following
+// prefix.
+func (x *XMLParser) Capture(prefix []byte, size int) {
+	x.capture = append(make([]byte, 0, size), prefix...)
+	x.capturing = true
+}
+
+// Captured returns the bytes recorded since the last call to Capture.
+func (x *XMLParser) Captured() []byte {
+	return x.capture
+}
+
+// StopCapture stops recording and returns the recorded bytes.
+func (x *XMLParser) StopCapture() []byte {
+	b := x.capture
+	x.capture = nil
+	x.capturing = false
+	return b
+}
+
+func (x *XMLParser) readByte() (byte, error) {
+	b, err := x.reader.ReadByte()
+	if x.capturing && err == nil {
+		x.capture = append(x.capture, b)
+	}
+	return b, err
+}
+
+func (x *XMLParser) unreadByte() {
+	x.reader.UnreadByte()
+	if x.capturing {
+		x.capture = x.capture[:len(x.capture)-1]
+	}
+}
+
+func (x *XMLParser) discard(n int) {
+	for i := 0; i < n; i++ {
+		x.readByte()
+	}
+}
+
+// markup reads the markup following a '<'. Element names are read into
+// x.name, the content of CDATA sections is added to text if not nil.
+func (x *XMLParser) markup(text *scratch, attrs bool) (markup, error) {
+	b, err := x.readByte()
+	if err != nil {
+		return 0, err
+	}
+	switch b {
+	case '/':
+		x.name.reset()
+		for {
+			b, err := x.readByte()
+			if err != nil {
+				return 0, err
+			}
+			if b == '>' {
+				return markupEnd, nil
+			}
+			if !isSpace(b) {
+				x.name.add(b)
+			}
+		}
+	case '?':
+		return markupOther, x.skipUntil("?>")
+	case '!':
+		return x.declaration(text)
+	}
+
+	x.name.reset()
+	x.name.add(b)
+	if attrs {
+		x.attrs = x.attrs[:0]
+	}
+	for {
+		b, err := x.readByte()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case b == '>':
+			return markupStart, nil
+		case b == '/':
+			if err := x.expect('>'); err != nil {
+				return 0, err
+			}
+			return markupSelfClose, nil
+		case isSpace(b):
+			return x.attributes(attrs)
+		default:
+			x.name.add(b)
+		}
+	}
+}
+
+// attributes reads the attributes of a start element up to its end.
+func (x *XMLParser) attributes(keep bool) (markup, error) {
+	var name []byte
+	for {
+		b, err := x.readByte()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case isSpace(b):
+		case b == '>':
+			return markupStart, nil
+		case b == '/':
+			if err := x.expect('>'); err != nil {
+				return 0, err
+			}
+			return markupSelfClose, nil
+		case b == '=':
+			q, err := x.readByte()
+			for err == nil && isSpace(q) {
+				q, err = x.readByte()
+			}
+			if err != nil {
+				return 0, err
+			}
+			if q != '"' && q != '\'' {
+				return 0, &SyntaxError{"attribute value not quoted"}
+			}
+			v := &scratch{data: make([]byte, 16)}
+			if err := x.attributeValue(v, q); err != nil {
+				return 0, err
+			}
+			if keep {
+				x.attrs = append(x.attrs, Attr{Name: string(name), Value: v.bytes()})
+			}
+			name = name[:0]
+		default:
+			name = append(name, b)
+		}
+	}
+}
+
+func (x *XMLParser) attributeValue(v *scratch, quote byte) error {
+	for {
+		b, err := x.readByte()
+		if err != nil {
+			return err
+		}
+		switch {
+		case b == quote:
+			return nil
+		case b == '&':
+			if err := x.reference(v); err != nil {
+				return err
+			}
+		case b == '\r':
+			x.newline(v, ' ')
+		case isSpace(b):
+			v.add(' ')
+		default:
+			v.add(b)
+		}
+	}
+}
+
+// declaration reads the markup following a "<!", which is either a comment,
+// a CDATA section or a document type declaration.
+func (x *XMLParser) declaration(text *scratch) (markup, error) {
+	b, err := x.reader.Peek(2)
+	if err != nil {
+		return 0, err
+	}
+	if string(b) == "--" {
+		x.discard(2)
+		return markupOther, x.skipUntil("-->")
+	}
+	if b[0] == '[' {
+		if b, err := x.reader.Peek(7); err == nil && string(b) == "[CDATA[" {
+			x.discard(7)
+			return markupCData, x.readUntil(text, "]]>")
+		}
+	}
+	// doctype, possibly with an internal subset
+	depth := 0
+	for {
+		b, err := x.readByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '>':
+			if depth == 0 {
+				return markupOther, nil
+			}
+		}
+	}
+}
+
+func (x *XMLParser) skipUntil(end string) error {
+	return x.readUntil(nil, end)
+}
+
+// readUntil adds everything up to end to s, or discards it if s is nil.
+func (x *XMLParser) readUntil(s *scratch, end string) error {
+	// the last bytes read, which might be the start of end
+	window := make([]byte, 0, len(end))
+	for {
+		b, err := x.readByte()
+		if err != nil {
+			return err
+		}
+		window = append(window, b)
+		if string(window) == end {
+			return nil
+		}
+		if len(window) == len(end) {
+			if s != nil {
+				s.add(window[0])
+			}
+			window = window[:copy(window, window[1:])]
+		}
+	}
+}
+
+// reference resolves an entity or character reference following a '&'.
+// Unknown references are kept as they are.
+func (x *XMLParser) reference(s *scratch) error {
+	var ref [16]byte
+	n := 0
+	for {
+		b, err := x.readByte()
+		if err != nil {
+			return err
+		}
+		if b == ';' {
+			break
+		}
+		if n == len(ref) || isSpace(b) || b == '<' || b == '&' {
+			x.unreadByte()
+			s.add('&')
+			for _, c := range ref[:n] {
+				s.add(c)
+			}
+			return nil
+		}
+		ref[n] = b
+		n++
+	}
+
+	switch name := ref[:n]; {
+	case string(name) == "amp":
+		s.add('&')
+	case string(name) == "lt":
+		s.add('<')
+	case string(name) == "gt":
+		s.add('>')
+	case string(name) == "quot":
+		s.add('"')
+	case string(name) == "apos":
+		s.add('\'')
+	case len(name) > 1 && name[0] == '#':
+		var (
+			r   uint64
+			err error
+		)
+		if name[1] == 'x' || name[1] == 'X' {
+			r, err = strconv.ParseUint(string(name[2:]), 16, 32)
+		} else {
+			r, err = strconv.ParseUint(string(name[1:]), 10, 32)
+		}
+		if err != nil {
+			return &SyntaxError{"invalid character reference &" + string(name) + ";"}
+		}
+		if b, ok := runeToCp437(rune(r)); ok {
+			s.add(b)
+		} else {
+			// kept as UTF-8 behind a mark, see AppendCp437
+			s.add(utf8Mark)
+			for _, c := range utf8.AppendRune(nil, rune(r)) {
+				s.add(c)
+			}
+		}
+	default:
+		s.add('&')
+		for _, c := range name {
+			s.add(c)
+		}
+		s.add(';')
+	}
+	return nil
+}
+
+// newline normalizes "\r\n" and single "\r" following a '\r' to nl.
+func (x *XMLParser) newline(s *scratch, nl byte) {
+	if b, err := x.reader.Peek(1); err == nil && b[0] == '\n' {
+		x.discard(1)
+	}
+	s.add(nl)
+}
+
+func (x *XMLParser) expect(c byte) error {
+	b, err := x.readByte()
+	if err != nil {
+		return err
+	}
+	if b != c {
+		return &SyntaxError{"expected " + strconv.QuoteRune(rune(c)) + ", found " + strconv.QuoteRune(rune(b))}
+	}
+	return nil
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+type SyntaxError struct {
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return "xml syntax error: " + e.Msg
+}
+
 // scratch taken from
-//https://github.com/bcicen/jstream
+// https://github.com/bcicen/jstream
 type scratch struct {
 	data []byte
 	fill int
@@ -0,0 +1,279 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestParser(s string) *XMLParser {
+	return NewXMLParser(bufio.NewReader(strings.NewReader(s)))
+}
+
+func TestXMLParserValue(t *testing.T) {
+	tests := []struct {
+		name, input, want string
+	}{
+		{"plain", `<a>text</a>`, "text"},
+		{"empty", `<a></a>`, ""},
+		{"selfclosed", `<a/>`, ""},
+		{"predefined entities", `<a>&amp;&lt;&gt;&quot;&apos;</a>`, `&<>"'`},
+		{"decimal reference", `<a>&#65;&#233;</a>`, "A\x82"},
+		{"hex reference", `<a>&#x41;&#xE9;&#x2550;</a>`, "A\x82\xcd"},
+		{"unmappable reference", `<a>&#x4E2D;</a>`, "\x01中"},
+		{"unknown entity", `<a>&foo; bar</a>`, "&foo; bar"},
+		{"bare ampersand", `<a>fish & chips</a>`, "fish & chips"},
+		{"cdata", `<a><![CDATA[<b> & </b>]]></a>`, "<b> & </b>"},
+		{"cdata brackets", `<a><![CDATA[x]]]></a>`, "x]"},
+		{"comment", `<a>x<!-- <b> -->y</a>`, "xy"},
+		{"processing instruction", `<a>x<?pi y?>z</a>`, "xz"},
+		{"nested", `<a>x<b>y<c/>z</b>w</a>`, "xyzw"},
+		{"nested same name", `<a><a>x</a></a>`, "x"},
+		{"end tag spaces", `<a>x</a >`, "x"},
+		{"crlf", "<a>x\r\ny\rz</a>", "x\ny\nz"},
+		{"cp437", "<a>\x9adil</a>", "\x9adil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestParser(tt.input + "<next/>")
+			if _, _, err := p.Token(); err != nil {
+				t.Fatal(err)
+			}
+			got, err := p.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Value() = %q, want %q", got, tt.want)
+			}
+			if _, n, err := p.Token(); err != nil || n != "next" {
+				t.Errorf("Token() after Value() = %q, %v, want next", n, err)
+			}
+		})
+	}
+}
+
+func TestXMLParserUnmappableReference(t *testing.T) {
+	p := newTestParser(`<a>x&#x4E2D;&#233;&#x1F600;</a><next/>`)
+	if _, _, err := p.Token(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := p.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := ConvertCp473(got); s != "x中é😀" {
+		t.Errorf("ConvertCp473(Value()) = %q, want %q", s, "x中é😀")
+	}
+}
+
+func TestXMLParserAttrs(t *testing.T) {
+	p := newTestParser(`<a one="1" two = '2' three="x>y" four='"&amp;&#65;'
+		five="a
+b"><b/></a>`)
+	typ, n, err := p.Token()
+	if err != nil || typ != StartElement || n != "a" {
+		t.Fatalf("Token() = %v, %q, %v", typ, n, err)
+	}
+	want := map[string]string{"one": "1", "two": "2", "three": "x>y", "four": `"&A`, "five": "a b"}
+	if len(p.Attrs()) != len(want) {
+		t.Errorf("got %d attributes, want %d", len(p.Attrs()), len(want))
+	}
+	for k, v := range want {
+		if got, ok := p.Attr(k); !ok || string(got) != v {
+			t.Errorf("Attr(%q) = %q, %v, want %q", k, got, ok, v)
+		}
+	}
+	if _, ok := p.Attr("six"); ok {
+		t.Error("Attr(six) found")
+	}
+
+	typ, n, err = p.Token()
+	if err != nil || typ != StartElement || n != "b" {
+		t.Fatalf("Token() = %v, %q, %v", typ, n, err)
+	}
+	if len(p.Attrs()) != 0 {
+		t.Errorf("attributes of b = %v", p.Attrs())
+	}
+}
+
+func TestXMLParserSkip(t *testing.T) {
+	p := newTestParser(`<r><a x="<b>"><b>1</b><c/><![CDATA[</a>]]><!-- </a> --></a><d>2</d></r>`)
+	p.Token()
+	p.Token()
+	if err := p.Skip(); err != nil {
+		t.Fatal(err)
+	}
+	if _, n, err := p.Token(); err != nil || n != "d" {
+		t.Errorf("Token() after Skip() = %q, %v, want d", n, err)
+	}
+}
+
+func TestXMLParserSyntaxErrors(t *testing.T) {
+	for _, input := range []string{`<a x=1>`, `<a/ >`, `<a>&#xZZ;</a>`} {
+		p := newTestParser(input)
+		_, _, err := p.Token()
+		if err == nil {
+			_, err = p.Value()
+		}
+		if _, ok := err.(*SyntaxError); !ok {
+			t.Errorf("%s: got error %v, want syntax error", input, err)
+		}
+	}
+}
+
+// element is a start element as reported by encoding/xml.
+type element struct {
+	name  string
+	attrs []xml.Attr
+	leaf  bool
+	text  string
+}
+
+func stdElements(data []byte) ([]*element, error) {
+	var (
+		list  []*element
+		stack []*element
+	)
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			return list, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if len(stack) > 0 {
+				stack[len(stack)-1].leaf = false
+			}
+			e := &element{name: t.Name.Local, attrs: t.Attr, leaf: true}
+			list = append(list, e)
+			stack = append(stack, e)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+}
+
+// TestXMLParserConformance compares the parser to encoding/xml on the
+// documents in testdata/xml.
+func TestXMLParserConformance(t *testing.T) {
+	files, err := filepath.Glob("testdata/xml/*.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no test documents found")
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := stdElements(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p := NewXMLParser(bufio.NewReader(bytes.NewReader(data)))
+			i := 0
+			for {
+				typ, n, err := p.Token()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if typ != StartElement {
+					continue
+				}
+				if i == len(want) {
+					t.Fatalf("unexpected element %s", n)
+				}
+				e := want[i]
+				i++
+				if n != e.name {
+					t.Fatalf("element %d is %s, want %s", i, n, e.name)
+				}
+				if len(p.Attrs()) != len(e.attrs) {
+					t.Errorf("%s has %d attributes, want %d", n, len(p.Attrs()), len(e.attrs))
+				}
+				for _, a := range e.attrs {
+					if v, ok := p.Attr(a.Name.Local); !ok || ConvertCp473(v) != a.Value {
+						t.Errorf("%s attribute %s = %q, want %q", n, a.Name.Local, ConvertCp473(v), a.Value)
+					}
+				}
+				if e.leaf {
+					v, err := p.Value()
+					if err != nil {
+						t.Fatal(err)
+					}
+					if ConvertCp473(v) != e.text {
+						t.Errorf("%s = %q, want %q", n, ConvertCp473(v), e.text)
+					}
+				}
+			}
+			if i != len(want) {
+				t.Errorf("found %d elements, want %d", i, len(want))
+			}
+		})
+	}
+}
+
+func benchmarkDocument() []byte {
+	var b bytes.Buffer
+	b.WriteString("<?xml version=\"1.0\" encoding='UTF-8'?>\n<df_world>\n<historical_figures>\n")
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(&b, "<historical_figure>\n<id>%d</id>\n<name>urist mcaxe &amp; sons</name>\n<race>DWARF</race>\n"+
+			"<entity_link>\n<link_type>member</link_type>\n<entity_id>%d</entity_id>\n</entity_link>\n<deity/>\n</historical_figure>\n", i, i%50)
+	}
+	b.WriteString("</historical_figures>\n</df_world>\n")
+	return b.Bytes()
+}
+
+var leafElements = map[string]bool{"id": true, "name": true, "race": true, "link_type": true, "entity_id": true, "deity": true}
+
+func BenchmarkXMLParser(b *testing.B) {
+	data := benchmarkDocument()
+	b.SetBytes(int64(len(data)))
+	for n := 0; n < b.N; n++ {
+		p := NewXMLParser(bufio.NewReader(bytes.NewReader(data)))
+		for {
+			t, name, err := p.Token()
+			if err != nil {
+				break
+			}
+			if t == StartElement && leafElements[name] {
+				p.Value()
+			}
+		}
+	}
+}
+
+func BenchmarkEncodingXML(b *testing.B) {
+	data := benchmarkDocument()
+	b.SetBytes(int64(len(data)))
+	for n := 0; n < b.N; n++ {
+		d := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := d.RawToken(); err != nil {
+				break
+			}
+		}
+	}
+}
@@ -1,4 +1,11 @@
 #!/bin/bash
 
+# inspect the heap profile written by --memstats, or sample the heap of a
+# browser started with --profile
+if [ -f "$1" ]; then
+  go tool pprof "$1"
+  exit
+fi
+
 curl http://localhost:8081/debug/pprof/heap > heap.0.pprof
-go tool pprof heap.0.pprof
\ No newline at end of file
+go tool pprof heap.0.pprof